@@ -0,0 +1 @@
+terraform import railway_deployment.api 39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c
@@ -0,0 +1,5 @@
+resource "railway_deployment" "api" {
+  service_id     = railway_service.api.id
+  environment_id = railway_environment.production.id
+  deployment_id  = "5b9a1f3c-2d4e-4f6a-8b7c-9d0e1f2a3b4c"
+}
@@ -0,0 +1,10 @@
+resource "railway_deployment_restart" "api" {
+  service_id      = railway_service.api.id
+  environment_id  = railway_environment.production.id
+  redeploy        = true
+  wait_for_health = true
+
+  triggers = {
+    database_password = railway_variable.database_password.id
+  }
+}
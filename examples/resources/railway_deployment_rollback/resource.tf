@@ -0,0 +1,12 @@
+variable "rollback_to" {
+  type    = string
+  default = null
+}
+
+resource "railway_deployment_rollback" "api" {
+  count = var.rollback_to == null ? 0 : 1
+
+  service_id     = railway_service.api.id
+  environment_id = railway_environment.production.id
+  deployment_id  = var.rollback_to
+}
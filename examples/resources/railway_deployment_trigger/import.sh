@@ -0,0 +1 @@
+terraform import railway_deployment_trigger.api 4f3a1c2e-8b7d-4e6f-9a0b-1c2d3e4f5a6b
@@ -0,0 +1,7 @@
+resource "railway_deployment_trigger" "api" {
+  project_id     = railway_project.example.id
+  service_id     = railway_service.example.id
+  environment_id = railway_project.example.default_environment.id
+  repository     = "railwayapp/starters"
+  branch         = "main"
+}
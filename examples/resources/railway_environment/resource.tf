@@ -2,3 +2,19 @@ resource "railway_environment" "example" {
   name       = "staging"
   project_id = railway_project.example.id
 }
+
+resource "railway_environment" "preview" {
+  name                  = "preview"
+  project_id            = railway_project.example.id
+  source_environment_id = railway_environment.example.id
+  is_ephemeral          = true
+}
+
+resource "railway_environment" "load_test" {
+  name                  = "load-test"
+  project_id            = railway_project.example.id
+  source_environment_id = railway_environment.example.id
+  clone_variables       = false
+  clone_volumes         = false
+  exclude_services      = [railway_service.mailer.id]
+}
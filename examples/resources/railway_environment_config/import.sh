@@ -0,0 +1 @@
+terraform import railway_environment_config.staging d0519b29-5d12-4857-a5dd-76fa7418336c
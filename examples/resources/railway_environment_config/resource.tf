@@ -0,0 +1,8 @@
+resource "railway_environment_config" "staging" {
+  environment_id = railway_environment.staging.id
+  commit_message = "Apply terraform changes"
+
+  triggers = {
+    api = railway_service_instance.api.id
+  }
+}
@@ -0,0 +1 @@
+terraform import railway_project_member.alice 0bb01547-570d-4109-a5e8-138691f6a2d1:alice@example.com
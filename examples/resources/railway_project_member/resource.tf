@@ -0,0 +1,5 @@
+resource "railway_project_member" "alice" {
+  project_id = railway_project.example.id
+  email      = "alice@example.com"
+  role       = "MEMBER"
+}
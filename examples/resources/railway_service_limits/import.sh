@@ -0,0 +1 @@
+terraform import railway_service_limits.api_production 89fa0236-2b1b-4a8c-b12d-ae3634b30d97:d0519b29-5d12-4857-a5dd-76fa7418336c
@@ -0,0 +1,5 @@
+resource "railway_service_repo_connection" "api" {
+  service_id = railway_service.example.id
+  repository = "railwayapp/starters"
+  branch     = "main"
+}
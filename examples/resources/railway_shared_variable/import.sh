@@ -1 +1,4 @@
+terraform import railway_shared_variable.sentry 0bb01547-570d-4109-a5e8-138691f6a2d1:d0519b29-5d12-4857-a5dd-76fa7418336c:SENTRY_KEY
+
+# Or by environment name
 terraform import railway_shared_variable.sentry 0bb01547-570d-4109-a5e8-138691f6a2d1:staging:SENTRY_KEY
@@ -1 +1,4 @@
-terraform import railway_tcp_proxy.redis 89fa0236-2b1b-4a8c-b12d-ae3634b30d97:d0519b29-5d12-4857-a5dd-76fa7418336c:227bc195-52fa-4d39-a872-a8cec0a0feca
+terraform import railway_tcp_proxy.redis 89fa0236-2b1b-4a8c-b12d-ae3634b30d97:d0519b29-5d12-4857-a5dd-76fa7418336c:6379
+
+# Or by id, which searches every service instance of every project and takes one request for each
+terraform import railway_tcp_proxy.redis 227bc195-52fa-4d39-a872-a8cec0a0feca
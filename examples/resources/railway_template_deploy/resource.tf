@@ -0,0 +1,16 @@
+resource "railway_template_deploy" "stack" {
+  code           = "xxxxxx"
+  project_id     = railway_project.example.id
+  environment_id = railway_project.example.default_environment.id
+
+  variables = {
+    "LOG_LEVEL"    = "info"
+    "api.NODE_ENV" = "production"
+  }
+}
+
+resource "railway_service_domain" "api" {
+  subdomain      = "example-api"
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_template_deploy.stack.service_ids["api"]
+}
@@ -0,0 +1 @@
+terraform import railway_usage_limit.example ecb63be7-63fb-47fe-95fc-1585d24e172d
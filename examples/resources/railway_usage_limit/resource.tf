@@ -0,0 +1,5 @@
+resource "railway_usage_limit" "example" {
+  workspace_id        = "ecb63be7-63fb-47fe-95fc-1585d24e172d"
+  alert_threshold_usd = 50
+  hard_limit_usd      = 100
+}
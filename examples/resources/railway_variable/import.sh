@@ -1 +1,4 @@
+terraform import railway_variable.sentry 9d2ef9c4-e1fa-4ac1-b7e4-1a6e3a8cd7b5:89fa0236-2b1b-4a8c-b12d-ae3634b30d97:SENTRY_KEY
+
+# Or by service and environment name
 terraform import railway_variable.sentry 89fa0236-2b1b-4a8c-b12d-ae3634b30d97:staging:SENTRY_KEY
@@ -4,3 +4,11 @@ resource "railway_variable" "example" {
   environment_id = railway_project.example.default_environment.id
   service_id     = railway_service.example.id
 }
+
+# Railway references are escaped with a second $ so terraform doesn't read them as templates
+resource "railway_variable" "database_url" {
+  name           = "DATABASE_URL"
+  value          = "$${{Postgres.DATABASE_URL}}"
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+}
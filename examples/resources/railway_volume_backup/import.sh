@@ -0,0 +1 @@
+terraform import railway_volume_backup.before_migration 7d1e5f2a-3b4c-4d5e-8f90-a1b2c3d4e5f6:6a0c9e2b-8f1d-4c3b-9a7e-5d4f3e2c1b0a
@@ -0,0 +1,6 @@
+resource "railway_volume_backup" "before_migration" {
+  volume_instance_id = railway_volume_instance.data.id
+
+  # Change to a new value to restore the volume instance from this backup
+  # restore_trigger = "2024-06-01"
+}
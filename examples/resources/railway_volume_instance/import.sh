@@ -0,0 +1 @@
+terraform import railway_volume_instance.data 8a1e7ddb-5b8f-4e0c-9fc3-3b8c3e4f0f2d:d0519b29-5d12-4857-a5dd-76fa7418336c
@@ -0,0 +1,18 @@
+# Only for volumes that aren't managed by the volume block of a railway_service,
+# such as the ones created by a template
+data "railway_volumes" "all" {
+  project_id = railway_project.example.id
+}
+
+locals {
+  data_volume = one([
+    for volume in data.railway_volumes.all.volumes : volume
+    if volume.name == "data" && volume.environment_id == railway_project.example.default_environment.id
+  ])
+}
+
+resource "railway_volume_instance" "data" {
+  volume_id      = local.data_volume.id
+  environment_id = railway_project.example.default_environment.id
+  mount_path     = "/var/lib/data"
+}
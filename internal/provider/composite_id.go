@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// serviceInstanceId returns the composite identifier of a service instance, in the format
+// `service_id:environment_id`. It is the identifier of every resource that belongs to a single service instance.
+func serviceInstanceId(serviceId string, environmentId string) string {
+	return serviceId + ":" + environmentId
+}
+
+// parseServiceInstanceId splits a composite identifier made by serviceInstanceId. Both parts must be ids.
+func parseServiceInstanceId(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 2 || !uuidRegex().MatchString(parts[0]) || !uuidRegex().MatchString(parts[1]) {
+		return "", "", fmt.Errorf("expected format service_id:environment_id, where both are ids, got: %q", id)
+	}
+
+	return parts[0], parts[1], nil
+}
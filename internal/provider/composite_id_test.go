@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseServiceInstanceId(t *testing.T) {
+	testCases := map[string]struct {
+		id                  string
+		expectServiceId     string
+		expectEnvironmentId string
+		expectError         bool
+	}{
+		"valid": {
+			id:                  "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c",
+			expectServiceId:     "39da7e07-fa3a-42fd-b695-d229319f2993",
+			expectEnvironmentId: "d0519b29-5d12-4857-a5dd-76fa7418336c",
+		},
+		"environment name": {
+			id:          "39da7e07-fa3a-42fd-b695-d229319f2993:staging",
+			expectError: true,
+		},
+		"missing environment": {
+			id:          "39da7e07-fa3a-42fd-b695-d229319f2993",
+			expectError: true,
+		},
+		"too many parts": {
+			id:          "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:8080",
+			expectError: true,
+		},
+		"empty": {
+			id:          "",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			serviceId, environmentId, err := parseServiceInstanceId(testCase.id)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got %s and %s", serviceId, environmentId)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if serviceId != testCase.expectServiceId || environmentId != testCase.expectEnvironmentId {
+				t.Errorf("expected %s and %s, got %s and %s", testCase.expectServiceId, testCase.expectEnvironmentId, serviceId, environmentId)
+			}
+
+			if id := serviceInstanceId(serviceId, environmentId); id != testCase.id {
+				t.Errorf("expected %s to round-trip, got %s", testCase.id, id)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &BuildLogsDataSource{}
+
+const (
+	logsDefaultLimit = 100
+	logsMaxLimit     = 5000
+)
+
+func NewBuildLogsDataSource() datasource.DataSource {
+	return &BuildLogsDataSource{}
+}
+
+type BuildLogsDataSource struct {
+	client *graphql.Client
+}
+
+type LogEntryModel struct {
+	Timestamp  types.String `tfsdk:"timestamp"`
+	Message    types.String `tfsdk:"message"`
+	Severity   types.String `tfsdk:"severity"`
+	Attributes types.Map    `tfsdk:"attributes"`
+}
+
+type BuildLogsDataSourceModel struct {
+	DeploymentId types.String    `tfsdk:"deployment_id"`
+	Limit        types.Int64     `tfsdk:"limit"`
+	Logs         []LogEntryModel `tfsdk:"logs"`
+}
+
+func (d *BuildLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_build_logs"
+}
+
+func (d *BuildLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the most recent build logs of a Railway deployment, oldest first.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_build_logs" "api" {
+  deployment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  limit         = 500
+}
+
+resource "local_file" "build_log" {
+  filename = "build.log"
+  content  = join("\n", [for log in data.railway_build_logs.api.logs : "${log.timestamp} ${log.message}"])
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "Deployment ID to read the build logs of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of log entries to read, Railway keeps the most recent ones. **Default** `%d`.", logsDefaultLimit),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, logsMaxLimit),
+				},
+			},
+			"logs": logEntriesSchema("Build log entries, oldest first."),
+		},
+	}
+}
+
+func (d *BuildLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BuildLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BuildLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := logsDefaultLimit
+
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	response, err := getBuildLogs(ctx, *d.client, data.DeploymentId.ValueString(), limit)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read build logs, got error: %s", err))
+		return
+	}
+
+	entries := make([]LogEntry, 0, len(response.BuildLogs))
+
+	for _, log := range response.BuildLogs {
+		entries = append(entries, log.LogEntry)
+	}
+
+	logs, diags := logEntryModels(ctx, entries)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Logs = logs
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// logEntriesSchema is the schema of the log entries returned by the logs data sources.
+func logEntriesSchema(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"timestamp": schema.StringAttribute{
+					MarkdownDescription: "Time of the log entry, in RFC 3339 format.",
+					Computed:            true,
+				},
+				"message": schema.StringAttribute{
+					MarkdownDescription: "Message of the log entry.",
+					Computed:            true,
+				},
+				"severity": schema.StringAttribute{
+					MarkdownDescription: "Severity of the log entry, such as `info` or `err`.",
+					Computed:            true,
+				},
+				"attributes": schema.MapAttribute{
+					MarkdownDescription: "Attributes parsed from a structured log entry.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+			},
+		},
+	}
+}
+
+func logEntryModels(ctx context.Context, entries []LogEntry) ([]LogEntryModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	logs := make([]LogEntryModel, 0, len(entries))
+
+	for _, entry := range entries {
+		attributes := make(map[string]string, len(entry.Attributes))
+
+		for _, attribute := range entry.Attributes {
+			attributes[attribute.Key] = attribute.Value
+		}
+
+		attributesValue, attributesDiags := types.MapValueFrom(ctx, types.StringType, attributes)
+		diags.Append(attributesDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		logs = append(logs, LogEntryModel{
+			Timestamp:  types.StringValue(entry.Timestamp),
+			Message:    types.StringValue(entry.Message),
+			Severity:   types.StringPointerValue(entry.Severity),
+			Attributes: attributesValue,
+		})
+	}
+
+	return logs, diags
+}
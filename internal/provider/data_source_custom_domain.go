@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CustomDomainDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &CustomDomainDataSource{}
+
+func NewCustomDomainDataSource() datasource.DataSource {
+	return &CustomDomainDataSource{}
+}
+
+type CustomDomainDataSource struct {
+	client *graphql.Client
+}
+
+type CustomDomainDataSourceDnsRecordModel struct {
+	RecordType    types.String `tfsdk:"record_type"`
+	Hostname      types.String `tfsdk:"hostname"`
+	RequiredValue types.String `tfsdk:"required_value"`
+	CurrentValue  types.String `tfsdk:"current_value"`
+	Status        types.String `tfsdk:"status"`
+}
+
+type CustomDomainDataSourceModel struct {
+	Id            types.String                           `tfsdk:"id"`
+	ProjectId     types.String                           `tfsdk:"project_id"`
+	ServiceId     types.String                           `tfsdk:"service_id"`
+	EnvironmentId types.String                           `tfsdk:"environment_id"`
+	Domain        types.String                           `tfsdk:"domain"`
+	Status        types.String                           `tfsdk:"status"`
+	DnsRecords    []CustomDomainDataSourceDnsRecordModel `tfsdk:"dns_records"`
+}
+
+func (d *CustomDomainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_domain"
+}
+
+func (d *CustomDomainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Look up a Railway custom domain and the DNS records it needs, by ID and project, or by service, environment and domain.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_custom_domain" "www" {
+  service_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  domain         = "www.example.com"
+}
+
+resource "cloudflare_record" "www" {
+  for_each = { for record in data.railway_custom_domain.www.dns_records : record.hostname => record }
+
+  zone_id = var.cloudflare_zone_id
+  name    = each.value.hostname
+  type    = trimprefix(each.value.record_type, "DNS_RECORD_TYPE_")
+  value   = each.value.required_value
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the custom domain. Either this and `project_id`, or `service_id`, `environment_id` and `domain` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("project_id")),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID the custom domain belongs to.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Service ID the custom domain belongs to.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment ID the custom domain belongs to.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Custom domain name.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Certificate status of the custom domain, as reported by Railway.",
+				Computed:            true,
+			},
+			"dns_records": customDomainDnsRecordsSchema(),
+		},
+	}
+}
+
+func (d *CustomDomainDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("domain"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("service_id"),
+			path.MatchRoot("environment_id"),
+			path.MatchRoot("domain"),
+		),
+	}
+}
+
+func (d *CustomDomainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomDomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CustomDomainDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var customDomain *CustomDomainRecords
+
+	if !data.Id.IsNull() {
+		response, err := getCustomDomainRecords(ctx, *d.client, data.Id.ValueString(), data.ProjectId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom domain, got error: %s", err))
+			return
+		}
+
+		customDomain = &response.CustomDomain.CustomDomainRecords
+	} else {
+		// Railway looks domains up by project as well
+		service, err := getService(ctx, *d.client, data.ServiceId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
+			return
+		}
+
+		response, err := listCustomDomainRecords(ctx, *d.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), service.Service.ProjectId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom domains, got error: %s", err))
+			return
+		}
+
+		for _, domain := range response.Domains.CustomDomains {
+			if domain.Domain == data.Domain.ValueString() {
+				customDomain = &domain.CustomDomainRecords
+				break
+			}
+		}
+
+		if customDomain == nil {
+			resp.Diagnostics.AddError(
+				"Custom Domain Not Found",
+				fmt.Sprintf("No custom domain %q exists for service %s in environment %s.", data.Domain.ValueString(), data.ServiceId.ValueString(), data.EnvironmentId.ValueString()),
+			)
+			return
+		}
+
+		data.ProjectId = types.StringValue(service.Service.ProjectId)
+	}
+
+	data.Id = types.StringValue(customDomain.Id)
+	data.Domain = types.StringValue(customDomain.Domain)
+	data.ServiceId = types.StringValue(customDomain.ServiceId)
+	data.EnvironmentId = types.StringValue(customDomain.EnvironmentId)
+
+	if customDomain.ProjectId != "" {
+		data.ProjectId = types.StringValue(customDomain.ProjectId)
+	}
+
+	// Statuses are kept as Railway reports them, so new ones don't need a provider release
+	data.Status = types.StringValue(string(customDomain.Status.CertificateStatus))
+	data.DnsRecords = customDomainDnsRecords(customDomain)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func customDomainDnsRecordsSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "DNS records to create for the custom domain.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"record_type": schema.StringAttribute{
+					MarkdownDescription: "Type of the record, as reported by Railway, such as `DNS_RECORD_TYPE_CNAME`.",
+					Computed:            true,
+				},
+				"hostname": schema.StringAttribute{
+					MarkdownDescription: "Fully qualified name of the record.",
+					Computed:            true,
+				},
+				"required_value": schema.StringAttribute{
+					MarkdownDescription: "Value the record must have.",
+					Computed:            true,
+				},
+				"current_value": schema.StringAttribute{
+					MarkdownDescription: "Value Railway currently sees for the record.",
+					Computed:            true,
+				},
+				"status": schema.StringAttribute{
+					MarkdownDescription: "Status of the record, as reported by Railway.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func customDomainDnsRecords(customDomain *CustomDomainRecords) []CustomDomainDataSourceDnsRecordModel {
+	records := []CustomDomainDataSourceDnsRecordModel{}
+
+	for _, record := range customDomain.Status.DnsRecords {
+		records = append(records, CustomDomainDataSourceDnsRecordModel{
+			RecordType:    types.StringValue(string(record.RecordType)),
+			Hostname:      types.StringValue(record.Fqdn),
+			RequiredValue: types.StringValue(record.RequiredValue),
+			CurrentValue:  types.StringValue(record.CurrentValue),
+			Status:        types.StringValue(string(record.Status)),
+		})
+	}
+
+	return records
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CustomDomainsDataSource{}
+
+func NewCustomDomainsDataSource() datasource.DataSource {
+	return &CustomDomainsDataSource{}
+}
+
+type CustomDomainsDataSource struct {
+	client *graphql.Client
+}
+
+type CustomDomainsDataSourceDomainModel struct {
+	Id            types.String                           `tfsdk:"id"`
+	Domain        types.String                           `tfsdk:"domain"`
+	ServiceId     types.String                           `tfsdk:"service_id"`
+	EnvironmentId types.String                           `tfsdk:"environment_id"`
+	Status        types.String                           `tfsdk:"status"`
+	DnsRecords    []CustomDomainDataSourceDnsRecordModel `tfsdk:"dns_records"`
+}
+
+type CustomDomainsDataSourceModel struct {
+	ProjectId     types.String                         `tfsdk:"project_id"`
+	EnvironmentId types.String                         `tfsdk:"environment_id"`
+	CustomDomains []CustomDomainsDataSourceDomainModel `tfsdk:"custom_domains"`
+}
+
+func (d *CustomDomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_domains"
+}
+
+func (d *CustomDomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the Railway custom domains of a project, sorted by domain.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_custom_domains" "production" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+output "custom_domains" {
+  value = [for domain in data.railway_custom_domains.production.custom_domains : domain.domain]
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID to list the custom domains of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the custom domains of this environment.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"custom_domains": schema.ListNestedAttribute{
+				MarkdownDescription: "Custom domains of the project, sorted by domain.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the custom domain.",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Custom domain name.",
+							Computed:            true,
+						},
+						"service_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the service the custom domain points to.",
+							Computed:            true,
+						},
+						"environment_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the environment the custom domain belongs to.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Certificate status of the custom domain, as reported by Railway.",
+							Computed:            true,
+						},
+						"dns_records": customDomainDnsRecordsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomDomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomDomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CustomDomainsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := data.ProjectId.ValueString()
+	environmentIds := []string{data.EnvironmentId.ValueString()}
+
+	if data.EnvironmentId.IsNull() {
+		environments, err := listAllEnvironments(ctx, *d.client, projectId, nil)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environments, got error: %s", err))
+			return
+		}
+
+		environmentIds = make([]string, 0, len(environments))
+
+		for _, environment := range environments {
+			environmentIds = append(environmentIds, environment.Id)
+		}
+	}
+
+	customDomains := []CustomDomainsDataSourceDomainModel{}
+
+	for _, environmentId := range environmentIds {
+		domains, err := listAllEnvironmentCustomDomains(ctx, *d.client, projectId, environmentId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom domains, got error: %s", err))
+			return
+		}
+
+		for _, domain := range domains {
+			customDomains = append(customDomains, CustomDomainsDataSourceDomainModel{
+				Id:            types.StringValue(domain.Id),
+				Domain:        types.StringValue(domain.Domain),
+				ServiceId:     types.StringValue(domain.ServiceId),
+				EnvironmentId: types.StringValue(domain.EnvironmentId),
+				Status:        types.StringValue(string(domain.Status.CertificateStatus)),
+				DnsRecords:    customDomainDnsRecords(&domain),
+			})
+		}
+	}
+
+	sort.Slice(customDomains, func(i, j int) bool {
+		if customDomains[i].Domain.ValueString() != customDomains[j].Domain.ValueString() {
+			return customDomains[i].Domain.ValueString() < customDomains[j].Domain.ValueString()
+		}
+
+		return customDomains[i].Id.ValueString() < customDomains[j].Id.ValueString()
+	})
+
+	data.CustomDomains = customDomains
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAllEnvironmentCustomDomains reads the custom domains of every service instance in an environment.
+func listAllEnvironmentCustomDomains(ctx context.Context, client graphql.Client, projectId string, environmentId string) ([]CustomDomainRecords, error) {
+	var domains []CustomDomainRecords
+	var after *string
+
+	for {
+		response, err := listEnvironmentCustomDomainRecords(ctx, client, environmentId, projectId, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		connection := response.Environment.ServiceInstances
+
+		for _, edge := range connection.Edges {
+			for _, domain := range edge.Node.Domains.CustomDomains {
+				domains = append(domains, domain.CustomDomainRecords)
+			}
+		}
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			return domains, nil
+		}
+
+		after = &connection.PageInfo.EndCursor
+	}
+}
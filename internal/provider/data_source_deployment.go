@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DeploymentDataSource{}
+
+func NewDeploymentDataSource() datasource.DataSource {
+	return &DeploymentDataSource{}
+}
+
+type DeploymentDataSource struct {
+	client *graphql.Client
+}
+
+type DeploymentDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Status        types.String `tfsdk:"status"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	Url           types.String `tfsdk:"url"`
+	Image         types.String `tfsdk:"image"`
+	CommitHash    types.String `tfsdk:"commit_hash"`
+}
+
+func (d *DeploymentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (d *DeploymentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Look up a Railway deployment by ID.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_deployment" "previous" {
+  id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+output "previous_image" {
+  value = data.railway_deployment.previous.image
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Deployment identifier.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the deployment.",
+				Computed:            true,
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the deployment belongs to.",
+				Computed:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment the deployment belongs to.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Time the deployment was created, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the deployment, if it has one.",
+				Computed:            true,
+			},
+			"image": schema.StringAttribute{
+				MarkdownDescription: "Image that was deployed, for services deployed from an image.",
+				Computed:            true,
+			},
+			"commit_hash": schema.StringAttribute{
+				MarkdownDescription: "Commit that was deployed, for services deployed from a repository.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DeploymentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getDeployment(ctx, *d.client, data.Id.ValueString())
+
+	if err != nil {
+		if isNotAuthorizedError(err) {
+			resp.Diagnostics.AddError(
+				"Insufficient Token Permissions",
+				fmt.Sprintf("The railway token is not allowed to read deployment %s, it belongs to a project the token has no access to.", data.Id.ValueString()),
+			)
+			return
+		}
+
+		if isNotFoundError(err) {
+			resp.Diagnostics.AddError("Deployment Not Found", fmt.Sprintf("No deployment with ID %s exists.", data.Id.ValueString()))
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment, got error: %s", err))
+		return
+	}
+
+	deployment := response.Deployment
+
+	data.Status = types.StringValue(string(deployment.Status))
+	data.ServiceId = optionalString(deployment.ServiceId)
+	data.EnvironmentId = types.StringValue(deployment.EnvironmentId)
+	data.CreatedAt = types.StringValue(deployment.CreatedAt.Format(time.RFC3339))
+	data.Url = optionalString(deployment.Url)
+	data.Image = deploymentMetaString(deployment.Meta, "image")
+	data.CommitHash = deploymentMetaString(deployment.Meta, "commitHash")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DeploymentsDataSource{}
+
+const (
+	deploymentsDefaultLimit = 10
+	deploymentsPageSize     = 50
+)
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+type DeploymentsDataSource struct {
+	client *graphql.Client
+}
+
+type DeploymentsDataSourceDeploymentModel struct {
+	Id         types.String `tfsdk:"id"`
+	Status     types.String `tfsdk:"status"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	Url        types.String `tfsdk:"url"`
+	Image      types.String `tfsdk:"image"`
+	CommitHash types.String `tfsdk:"commit_hash"`
+}
+
+type DeploymentsDataSourceModel struct {
+	ServiceId     types.String                           `tfsdk:"service_id"`
+	EnvironmentId types.String                           `tfsdk:"environment_id"`
+	Status        types.String                           `tfsdk:"status"`
+	Limit         types.Int64                            `tfsdk:"limit"`
+	Deployments   []DeploymentsDataSourceDeploymentModel `tfsdk:"deployments"`
+}
+
+func (d *DeploymentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *DeploymentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the most recent Railway deployments of a service in an environment, newest first.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_deployments" "api" {
+  service_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  limit          = 5
+}
+
+output "deployment_statuses" {
+  value = [for deployment in data.railway_deployments.api.deployments : deployment.status]
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Service ID to list the deployments of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment ID to list the deployments of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only list deployments with this status, such as `SUCCESS` or `FAILED`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(deploymentStatuses()...),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of deployments to list. **Default** `%d`.", deploymentsDefaultLimit),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"deployments": schema.ListNestedAttribute{
+				MarkdownDescription: "Deployments, newest first. Empty when the service has never been deployed.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Deployment identifier.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the deployment.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Time the deployment was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL of the deployment, if it has one.",
+							Computed:            true,
+						},
+						"image": schema.StringAttribute{
+							MarkdownDescription: "Image that was deployed, for services deployed from an image.",
+							Computed:            true,
+						},
+						"commit_hash": schema.StringAttribute{
+							MarkdownDescription: "Commit that was deployed, for services deployed from a repository.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := deploymentsDefaultLimit
+
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	input := DeploymentListInput{
+		ServiceId:     data.ServiceId.ValueString(),
+		EnvironmentId: data.EnvironmentId.ValueString(),
+	}
+
+	if !data.Status.IsNull() {
+		input.Status = &DeploymentStatusInput{
+			In: []DeploymentStatus{DeploymentStatus(data.Status.ValueString())},
+		}
+	}
+
+	var nodes []listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment
+	var after *string
+
+	for len(nodes) < limit {
+		response, err := listDeployments(ctx, *d.client, input, min(limit-len(nodes), deploymentsPageSize), after)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployments, got error: %s", err))
+			return
+		}
+
+		connection := response.Deployments
+
+		for _, edge := range connection.Edges {
+			nodes = append(nodes, edge.Node)
+		}
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			break
+		}
+
+		after = &connection.PageInfo.EndCursor
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].CreatedAt.After(nodes[j].CreatedAt)
+	})
+
+	if len(nodes) > limit {
+		nodes = nodes[:limit]
+	}
+
+	deployments := make([]DeploymentsDataSourceDeploymentModel, 0, len(nodes))
+
+	for _, node := range nodes {
+		deployments = append(deployments, DeploymentsDataSourceDeploymentModel{
+			Id:         types.StringValue(node.Id),
+			Status:     types.StringValue(string(node.Status)),
+			CreatedAt:  types.StringValue(node.CreatedAt.Format(time.RFC3339)),
+			Url:        optionalString(node.Url),
+			Image:      deploymentMetaString(node.Meta, "image"),
+			CommitHash: deploymentMetaString(node.Meta, "commitHash"),
+		})
+	}
+
+	data.Deployments = deployments
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func deploymentStatuses() []string {
+	return []string{
+		string(DeploymentStatusBuilding),
+		string(DeploymentStatusCrashed),
+		string(DeploymentStatusDeploying),
+		string(DeploymentStatusFailed),
+		string(DeploymentStatusInitializing),
+		string(DeploymentStatusNeedsApproval),
+		string(DeploymentStatusQueued),
+		string(DeploymentStatusRemoved),
+		string(DeploymentStatusRemoving),
+		string(DeploymentStatusSkipped),
+		string(DeploymentStatusSleeping),
+		string(DeploymentStatusSuccess),
+		string(DeploymentStatusWaiting),
+	}
+}
+
+// deploymentMetaString reads a string from the deployment metadata, which only has the keys relevant to the source.
+func deploymentMetaString(meta map[string]interface{}, key string) types.String {
+	value, ok := meta[key].(string)
+
+	if !ok {
+		return types.StringNull()
+	}
+
+	return optionalString(value)
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainsDataSource{}
+
+func NewDomainsDataSource() datasource.DataSource {
+	return &DomainsDataSource{}
+}
+
+type DomainsDataSource struct {
+	client *graphql.Client
+}
+
+type DomainsDataSourceDomainModel struct {
+	Id         types.String `tfsdk:"id"`
+	Domain     types.String `tfsdk:"domain"`
+	TargetPort types.Int64  `tfsdk:"target_port"`
+}
+
+type DomainsDataSourceModel struct {
+	ServiceId      types.String                   `tfsdk:"service_id"`
+	EnvironmentId  types.String                   `tfsdk:"environment_id"`
+	ServiceDomains []DomainsDataSourceDomainModel `tfsdk:"service_domains"`
+	CustomDomains  []DomainsDataSourceDomainModel `tfsdk:"custom_domains"`
+}
+
+func (d *DomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+func (d *DomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the Railway service domains and custom domains of a service in an environment, sorted by domain.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_domains" "api" {
+  service_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+output "domains" {
+  value = concat(
+    [for domain in data.railway_domains.api.service_domains : domain.domain],
+    [for domain in data.railway_domains.api.custom_domains : domain.domain],
+  )
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Service ID to list the domains of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment ID to list the domains of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"service_domains": schema.ListNestedAttribute{
+				MarkdownDescription: "Domains generated by Railway, such as `*.up.railway.app`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Domain identifier.",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Domain name.",
+							Computed:            true,
+						},
+						"target_port": schema.Int64Attribute{
+							MarkdownDescription: "Port the domain routes to, null when Railway picks it.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"custom_domains": schema.ListNestedAttribute{
+				MarkdownDescription: "Custom domains.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Domain identifier.",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Domain name.",
+							Computed:            true,
+						},
+						"target_port": schema.Int64Attribute{
+							MarkdownDescription: "Port the domain routes to, null when Railway picks it.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Railway looks domains up by project as well
+	service, err := getService(ctx, *d.client, data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
+		return
+	}
+
+	response, err := listDomains(ctx, *d.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), service.Service.ProjectId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read domains, got error: %s", err))
+		return
+	}
+
+	data.ServiceDomains = []DomainsDataSourceDomainModel{}
+
+	for _, domain := range response.Domains.ServiceDomains {
+		data.ServiceDomains = append(data.ServiceDomains, newDomainsDataSourceDomainModel(domain.Id, domain.Domain, domain.TargetPort))
+	}
+
+	data.CustomDomains = []DomainsDataSourceDomainModel{}
+
+	for _, domain := range response.Domains.CustomDomains {
+		data.CustomDomains = append(data.CustomDomains, newDomainsDataSourceDomainModel(domain.Id, domain.Domain, domain.TargetPort))
+	}
+
+	sortDomainsDataSourceDomains(data.ServiceDomains)
+	sortDomainsDataSourceDomains(data.CustomDomains)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newDomainsDataSourceDomainModel(id string, domain string, targetPort *int) DomainsDataSourceDomainModel {
+	model := DomainsDataSourceDomainModel{
+		Id:         types.StringValue(id),
+		Domain:     types.StringValue(domain),
+		TargetPort: types.Int64Null(),
+	}
+
+	if targetPort != nil {
+		model.TargetPort = types.Int64Value(int64(*targetPort))
+	}
+
+	return model
+}
+
+func sortDomainsDataSourceDomains(domains []DomainsDataSourceDomainModel) {
+	sort.Slice(domains, func(i, j int) bool {
+		if domains[i].Domain.ValueString() != domains[j].Domain.ValueString() {
+			return domains[i].Domain.ValueString() < domains[j].Domain.ValueString()
+		}
+
+		return domains[i].Id.ValueString() < domains[j].Id.ValueString()
+	})
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DotenvDataSource{}
+
+func NewDotenvDataSource() datasource.DataSource {
+	return &DotenvDataSource{}
+}
+
+type DotenvDataSource struct{}
+
+type DotenvDataSourceModel struct {
+	Content   types.String `tfsdk:"content"`
+	Variables types.Map    `tfsdk:"variables"`
+}
+
+func (d *DotenvDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dotenv"
+}
+
+func (d *DotenvDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Parse the content of a dotenv file into variables. Supports comments, blank lines, ` + "`export`" + ` prefixes, single and double quotes, and escaped newlines in double quotes.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_dotenv" "production" {
+  content = file("${path.module}/.env.production")
+}
+
+resource "railway_variable_collection" "api" {
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  service_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+  variables = [
+    for name, value in merge(data.railway_dotenv.production.variables, { LOG_LEVEL = "info" }) : {
+      name  = name
+      value = value
+    }
+  ]
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content of the dotenv file, usually read with `file()`.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"variables": schema.MapAttribute{
+				MarkdownDescription: "Variables of the dotenv file, keyed by name. When a name is repeated, the last value wins.",
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *DotenvDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DotenvDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, err := parseDotenv(data.Content.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Invalid Dotenv Content", err.Error())
+		return
+	}
+
+	variablesValue, diags := types.MapValueFrom(ctx, types.StringType, variables)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Variables = variablesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+var dotenvNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// parseDotenv parses dotenv content into variables. Errors report the line they happened on.
+func parseDotenv(content string) (map[string]string, error) {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	variables := map[string]string{}
+
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimSpace(lines[i])
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if rest, ok := strings.CutPrefix(line, "export "); ok {
+			line = strings.TrimSpace(rest)
+		}
+
+		name, value, ok := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected NAME=VALUE", lineNumber)
+		}
+
+		if !dotenvNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", lineNumber, name)
+		}
+
+		value = strings.TrimSpace(value)
+
+		if value == "" || (value[0] != '"' && value[0] != '\'') {
+			// Unquoted values end at an inline comment
+			if index := strings.Index(value, " #"); index >= 0 {
+				value = strings.TrimSpace(value[:index])
+			}
+
+			variables[name] = value
+			continue
+		}
+
+		quote := value[0]
+		quoted := value[1:]
+
+		// Quoted values may span multiple lines until the closing quote
+		end := closingQuote(quoted, quote)
+
+		for end < 0 && i+1 < len(lines) {
+			i++
+			quoted += "\n" + lines[i]
+			end = closingQuote(quoted, quote)
+		}
+
+		if end < 0 {
+			return nil, fmt.Errorf("line %d: unterminated quoted value of %s", lineNumber, name)
+		}
+
+		if trailing := strings.TrimSpace(quoted[end+1:]); trailing != "" && !strings.HasPrefix(trailing, "#") {
+			return nil, fmt.Errorf("line %d: unexpected characters after the quoted value of %s", i+1, name)
+		}
+
+		value = quoted[:end]
+
+		if quote == '"' {
+			value = unescapeDotenv(value)
+		}
+
+		variables[name] = value
+	}
+
+	return variables, nil
+}
+
+// closingQuote returns the index of the unescaped closing quote in value, or -1 when there is none. Single quoted
+// values have no escapes.
+func closingQuote(value string, quote byte) int {
+	for i := 0; i < len(value); i++ {
+		if quote == '"' && value[i] == '\\' {
+			i++
+			continue
+		}
+
+		if value[i] == quote {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func unescapeDotenv(value string) string {
+	var builder strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i+1 == len(value) {
+			builder.WriteByte(value[i])
+			continue
+		}
+
+		i++
+
+		switch value[i] {
+		case 'n':
+			builder.WriteByte('\n')
+		case 'r':
+			builder.WriteByte('\r')
+		case 't':
+			builder.WriteByte('\t')
+		case '"', '\\', '$':
+			builder.WriteByte(value[i])
+		default:
+			// Unknown escapes are kept as is
+			builder.WriteByte('\\')
+			builder.WriteByte(value[i])
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseDotenv(t *testing.T) {
+	testCases := map[string]struct {
+		content     string
+		expected    map[string]string
+		expectError string
+	}{
+		"plain": {
+			content: "# Defaults\n\nPORT=3000\nexport LOG_LEVEL = info # verbose enough\nEMPTY=\nURL=https://example.com/#anchor\n",
+			expected: map[string]string{
+				"PORT":      "3000",
+				"LOG_LEVEL": "info",
+				"EMPTY":     "",
+				"URL":       "https://example.com/#anchor",
+			},
+		},
+		"quoted": {
+			content: "GREETING=\"hello # world\"\nLITERAL='no \\n escapes'\nESCAPED=\"line one\\nline two \\\"quoted\\\"\" # comment\n",
+			expected: map[string]string{
+				"GREETING": "hello # world",
+				"LITERAL":  "no \\n escapes",
+				"ESCAPED":  "line one\nline two \"quoted\"",
+			},
+		},
+		"multiline": {
+			content: "KEY=\"-----BEGIN KEY-----\nabc\n-----END KEY-----\"\r\nNEXT=1\r\n",
+			expected: map[string]string{
+				"KEY":  "-----BEGIN KEY-----\nabc\n-----END KEY-----",
+				"NEXT": "1",
+			},
+		},
+		"repeated": {
+			content:  "NAME=first\nNAME=second\n",
+			expected: map[string]string{"NAME": "second"},
+		},
+		"missing equals": {
+			content:     "PORT=3000\n\nLOG_LEVEL\n",
+			expectError: "line 3: expected NAME=VALUE",
+		},
+		"invalid name": {
+			content:     "1PORT=3000\n",
+			expectError: "line 1: invalid variable name",
+		},
+		"unterminated": {
+			content:     "PORT=3000\nKEY=\"abc\nNEXT=1\n",
+			expectError: "line 2: unterminated quoted value of KEY",
+		},
+		"trailing characters": {
+			content:     "KEY='abc' def\n",
+			expectError: "line 1: unexpected characters",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			variables, err := parseDotenv(testCase.content)
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(variables, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, variables)
+			}
+		})
+	}
+}
@@ -3,16 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &EnvironmentDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &EnvironmentDataSource{}
 
 func NewEnvironmentDataSource() datasource.DataSource {
 	return &EnvironmentDataSource{}
@@ -22,10 +29,20 @@ type EnvironmentDataSource struct {
 	client *graphql.Client
 }
 
+type EnvironmentDataSourceServiceInstanceModel struct {
+	ServiceId   types.String `tfsdk:"service_id"`
+	ServiceName types.String `tfsdk:"service_name"`
+}
+
 type EnvironmentDataSourceModel struct {
-	Id        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	ProjectId types.String `tfsdk:"project_id"`
+	Id               types.String                                `tfsdk:"id"`
+	Name             types.String                                `tfsdk:"name"`
+	ProjectId        types.String                                `tfsdk:"project_id"`
+	IsEphemeral      types.Bool                                  `tfsdk:"is_ephemeral"`
+	CreatedAt        types.String                                `tfsdk:"created_at"`
+	ServiceInstances []EnvironmentDataSourceServiceInstanceModel `tfsdk:"service_instances"`
+
+	CaseInsensitive types.Bool `tfsdk:"case_insensitive"`
 }
 
 func (d *EnvironmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -34,7 +51,7 @@ func (d *EnvironmentDataSource) Metadata(ctx context.Context, req datasource.Met
 
 func (d *EnvironmentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `Look up an existing Railway environment by ID.
+		MarkdownDescription: `Look up an existing Railway environment by ID, or by project and name.
 
 ## Example Usage
 
@@ -50,28 +67,86 @@ output "environment_name" {
 output "project_id" {
   value = data.railway_environment.production.project_id
 }
+
+data "railway_environment" "staging" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "staging"
+}
 ` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "Environment identifier.",
-				Required:            true,
+				MarkdownDescription: "Environment identifier. Either this or `project_id` and `name` must be set.",
+				Optional:            true,
+				Computed:            true,
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Environment name.",
+				MarkdownDescription: "Environment name, used with `project_id` to look the environment up.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
 			},
 			"project_id": schema.StringAttribute{
-				MarkdownDescription: "Project ID the environment belongs to.",
+				MarkdownDescription: "Project ID the environment belongs to, used with `name` to look the environment up.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"is_ephemeral": schema.BoolAttribute{
+				MarkdownDescription: "Whether the environment is ephemeral, such as the ones created for pull requests.",
 				Computed:            true,
 			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Time the environment was created, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"service_instances": schema.ListNestedAttribute{
+				MarkdownDescription: "Services deployed in the environment, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the service.",
+							Computed:            true,
+						},
+						"service_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the service.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"case_insensitive": schema.BoolAttribute{
+				MarkdownDescription: "Whether to match `name` ignoring case. **Default** `false`.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
+			},
 		},
 	}
 }
 
+func (d *EnvironmentDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("project_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *EnvironmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -99,17 +174,78 @@ func (d *EnvironmentDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	response, err := getEnvironment(ctx, *d.client, data.Id.ValueString())
+	environmentId := data.Id.ValueString()
+
+	if data.Id.IsNull() {
+		environments, err := listAllEnvironments(ctx, *d.client, data.ProjectId.ValueString(), nil)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environments, got error: %s", err))
+			return
+		}
+
+		var matches []listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment
+
+		for _, environment := range environments {
+			if environment.Name == data.Name.ValueString() || (data.CaseInsensitive.ValueBool() && strings.EqualFold(environment.Name, data.Name.ValueString())) {
+				matches = append(matches, environment)
+			}
+		}
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError(
+				"Environment Not Found",
+				fmt.Sprintf("No environment named %q exists in project %s.", data.Name.ValueString(), data.ProjectId.ValueString()),
+			)
+			return
+		}
+
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple Environments Found",
+				fmt.Sprintf("Found %d environments named %q in project %s, expected one.", len(matches), data.Name.ValueString(), data.ProjectId.ValueString()),
+			)
+			return
+		}
+
+		environmentId = matches[0].Id
+	}
+
+	response, err := getEnvironment(ctx, *d.client, environmentId)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
 		return
 	}
 
-	environment := response.Environment.Environment
+	environment := response.Environment
 
+	data.Id = types.StringValue(environment.Id)
 	data.Name = types.StringValue(environment.Name)
 	data.ProjectId = types.StringValue(environment.ProjectId)
+	data.IsEphemeral = types.BoolValue(environment.IsEphemeral)
+	data.CreatedAt = types.StringNull()
+
+	if !environment.CreatedAt.IsZero() {
+		data.CreatedAt = types.StringValue(environment.CreatedAt.Format(time.RFC3339))
+	}
+
+	data.ServiceInstances = []EnvironmentDataSourceServiceInstanceModel{}
+
+	for _, edge := range environment.ServiceInstances.Edges {
+		data.ServiceInstances = append(data.ServiceInstances, EnvironmentDataSourceServiceInstanceModel{
+			ServiceId:   types.StringValue(edge.Node.ServiceId),
+			ServiceName: types.StringValue(edge.Node.ServiceName),
+		})
+	}
+
+	sort.Slice(data.ServiceInstances, func(i, j int) bool {
+		if data.ServiceInstances[i].ServiceName.ValueString() != data.ServiceInstances[j].ServiceName.ValueString() {
+			return data.ServiceInstances[i].ServiceName.ValueString() < data.ServiceInstances[j].ServiceName.ValueString()
+		}
+
+		return data.ServiceInstances[i].ServiceId.ValueString() < data.ServiceInstances[j].ServiceId.ValueString()
+	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
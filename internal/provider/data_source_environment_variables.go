@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EnvironmentVariablesDataSource{}
+
+// environmentVariablesSharedKey holds the shared variables next to the services.
+const environmentVariablesSharedKey = "_shared"
+
+func NewEnvironmentVariablesDataSource() datasource.DataSource {
+	return &EnvironmentVariablesDataSource{}
+}
+
+type EnvironmentVariablesDataSource struct {
+	client *graphql.Client
+}
+
+type EnvironmentVariablesDataSourceModel struct {
+	ProjectId     types.String `tfsdk:"project_id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	Variables     types.Map    `tfsdk:"variables"`
+}
+
+func (d *EnvironmentVariablesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variables"
+}
+
+func (d *EnvironmentVariablesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the variables of every service in a Railway environment, along with the shared variables of the environment.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_environment_variables" "development" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+resource "local_sensitive_file" "api_env" {
+  filename = "api.env"
+  content = join("\n", [
+    for name, value in merge(
+      data.railway_environment_variables.development.variables["_shared"],
+      data.railway_environment_variables.development.variables["api"],
+    ) : "${name}=${value}"
+  ])
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID the environment belongs to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment ID to read the variables of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"variables": schema.MapAttribute{
+				MarkdownDescription: fmt.Sprintf("Variables keyed by service name and then variable name. The shared variables of the environment are under `%s`. Values are unrendered, so references to other variables are kept as is.", environmentVariablesSharedKey),
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.MapType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+func (d *EnvironmentVariablesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EnvironmentVariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EnvironmentVariablesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := data.ProjectId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+
+	environment, err := getEnvironment(ctx, *d.client, environmentId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+
+	shared, err := getSharedVariables(ctx, *d.client, projectId, environmentId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared variables, got error: %s", err))
+		return
+	}
+
+	variables := map[string]map[string]string{
+		environmentVariablesSharedKey: stringifyVariables(shared.Variables),
+	}
+
+	// Railway only returns variable values per service, so this is one request per service, made one at a time
+	for _, edge := range environment.Environment.ServiceInstances.Edges {
+		instance := edge.Node
+
+		if _, ok := variables[instance.ServiceName]; ok {
+			resp.Diagnostics.AddError(
+				"Duplicate Service Name",
+				fmt.Sprintf("Environment %s has more than one service named %q, or one named %q, so they can't be keyed by name.", environmentId, instance.ServiceName, environmentVariablesSharedKey),
+			)
+			return
+		}
+
+		response, err := getVariables(ctx, *d.client, projectId, environmentId, instance.ServiceId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variables of service %s, got error: %s", instance.ServiceId, err))
+			return
+		}
+
+		variables[instance.ServiceName] = stringifyVariables(response.Variables)
+	}
+
+	variablesValue, diags := types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, variables)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Variables = variablesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func stringifyVariables(variables map[string]interface{}) map[string]string {
+	values := make(map[string]string, len(variables))
+
+	for name, value := range variables {
+		values[name] = fmt.Sprintf("%v", value)
+	}
+
+	return values
+}
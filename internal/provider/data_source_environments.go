@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EnvironmentsDataSource{}
+
+func NewEnvironmentsDataSource() datasource.DataSource {
+	return &EnvironmentsDataSource{}
+}
+
+type EnvironmentsDataSource struct {
+	client *graphql.Client
+}
+
+type EnvironmentsDataSourceEnvironmentModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	IsEphemeral types.Bool   `tfsdk:"is_ephemeral"`
+}
+
+type EnvironmentsDataSourceModel struct {
+	ProjectId        types.String                             `tfsdk:"project_id"`
+	ExcludeEphemeral types.Bool                               `tfsdk:"exclude_ephemeral"`
+	Environments     []EnvironmentsDataSourceEnvironmentModel `tfsdk:"environments"`
+}
+
+func (d *EnvironmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environments"
+}
+
+func (d *EnvironmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the Railway environments of a project, sorted by name.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_environments" "all" {
+  project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  exclude_ephemeral = true
+}
+
+output "environment_ids" {
+  value = { for environment in data.railway_environments.all.environments : environment.name => environment.id }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID to list the environments of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"exclude_ephemeral": schema.BoolAttribute{
+				MarkdownDescription: "Whether to leave out ephemeral environments, such as the ones created for pull requests. **Default** `false`.",
+				Optional:            true,
+			},
+			"environments": schema.ListNestedAttribute{
+				MarkdownDescription: "Environments of the project, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Environment identifier.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Environment name.",
+							Computed:            true,
+						},
+						"is_ephemeral": schema.BoolAttribute{
+							MarkdownDescription: "Whether the environment is ephemeral, such as the ones created for pull requests.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EnvironmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EnvironmentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Railway filters on the flag, leaving it out returns every environment
+	var isEphemeral *bool
+
+	if data.ExcludeEphemeral.ValueBool() {
+		isEphemeral = new(bool)
+	}
+
+	nodes, err := listAllEnvironments(ctx, *d.client, data.ProjectId.ValueString(), isEphemeral)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environments, got error: %s", err))
+		return
+	}
+
+	environments := make([]EnvironmentsDataSourceEnvironmentModel, 0, len(nodes))
+
+	for _, node := range nodes {
+		environments = append(environments, EnvironmentsDataSourceEnvironmentModel{
+			Id:          types.StringValue(node.Id),
+			Name:        types.StringValue(node.Name),
+			IsEphemeral: types.BoolValue(node.IsEphemeral),
+		})
+	}
+
+	sort.Slice(environments, func(i, j int) bool {
+		if environments[i].Name.ValueString() != environments[j].Name.ValueString() {
+			return environments[i].Name.ValueString() < environments[j].Name.ValueString()
+		}
+
+		return environments[i].Id.ValueString() < environments[j].Id.ValueString()
+	})
+
+	data.Environments = environments
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAllEnvironments reads every page of the environments of a project.
+func listAllEnvironments(ctx context.Context, client graphql.Client, projectId string, isEphemeral *bool) ([]listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment, error) {
+	var environments []listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment
+	var after *string
+
+	for {
+		response, err := listEnvironments(ctx, client, projectId, isEphemeral, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		connection := response.Environments
+
+		for _, edge := range connection.Edges {
+			environments = append(environments, edge.Node)
+		}
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			return environments, nil
+		}
+
+		after = &connection.PageInfo.EndCursor
+	}
+}
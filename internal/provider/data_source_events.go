@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &EventsDataSource{}
+
+const (
+	eventsDefaultLimit = 50
+	eventsPageSize     = 50
+)
+
+func NewEventsDataSource() datasource.DataSource {
+	return &EventsDataSource{}
+}
+
+type EventsDataSource struct {
+	client *graphql.Client
+}
+
+type EventsDataSourceEventModel struct {
+	Id            types.String `tfsdk:"id"`
+	Action        types.String `tfsdk:"action"`
+	Object        types.String `tfsdk:"object"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	Payload       types.String `tfsdk:"payload"`
+}
+
+type EventsDataSourceModel struct {
+	ProjectId     types.String                 `tfsdk:"project_id"`
+	EnvironmentId types.String                 `tfsdk:"environment_id"`
+	After         types.String                 `tfsdk:"after"`
+	Limit         types.Int64                  `tfsdk:"limit"`
+	Events        []EventsDataSourceEventModel `tfsdk:"events"`
+}
+
+func (d *EventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_events"
+}
+
+func (d *EventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the most recent Railway events of a project, newest first.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_events" "production" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  after          = "2024-01-01T00:00:00Z"
+  limit          = 100
+}
+
+output "actions" {
+  value = [for event in data.railway_events.production.events : "${event.created_at} ${event.object} ${event.action}"]
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID to list the events of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the events of this environment.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"after": schema.StringAttribute{
+				MarkdownDescription: "Only list events created after this time, in RFC 3339 format.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of events to list. **Default** `%d`.", eventsDefaultLimit),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"events": schema.ListNestedAttribute{
+				MarkdownDescription: "Events, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Event identifier.",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Action that happened, such as `create` or `delete`.",
+							Computed:            true,
+						},
+						"object": schema.StringAttribute{
+							MarkdownDescription: "Type of the object the action happened to, such as `Service` or `Variable`.",
+							Computed:            true,
+						},
+						"environment_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the environment of the event, if any.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Time of the event, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"payload": schema.StringAttribute{
+							MarkdownDescription: "Details of the event as a JSON string, use `jsondecode` to read them.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EventsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var after time.Time
+
+	if !data.After.IsNull() {
+		var err error
+
+		after, err = time.Parse(time.RFC3339, data.After.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("after"), "Invalid After Time", fmt.Sprintf("Unable to parse after as an RFC 3339 time, got error: %s", err))
+			return
+		}
+	}
+
+	limit := eventsDefaultLimit
+
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	var nodes []listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent
+	var cursor *string
+
+	for len(nodes) < limit {
+		response, err := listEvents(ctx, *d.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueStringPointer(), min(limit-len(nodes), eventsPageSize), cursor)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read events, got error: %s", err))
+			return
+		}
+
+		connection := response.Events
+		reachedAfter := false
+
+		for _, edge := range connection.Edges {
+			if !after.IsZero() && !edge.Node.CreatedAt.After(after) {
+				reachedAfter = true
+				continue
+			}
+
+			nodes = append(nodes, edge.Node)
+		}
+
+		// Railway returns the newest events first, so older pages can't have newer events
+		if reachedAfter || !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			break
+		}
+
+		cursor = &connection.PageInfo.EndCursor
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].CreatedAt.After(nodes[j].CreatedAt)
+	})
+
+	if len(nodes) > limit {
+		nodes = nodes[:limit]
+	}
+
+	events := make([]EventsDataSourceEventModel, 0, len(nodes))
+
+	for _, node := range nodes {
+		payload := types.StringNull()
+
+		if node.Payload != nil {
+			encoded, err := json.Marshal(node.Payload)
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode event payload, got error: %s", err))
+				return
+			}
+
+			payload = types.StringValue(string(encoded))
+		}
+
+		events = append(events, EventsDataSourceEventModel{
+			Id:            types.StringValue(node.Id),
+			Action:        types.StringValue(node.Action),
+			Object:        types.StringValue(node.Object),
+			EnvironmentId: types.StringPointerValue(node.EnvironmentId),
+			CreatedAt:     types.StringValue(node.CreatedAt.Format(time.RFC3339)),
+			Payload:       payload,
+		})
+	}
+
+	data.Events = events
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &MetricsDataSource{}
+
+const (
+	metricsDefaultWindowHours = 24
+	// Railway keeps metrics for 30 days
+	metricsMaxWindowHours = 30 * 24
+	// Keep the series small enough for state while still showing spikes
+	metricsMaxSamples       = 500
+	metricsMinSampleSeconds = 60
+)
+
+// metricsMeasurements maps the measurement names of the data source to the Railway measurements.
+var metricsMeasurements = map[string]MetricMeasurement{
+	"cpu":        MetricMeasurementCpuUsage,
+	"memory":     MetricMeasurementMemoryUsageGb,
+	"network_rx": MetricMeasurementNetworkRxGb,
+	"network_tx": MetricMeasurementNetworkTxGb,
+}
+
+func NewMetricsDataSource() datasource.DataSource {
+	return &MetricsDataSource{}
+}
+
+type MetricsDataSource struct {
+	client *graphql.Client
+}
+
+type MetricsDataSourceSampleModel struct {
+	Timestamp types.String  `tfsdk:"timestamp"`
+	Value     types.Float64 `tfsdk:"value"`
+}
+
+type MetricsDataSourceModel struct {
+	ServiceId         types.String                   `tfsdk:"service_id"`
+	EnvironmentId     types.String                   `tfsdk:"environment_id"`
+	Measurement       types.String                   `tfsdk:"measurement"`
+	WindowHours       types.Int64                    `tfsdk:"window_hours"`
+	SampleRateSeconds types.Int64                    `tfsdk:"sample_rate_seconds"`
+	Samples           []MetricsDataSourceSampleModel `tfsdk:"samples"`
+	Avg               types.Float64                  `tfsdk:"avg"`
+	P95               types.Float64                  `tfsdk:"p95"`
+	Max               types.Float64                  `tfsdk:"max"`
+}
+
+func (d *MetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics"
+}
+
+func (d *MetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the resource usage of a Railway service in an environment over a recent time window.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_metrics" "api_memory" {
+  service_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  measurement    = "memory"
+  window_hours   = 168
+}
+
+resource "railway_service_limits" "api" {
+  service_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  memory_gb      = 2
+
+  lifecycle {
+    precondition {
+      condition     = coalesce(data.railway_metrics.api_memory.p95, 0) < 2
+      error_message = "The p95 memory usage of the api is above its limit."
+    }
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Service ID to read the metrics of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment ID to read the metrics of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"measurement": schema.StringAttribute{
+				MarkdownDescription: "What to measure, one of `cpu` (in vCPUs), `memory` (in GB), `network_rx` or `network_tx` (in GB).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("cpu", "memory", "network_rx", "network_tx"),
+				},
+			},
+			"window_hours": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of hours up to now to read the metrics of, at most %d. **Default** `%d`.", metricsMaxWindowHours, metricsDefaultWindowHours),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, metricsMaxWindowHours),
+				},
+			},
+			"sample_rate_seconds": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of seconds between samples, derived from the window so that there are at most %d samples.", metricsMaxSamples),
+				Computed:            true,
+			},
+			"samples": schema.ListNestedAttribute{
+				MarkdownDescription: "Samples of the measurement, oldest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "Time of the sample, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"value": schema.Float64Attribute{
+							MarkdownDescription: "Value of the sample.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"avg": schema.Float64Attribute{
+				MarkdownDescription: "Average of the samples. Null when there are no samples.",
+				Computed:            true,
+			},
+			"p95": schema.Float64Attribute{
+				MarkdownDescription: "95th percentile of the samples. Null when there are no samples.",
+				Computed:            true,
+			},
+			"max": schema.Float64Attribute{
+				MarkdownDescription: "Maximum of the samples. Null when there are no samples.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetricsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windowHours := int64(metricsDefaultWindowHours)
+
+	if !data.WindowHours.IsNull() {
+		windowHours = data.WindowHours.ValueInt64()
+	}
+
+	window := time.Duration(windowHours) * time.Hour
+	sampleRateSeconds := metricsSampleRateSeconds(window)
+	endDate := time.Now().UTC()
+
+	response, err := getServiceMetrics(
+		ctx,
+		*d.client,
+		data.ServiceId.ValueString(),
+		data.EnvironmentId.ValueString(),
+		metricsMeasurements[data.Measurement.ValueString()],
+		endDate.Add(-window),
+		endDate,
+		sampleRateSeconds,
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metrics, got error: %s", err))
+		return
+	}
+
+	var values []getServiceMetricsMetricsMetricsResultValuesMetric
+
+	for _, result := range response.Metrics {
+		values = append(values, result.Values...)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Ts < values[j].Ts
+	})
+
+	samples := make([]MetricsDataSourceSampleModel, 0, len(values))
+	series := make([]float64, 0, len(values))
+
+	for _, value := range values {
+		samples = append(samples, MetricsDataSourceSampleModel{
+			Timestamp: types.StringValue(time.Unix(int64(value.Ts), 0).UTC().Format(time.RFC3339)),
+			Value:     types.Float64Value(value.Value),
+		})
+
+		series = append(series, value.Value)
+	}
+
+	data.SampleRateSeconds = types.Int64Value(int64(sampleRateSeconds))
+	data.Samples = samples
+	data.Avg = types.Float64Null()
+	data.P95 = types.Float64Null()
+	data.Max = types.Float64Null()
+
+	if len(series) > 0 {
+		avg, p95, maximum := metricsAggregates(series)
+
+		data.Avg = types.Float64Value(avg)
+		data.P95 = types.Float64Value(p95)
+		data.Max = types.Float64Value(maximum)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// metricsSampleRateSeconds spreads the window over at most metricsMaxSamples samples, in whole minutes.
+func metricsSampleRateSeconds(window time.Duration) int {
+	seconds := int(math.Ceil(window.Seconds() / metricsMaxSamples))
+	minutes := (seconds + metricsMinSampleSeconds - 1) / metricsMinSampleSeconds
+
+	return max(minutes, 1) * metricsMinSampleSeconds
+}
+
+// metricsAggregates computes the average, the nearest-rank 95th percentile and the maximum of a non-empty series.
+func metricsAggregates(series []float64) (float64, float64, float64) {
+	sorted := append([]float64(nil), series...)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+
+	for _, value := range sorted {
+		sum += value
+	}
+
+	rank := int(math.Ceil(0.95 * float64(len(sorted))))
+
+	return sum / float64(len(sorted)), sorted[rank-1], sorted[len(sorted)-1]
+}
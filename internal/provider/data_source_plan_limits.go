@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PlanLimitsDataSource{}
+
+func NewPlanLimitsDataSource() datasource.DataSource {
+	return &PlanLimitsDataSource{}
+}
+
+type PlanLimitsDataSource struct {
+	client *graphql.Client
+}
+
+type PlanLimitsDataSourceModel struct {
+	ProjectId     types.String  `tfsdk:"project_id"`
+	Plan          types.String  `tfsdk:"plan"`
+	MaxVCPUs      types.Float64 `tfsdk:"max_vcpus"`
+	MaxMemoryGB   types.Float64 `tfsdk:"max_memory_gb"`
+	MaxReplicas   types.Int64   `tfsdk:"max_replicas"`
+	IncludedUsage types.Float64 `tfsdk:"included_usage"`
+	AppSleeping   types.Bool    `tfsdk:"app_sleeping"`
+	MultiRegion   types.Bool    `tfsdk:"multi_region"`
+}
+
+func (d *PlanLimitsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plan_limits"
+}
+
+func (d *PlanLimitsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the plan of the workspace a Railway project belongs to and its limits. Limits Railway doesn't report for the plan are null.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_plan_limits" "main" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+resource "railway_service_limits" "api" {
+  service_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  vcpus          = 8
+
+  lifecycle {
+    precondition {
+      condition     = data.railway_plan_limits.main.max_vcpus == null || data.railway_plan_limits.main.max_vcpus >= 8
+      error_message = "The plan does not allow 8 vCPU per service."
+    }
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID to read the plan limits of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "Name of the plan, such as `hobby` or `pro`.",
+				Computed:            true,
+			},
+			"max_vcpus": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of vCPUs per service.",
+				Computed:            true,
+			},
+			"max_memory_gb": schema.Float64Attribute{
+				MarkdownDescription: "Maximum memory per service in GB.",
+				Computed:            true,
+			},
+			"max_replicas": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of replicas per service.",
+				Computed:            true,
+			},
+			"included_usage": schema.Float64Attribute{
+				MarkdownDescription: "Usage included in the plan in USD.",
+				Computed:            true,
+			},
+			"app_sleeping": schema.BoolAttribute{
+				MarkdownDescription: "Whether the plan allows app sleeping.",
+				Computed:            true,
+			},
+			"multi_region": schema.BoolAttribute{
+				MarkdownDescription: "Whether the plan allows deploying replicas to multiple regions.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PlanLimitsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PlanLimitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlanLimitsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getProjectPlanLimits(ctx, *d.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read plan limits, got error: %s", err))
+		return
+	}
+
+	limits := response.Project.SubscriptionPlanLimit
+	maxMemoryGB, maxVCPUs := parseServiceInstanceLimits(limits)
+
+	data.Plan = types.StringValue(string(response.Project.SubscriptionType))
+	data.MaxVCPUs = types.Float64PointerValue(maxVCPUs)
+	data.MaxMemoryGB = types.Float64PointerValue(maxMemoryGB)
+	data.MaxReplicas = types.Int64Null()
+	data.IncludedUsage = types.Float64PointerValue(planLimitNumber(limits, "usage", "included"))
+	data.AppSleeping = types.BoolPointerValue(planLimitBool(limits, "features", "appSleeping"))
+	data.MultiRegion = types.BoolPointerValue(planLimitBool(limits, "features", "multiRegion"))
+
+	if maxReplicas := planLimitNumber(limits, "replicas", "max"); maxReplicas != nil {
+		data.MaxReplicas = types.Int64Value(int64(*maxReplicas))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// planLimitNumber reads a number nested under section in the plan limits, nil when Railway doesn't report it.
+func planLimitNumber(limits map[string]interface{}, section string, key string) *float64 {
+	values, ok := limits[section].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	value, ok := values[key].(float64)
+
+	if !ok {
+		return nil
+	}
+
+	return &value
+}
+
+// planLimitBool reads a flag nested under section in the plan limits, nil when Railway doesn't report it.
+func planLimitBool(limits map[string]interface{}, section string, key string) *bool {
+	values, ok := limits[section].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	value, ok := values[key].(bool)
+
+	if !ok {
+		return nil
+	}
+
+	return &value
+}
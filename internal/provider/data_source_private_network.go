@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PrivateNetworkDataSource{}
+
+func NewPrivateNetworkDataSource() datasource.DataSource {
+	return &PrivateNetworkDataSource{}
+}
+
+type PrivateNetworkDataSource struct {
+	client *graphql.Client
+}
+
+type PrivateNetworkDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	ProjectId     types.String `tfsdk:"project_id"`
+	DnsName       types.String `tfsdk:"dns_name"`
+	Tags          types.List   `tfsdk:"tags"`
+}
+
+func (d *PrivateNetworkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_network"
+}
+
+func (d *PrivateNetworkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Look up an existing Railway private network by environment and name.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_private_network" "internal" {
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name           = "internal"
+}
+
+resource "railway_private_network_endpoint" "api" {
+  private_network_id = data.railway_private_network.internal.id
+  service_id         = railway_service.api.id
+  environment_id     = data.railway_private_network.internal.environment_id
+  service_name       = "api"
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Public identifier of the private network.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the private network.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment ID the private network belongs to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID the private network belongs to.",
+				Computed:            true,
+			},
+			"dns_name": schema.StringAttribute{
+				MarkdownDescription: "DNS name for the private network.",
+				Computed:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Tags for the private network.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *PrivateNetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PrivateNetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PrivateNetworkDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getPrivateNetworks(ctx, *d.client, data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private networks, got error: %s", err))
+		return
+	}
+
+	var matches []getPrivateNetworksPrivateNetworksPrivateNetwork
+
+	for _, network := range response.PrivateNetworks {
+		if network.Name == data.Name.ValueString() {
+			matches = append(matches, network)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Private Network Not Found",
+			fmt.Sprintf("No private network named %q exists in environment %s.", data.Name.ValueString(), data.EnvironmentId.ValueString()),
+		)
+		return
+	}
+
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Private Networks Found",
+			fmt.Sprintf("Found %d private networks named %q in environment %s, expected one.", len(matches), data.Name.ValueString(), data.EnvironmentId.ValueString()),
+		)
+		return
+	}
+
+	network := matches[0]
+
+	tags, diags := types.ListValueFrom(ctx, types.StringType, network.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(network.PublicId)
+	data.ProjectId = types.StringValue(network.ProjectId)
+	data.DnsName = types.StringValue(network.DnsName)
+	data.Tags = tags
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
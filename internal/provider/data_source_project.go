@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -28,8 +29,13 @@ type ProjectDataSourceModel struct {
 	Description        types.String `tfsdk:"description"`
 	IsPublic           types.Bool   `tfsdk:"is_public"`
 	HasPrDeploys       types.Bool   `tfsdk:"has_pr_deploys"`
+	PrEnvironmentsBot  types.Bool   `tfsdk:"pr_environments_use_bot"`
 	WorkspaceId        types.String `tfsdk:"workspace_id"`
+	WorkspaceName      types.String `tfsdk:"workspace_name"`
+	WorkspacePlan      types.String `tfsdk:"workspace_plan"`
 	DefaultEnvironment types.String `tfsdk:"default_environment_id"`
+	Environments       types.Map    `tfsdk:"environments"`
+	Services           types.Map    `tfsdk:"services"`
 }
 
 func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -76,14 +82,36 @@ output "project_name" {
 				MarkdownDescription: "Whether PR deploys are enabled.",
 				Computed:            true,
 			},
+			"pr_environments_use_bot": schema.BoolAttribute{
+				MarkdownDescription: "Whether PR deploys also create environments for pull requests opened by bots.",
+				Computed:            true,
+			},
 			"workspace_id": schema.StringAttribute{
 				MarkdownDescription: "Workspace ID the project belongs to.",
 				Computed:            true,
 			},
+			"workspace_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the workspace the project belongs to. Null when the token can't read the workspace.",
+				Computed:            true,
+			},
+			"workspace_plan": schema.StringAttribute{
+				MarkdownDescription: "Plan of the workspace the project belongs to, such as `HOBBY` or `PRO`. Null when the token can't read the workspace.",
+				Computed:            true,
+			},
 			"default_environment_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the default (oldest) environment in the project.",
 				Computed:            true,
 			},
+			"environments": schema.MapAttribute{
+				MarkdownDescription: "IDs of the environments in the project, keyed by name. Reading the project fails if two environments share a name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"services": schema.MapAttribute{
+				MarkdownDescription: "IDs of the services in the project, keyed by name. Reading the project fails if two services share a name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -128,19 +156,87 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	data.Description = types.StringValue(project.Description)
 	data.IsPublic = types.BoolValue(project.IsPublic)
 	data.HasPrDeploys = types.BoolValue(project.PrDeploys)
+	data.PrEnvironmentsBot = types.BoolValue(project.BotPrEnvironments)
+
+	data.WorkspaceId = types.StringNull()
+	data.WorkspaceName = types.StringNull()
+	data.WorkspacePlan = types.StringNull()
 
 	if project.Workspace != nil {
 		data.WorkspaceId = types.StringValue(project.Workspace.Id)
-	} else {
-		data.WorkspaceId = types.StringNull()
+
+		workspace, err := getProjectWorkspace(ctx, *d.client, project.Workspace.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to Read Workspace",
+				fmt.Sprintf("Leaving workspace_name and workspace_plan unset, got error: %s", err),
+			)
+		} else {
+			data.WorkspaceName = types.StringValue(workspace.Workspace.Name)
+			data.WorkspacePlan = types.StringValue(string(workspace.Workspace.Plan))
+		}
+	}
+
+	// The project only returns the first page of environments, so list them all
+	environments, err := listAllEnvironments(ctx, *d.client, project.Id, nil)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environments, got error: %s", err))
+		return
+	}
+
+	data.DefaultEnvironment = types.StringNull()
+
+	if environment := defaultEnvironment(environments); environment != nil {
+		data.DefaultEnvironment = types.StringValue(environment.Id)
+	}
+
+	environmentIds := make(map[string]string, len(environments))
+
+	for _, environment := range environments {
+		if _, ok := environmentIds[environment.Name]; ok {
+			resp.Diagnostics.AddError(
+				"Duplicate Environment Name",
+				fmt.Sprintf("Project %s has more than one environment named %q, so they can't be keyed by name.", project.Id, environment.Name),
+			)
+			return
+		}
+
+		environmentIds[environment.Name] = environment.Id
 	}
 
-	// Find the default (oldest) environment
-	if len(project.Environments.Edges) > 0 {
-		// The edges are sorted by createdAt, so the first one is the oldest
-		data.DefaultEnvironment = types.StringValue(project.Environments.Edges[0].Node.Id)
-	} else {
-		data.DefaultEnvironment = types.StringNull()
+	var diags diag.Diagnostics
+
+	data.Environments, diags = types.MapValueFrom(ctx, types.StringType, environmentIds)
+	resp.Diagnostics.Append(diags...)
+
+	services, err := listAllProjectServices(ctx, *d.client, project.Id)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read services, got error: %s", err))
+		return
+	}
+
+	serviceIds := make(map[string]string, len(services))
+
+	for _, service := range services {
+		if _, ok := serviceIds[service.Name]; ok {
+			resp.Diagnostics.AddError(
+				"Duplicate Service Name",
+				fmt.Sprintf("Project %s has more than one service named %q, so they can't be keyed by name.", project.Id, service.Name),
+			)
+			return
+		}
+
+		serviceIds[service.Name] = service.Id
+	}
+
+	data.Services, diags = types.MapValueFrom(ctx, types.StringType, serviceIds)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ProjectTokensDataSource{}
+
+func NewProjectTokensDataSource() datasource.DataSource {
+	return &ProjectTokensDataSource{}
+}
+
+type ProjectTokensDataSource struct {
+	client *graphql.Client
+}
+
+type ProjectTokensDataSourceTokenModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+}
+
+type ProjectTokensDataSourceModel struct {
+	ProjectId     types.String                        `tfsdk:"project_id"`
+	EnvironmentId types.String                        `tfsdk:"environment_id"`
+	Tokens        []ProjectTokensDataSourceTokenModel `tfsdk:"tokens"`
+}
+
+func (d *ProjectTokensDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_tokens"
+}
+
+func (d *ProjectTokensDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the Railway project tokens of a project, sorted by name. Only the metadata of the tokens is read, never the tokens themselves.
+
+Listing project tokens requires an account or workspace token.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_project_tokens" "production" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+output "token_names" {
+  value = [for token in data.railway_project_tokens.production.tokens : token.name]
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID to list the tokens of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the tokens of this environment.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"tokens": schema.ListNestedAttribute{
+				MarkdownDescription: "Project tokens, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the token.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the token.",
+							Computed:            true,
+						},
+						"environment_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the environment the token has access to.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Time the token was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectTokensDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectTokensDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodes, err := listAllProjectTokens(ctx, *d.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		if isNotAuthorizedError(err) {
+			resp.Diagnostics.AddError(
+				"Insufficient Token Permissions",
+				fmt.Sprintf("The railway token is not allowed to list the tokens of project %s. Project tokens can't list tokens, use an account or workspace token instead.", data.ProjectId.ValueString()),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project tokens, got error: %s", err))
+		return
+	}
+
+	tokens := []ProjectTokensDataSourceTokenModel{}
+
+	for _, node := range nodes {
+		if !data.EnvironmentId.IsNull() && node.EnvironmentId != data.EnvironmentId.ValueString() {
+			continue
+		}
+
+		tokens = append(tokens, ProjectTokensDataSourceTokenModel{
+			Id:            types.StringValue(node.Id),
+			Name:          types.StringValue(node.Name),
+			EnvironmentId: types.StringValue(node.EnvironmentId),
+			CreatedAt:     types.StringValue(node.CreatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].Name.ValueString() != tokens[j].Name.ValueString() {
+			return tokens[i].Name.ValueString() < tokens[j].Name.ValueString()
+		}
+
+		return tokens[i].Id.ValueString() < tokens[j].Id.ValueString()
+	})
+
+	data.Tokens = tokens
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAllProjectTokens reads every page of the tokens of a project.
+func listAllProjectTokens(ctx context.Context, client graphql.Client, projectId string) ([]listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken, error) {
+	var tokens []listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken
+	var after *string
+
+	for {
+		response, err := listProjectTokens(ctx, client, projectId, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		connection := response.ProjectTokens
+
+		for _, edge := range connection.Edges {
+			tokens = append(tokens, edge.Node)
+		}
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			return tokens, nil
+		}
+
+		after = &connection.PageInfo.EndCursor
+	}
+}
+
+// isNotAuthorizedError reports whether Railway rejected the request because the token lacks access.
+func isNotAuthorizedError(err error) bool {
+	message := strings.ToLower(err.Error())
+
+	return strings.Contains(message, "not authorized") || strings.Contains(message, "unauthorized") || strings.Contains(message, "forbidden")
+}
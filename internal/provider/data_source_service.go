@@ -3,16 +3,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &ServiceDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ServiceDataSource{}
 
 func NewServiceDataSource() datasource.DataSource {
 	return &ServiceDataSource{}
@@ -23,9 +27,13 @@ type ServiceDataSource struct {
 }
 
 type ServiceDataSourceModel struct {
-	Id        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	ProjectId types.String `tfsdk:"project_id"`
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	ProjectId   types.String `tfsdk:"project_id"`
+	Icon        types.String `tfsdk:"icon"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	SourceRepo  types.String `tfsdk:"source_repo"`
+	SourceImage types.String `tfsdk:"source_image"`
 }
 
 func (d *ServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -34,7 +42,7 @@ func (d *ServiceDataSource) Metadata(ctx context.Context, req datasource.Metadat
 
 func (d *ServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `Look up an existing Railway service by ID.
+		MarkdownDescription: `Look up an existing Railway service by ID, or by project and name.
 
 ## Example Usage
 
@@ -50,28 +58,71 @@ output "service_name" {
 output "project_id" {
   value = data.railway_service.existing.project_id
 }
+
+data "railway_service" "api" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "api"
+}
 ` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "Service identifier.",
-				Required:            true,
+				MarkdownDescription: "Service identifier. Either this or `project_id` and `name` must be set.",
+				Optional:            true,
+				Computed:            true,
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Service name.",
+				MarkdownDescription: "Service name, used with `project_id` to look the service up.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
 			},
 			"project_id": schema.StringAttribute{
-				MarkdownDescription: "Project ID the service belongs to.",
+				MarkdownDescription: "Project ID the service belongs to, used with `name` to look the service up.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the service.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Time the service was created, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"source_repo": schema.StringAttribute{
+				MarkdownDescription: "Source repository of the service, as configured in the default environment.",
+				Computed:            true,
+			},
+			"source_image": schema.StringAttribute{
+				MarkdownDescription: "Source image of the service, as configured in the default environment.",
 				Computed:            true,
 			},
 		},
 	}
 }
 
+func (d *ServiceDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("project_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *ServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -99,6 +150,41 @@ func (d *ServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	if data.Id.IsNull() {
+		services, err := listAllProjectServices(ctx, *d.client, data.ProjectId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read services, got error: %s", err))
+			return
+		}
+
+		var matches []string
+
+		for _, service := range services {
+			if service.Name == data.Name.ValueString() {
+				matches = append(matches, service.Id)
+			}
+		}
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError(
+				"Service Not Found",
+				fmt.Sprintf("No service named %q exists in project %s.", data.Name.ValueString(), data.ProjectId.ValueString()),
+			)
+			return
+		}
+
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple Services Found",
+				fmt.Sprintf("Found %d services named %q in project %s, expected one.", len(matches), data.Name.ValueString(), data.ProjectId.ValueString()),
+			)
+			return
+		}
+
+		data.Id = types.StringValue(matches[0])
+	}
+
 	response, err := getService(ctx, *d.client, data.Id.ValueString())
 
 	if err != nil {
@@ -106,10 +192,39 @@ func (d *ServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	service := response.Service.Service
+	service := response.Service
 
 	data.Name = types.StringValue(service.Name)
 	data.ProjectId = types.StringValue(service.ProjectId)
+	data.Icon = types.StringPointerValue(service.Icon)
+	data.CreatedAt = types.StringNull()
+
+	if !service.CreatedAt.IsZero() {
+		data.CreatedAt = types.StringValue(service.CreatedAt.Format(time.RFC3339))
+	}
+
+	// The source is set for the whole project, read it like the service resource does from the default environment
+	_, environment, err := defaultEnvironmentForProject(ctx, *d.client, service.ProjectId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read default environment, got error: %s", err))
+		return
+	}
+
+	instance, err := getServiceInstance(ctx, *d.client, environment.Id, service.Id)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service instance, got error: %s", err))
+		return
+	}
+
+	data.SourceRepo = types.StringNull()
+	data.SourceImage = types.StringNull()
+
+	if instance.ServiceInstance.Source != nil {
+		data.SourceRepo = types.StringPointerValue(instance.ServiceInstance.Source.Repo)
+		data.SourceImage = types.StringPointerValue(instance.ServiceInstance.Source.Image)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServiceInstanceDataSource{}
+
+func NewServiceInstanceDataSource() datasource.DataSource {
+	return &ServiceInstanceDataSource{}
+}
+
+type ServiceInstanceDataSource struct {
+	client *graphql.Client
+}
+
+type ServiceInstanceDataSourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	ServiceId               types.String `tfsdk:"service_id"`
+	EnvironmentId           types.String `tfsdk:"environment_id"`
+	SourceImage             types.String `tfsdk:"source_image"`
+	SourceRepo              types.String `tfsdk:"source_repo"`
+	Builder                 types.String `tfsdk:"builder"`
+	BuildCommand            types.String `tfsdk:"build_command"`
+	StartCommand            types.String `tfsdk:"start_command"`
+	HealthcheckPath         types.String `tfsdk:"healthcheck_path"`
+	HealthcheckTimeout      types.Int64  `tfsdk:"healthcheck_timeout"`
+	RestartPolicyType       types.String `tfsdk:"restart_policy_type"`
+	RestartPolicyMaxRetries types.Int64  `tfsdk:"restart_policy_max_retries"`
+	SleepApplication        types.Bool   `tfsdk:"sleep_application"`
+	Region                  types.String `tfsdk:"region"`
+	NumReplicas             types.Int64  `tfsdk:"num_replicas"`
+}
+
+func (d *ServiceInstanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_instance"
+}
+
+func (d *ServiceInstanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the environment-scoped configuration of a Railway service, such as one managed outside of this configuration.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_service_instance" "api_production" {
+  service_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+resource "railway_service_instance" "worker_production" {
+  service_id     = railway_service.worker.id
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  source_image   = data.railway_service_instance.api_production.source_image
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Composite identifier of the service instance (service_id:environment_id).",
+				Computed:            true,
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"source_image": schema.StringAttribute{
+				MarkdownDescription: "Docker image deployed for this service instance.",
+				Computed:            true,
+			},
+			"source_repo": schema.StringAttribute{
+				MarkdownDescription: "GitHub repository deployed for this service instance.",
+				Computed:            true,
+			},
+			"builder": schema.StringAttribute{
+				MarkdownDescription: "Build system used.",
+				Computed:            true,
+			},
+			"build_command": schema.StringAttribute{
+				MarkdownDescription: "Custom build command run during the build phase.",
+				Computed:            true,
+			},
+			"start_command": schema.StringAttribute{
+				MarkdownDescription: "Custom start command running the application.",
+				Computed:            true,
+			},
+			"healthcheck_path": schema.StringAttribute{
+				MarkdownDescription: "HTTP path for health checks.",
+				Computed:            true,
+			},
+			"healthcheck_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds for health check requests.",
+				Computed:            true,
+			},
+			"restart_policy_type": schema.StringAttribute{
+				MarkdownDescription: "Restart policy type.",
+				Computed:            true,
+			},
+			"restart_policy_max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of restart retries when using `ON_FAILURE` policy.",
+				Computed:            true,
+			},
+			"sleep_application": schema.BoolAttribute{
+				MarkdownDescription: "Whether serverless mode is enabled.",
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region the service instance is deployed in.",
+				Computed:            true,
+			},
+			"num_replicas": schema.Int64Attribute{
+				MarkdownDescription: "Number of replicas of the service instance.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ServiceInstanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServiceInstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServiceInstanceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getServiceInstanceForResource(ctx, *d.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Service Instance Not Found",
+				fmt.Sprintf("Service %s has no instance in environment %s.", data.ServiceId.ValueString(), data.EnvironmentId.ValueString()),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service instance, got error: %s", err))
+		return
+	}
+
+	instance := response.ServiceInstance
+
+	data.Id = types.StringValue(serviceInstanceId(data.ServiceId.ValueString(), data.EnvironmentId.ValueString()))
+	data.SourceImage = types.StringNull()
+	data.SourceRepo = types.StringNull()
+
+	if instance.Source != nil {
+		data.SourceImage = types.StringPointerValue(instance.Source.Image)
+		data.SourceRepo = types.StringPointerValue(instance.Source.Repo)
+	}
+
+	data.Builder = types.StringValue(string(instance.Builder))
+	data.BuildCommand = types.StringPointerValue(instance.BuildCommand)
+	data.StartCommand = types.StringPointerValue(instance.StartCommand)
+	data.HealthcheckPath = types.StringPointerValue(instance.HealthcheckPath)
+	data.HealthcheckTimeout = optionalInt64(instance.HealthcheckTimeout)
+	data.RestartPolicyType = types.StringValue(string(instance.RestartPolicyType))
+	data.RestartPolicyMaxRetries = types.Int64Value(int64(instance.RestartPolicyMaxRetries))
+	data.SleepApplication = types.BoolPointerValue(instance.SleepApplication)
+	data.Region = types.StringPointerValue(instance.Region)
+	data.NumReplicas = optionalInt64(instance.NumReplicas)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// isNotFoundError reports whether Railway rejected the request because the object does not exist.
+func isNotFoundError(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "not found")
+}
+
+func optionalInt64(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*value))
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServiceInstanceLimitsDataSource{}
+
+func NewServiceInstanceLimitsDataSource() datasource.DataSource {
+	return &ServiceInstanceLimitsDataSource{}
+}
+
+type ServiceInstanceLimitsDataSource struct {
+	client *graphql.Client
+}
+
+type ServiceInstanceLimitsDataSourceModel struct {
+	ServiceId        types.String  `tfsdk:"service_id"`
+	EnvironmentId    types.String  `tfsdk:"environment_id"`
+	MemoryGB         types.Float64 `tfsdk:"memory_gb"`
+	VCPUs            types.Float64 `tfsdk:"vcpus"`
+	DefaultsInEffect types.Bool    `tfsdk:"defaults_in_effect"`
+}
+
+func (d *ServiceInstanceLimitsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_instance_limits"
+}
+
+func (d *ServiceInstanceLimitsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the CPU and memory limits applied to a Railway service instance, such as one not managed with ` + "`railway_service_limits`" + `.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_service_instance_limits" "api" {
+  service_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+output "api_memory_gb" {
+  value = data.railway_service_instance_limits.api.memory_gb
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"memory_gb": schema.Float64Attribute{
+				MarkdownDescription: "Memory limit in GB, null when the plan default applies.",
+				Computed:            true,
+			},
+			"vcpus": schema.Float64Attribute{
+				MarkdownDescription: "Number of vCPUs, null when the plan default applies.",
+				Computed:            true,
+			},
+			"defaults_in_effect": schema.BoolAttribute{
+				MarkdownDescription: "Whether the service instance has no explicit limits and runs with the plan defaults.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ServiceInstanceLimitsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServiceInstanceLimitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServiceInstanceLimitsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getServiceInstanceLimitOverride(ctx, *d.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service limits, got error: %s", err))
+		return
+	}
+
+	// Parsed like the railway_service_limits resource so the values compare exactly
+	memoryGB, vcpus := parseServiceInstanceLimits(response.ServiceInstanceLimitOverride)
+
+	data.MemoryGB = types.Float64PointerValue(memoryGB)
+	data.VCPUs = types.Float64PointerValue(vcpus)
+	data.DefaultsInEffect = types.BoolValue(memoryGB == nil && vcpus == nil)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
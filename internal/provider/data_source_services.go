@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServicesDataSource{}
+
+func NewServicesDataSource() datasource.DataSource {
+	return &ServicesDataSource{}
+}
+
+type ServicesDataSource struct {
+	client *graphql.Client
+}
+
+type ServicesDataSourceServiceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+type ServicesDataSourceModel struct {
+	ProjectId types.String                     `tfsdk:"project_id"`
+	NameRegex types.String                     `tfsdk:"name_regex"`
+	Services  []ServicesDataSourceServiceModel `tfsdk:"services"`
+}
+
+func (d *ServicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_services"
+}
+
+func (d *ServicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the Railway services of a project, sorted by name.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_services" "team" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name_regex = "^team-"
+}
+
+output "service_ids" {
+  value = { for service in data.railway_services.team.services : service.name => service.id }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID to list the services of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression the service names must match.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"services": schema.ListNestedAttribute{
+				MarkdownDescription: "Services of the project, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Service identifier.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Service name.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Time the service was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Time the service was last updated, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServicesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+
+	if !data.NameRegex.IsNull() {
+		var err error
+
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", fmt.Sprintf("Unable to parse name_regex, got error: %s", err))
+			return
+		}
+	}
+
+	nodes, err := listAllProjectServices(ctx, *d.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read services, got error: %s", err))
+		return
+	}
+
+	services := []ServicesDataSourceServiceModel{}
+
+	for _, node := range nodes {
+		if nameRegex != nil && !nameRegex.MatchString(node.Name) {
+			continue
+		}
+
+		services = append(services, ServicesDataSourceServiceModel{
+			Id:        types.StringValue(node.Id),
+			Name:      types.StringValue(node.Name),
+			CreatedAt: types.StringValue(node.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt: types.StringValue(node.UpdatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	// Sort so for_each over the result is stable, names can repeat so fall back to the id
+	sort.Slice(services, func(i, j int) bool {
+		if services[i].Name.ValueString() != services[j].Name.ValueString() {
+			return services[i].Name.ValueString() < services[j].Name.ValueString()
+		}
+
+		return services[i].Id.ValueString() < services[j].Id.ValueString()
+	})
+
+	data.Services = services
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAllProjectServices reads every page of the services of a project.
+func listAllProjectServices(ctx context.Context, client graphql.Client, projectId string) ([]listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService, error) {
+	var services []listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService
+	var after *string
+
+	for {
+		response, err := listProjectServices(ctx, client, projectId, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		connection := response.Project.Services
+
+		for _, edge := range connection.Edges {
+			services = append(services, edge.Node)
+		}
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			return services, nil
+		}
+
+		after = &connection.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SharedVariablesDataSource{}
+
+func NewSharedVariablesDataSource() datasource.DataSource {
+	return &SharedVariablesDataSource{}
+}
+
+type SharedVariablesDataSource struct {
+	client *graphql.Client
+}
+
+type SharedVariablesDataSourceModel struct {
+	ProjectId     types.String `tfsdk:"project_id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	Variables     types.Map    `tfsdk:"variables"`
+}
+
+func (d *SharedVariablesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shared_variables"
+}
+
+func (d *SharedVariablesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the shared variables of a Railway environment.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_shared_variables" "production" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+resource "railway_variable" "sentry_dsn" {
+  name           = "SENTRY_DSN"
+  value          = data.railway_shared_variables.production.variables["SENTRY_DSN"]
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  service_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID the environment belongs to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment ID to read the shared variables of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"variables": schema.MapAttribute{
+				MarkdownDescription: "Shared variables of the environment, keyed by name. Values are unrendered, so references to other variables are kept as is.",
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *SharedVariablesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SharedVariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SharedVariablesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getSharedVariables(ctx, *d.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared variables, got error: %s", err))
+		return
+	}
+
+	variablesValue, diags := types.MapValueFrom(ctx, types.StringType, stringifyVariables(response.Variables))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Variables = variablesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &UsageDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &UsageDataSource{}
+
+// Published Railway prices in USD, the usage API only reports the measurements.
+var (
+	usageCpuPricePerVcpuMinute  = 0.000463
+	usageMemoryPricePerGBMinute = 0.000231
+	usageEgressPricePerGB       = 0.05
+)
+
+func NewUsageDataSource() datasource.DataSource {
+	return &UsageDataSource{}
+}
+
+type UsageDataSource struct {
+	client *graphql.Client
+}
+
+type UsageDataSourceServiceModel struct {
+	ServiceId     types.String  `tfsdk:"service_id"`
+	ProjectId     types.String  `tfsdk:"project_id"`
+	CpuUsage      types.Float64 `tfsdk:"cpu_usage"`
+	MemoryUsage   types.Float64 `tfsdk:"memory_usage"`
+	NetworkEgress types.Float64 `tfsdk:"network_egress"`
+	EstimatedCost types.Float64 `tfsdk:"estimated_cost"`
+}
+
+type UsageDataSourceTotalsModel struct {
+	CpuUsage      types.Float64 `tfsdk:"cpu_usage"`
+	MemoryUsage   types.Float64 `tfsdk:"memory_usage"`
+	NetworkEgress types.Float64 `tfsdk:"network_egress"`
+	EstimatedCost types.Float64 `tfsdk:"estimated_cost"`
+}
+
+type UsageDataSourceModel struct {
+	ProjectId   types.String                  `tfsdk:"project_id"`
+	WorkspaceId types.String                  `tfsdk:"workspace_id"`
+	ServiceIds  types.Set                     `tfsdk:"service_ids"`
+	Services    []UsageDataSourceServiceModel `tfsdk:"services"`
+	Totals      UsageDataSourceTotalsModel    `tfsdk:"totals"`
+}
+
+// usageAmounts accumulates the measurements of a service.
+type usageAmounts struct {
+	cpu    float64
+	memory float64
+	egress float64
+}
+
+func (u *usageAmounts) add(measurement MetricMeasurement, value float64) {
+	switch measurement {
+	case MetricMeasurementCpuUsage:
+		u.cpu += value
+	case MetricMeasurementMemoryUsageGb:
+		u.memory += value
+	case MetricMeasurementNetworkTxGb:
+		u.egress += value
+	}
+}
+
+func (u *usageAmounts) estimatedCost() float64 {
+	return u.cpu*usageCpuPricePerVcpuMinute + u.memory*usageMemoryPricePerGBMinute + u.egress*usageEgressPricePerGB
+}
+
+func (d *UsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage"
+}
+
+func (d *UsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the Railway usage of the current billing period, per service.
+
+The estimated cost is computed from Railway's published prices for CPU, memory and network egress. It leaves out volumes, plan fees and credits, so it can differ from the invoice.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_usage" "main" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+output "estimated_cost" {
+  value = data.railway_usage.main.totals.estimated_cost
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID to read the usage of. Conflicts with `workspace_id`, the usage of the whole workspace is read when both are left out.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "Workspace ID to read the usage of. Required if the railway token has access to multiple workspaces and `project_id` is not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"service_ids": schema.SetAttribute{
+				MarkdownDescription: "Only list the usage of these services. The totals always cover the whole project or workspace.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID")),
+				},
+			},
+			"services": schema.ListNestedAttribute{
+				MarkdownDescription: "Usage per service, sorted by project and service. Services without usage are left out.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the service.",
+							Computed:            true,
+						},
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the project the service belongs to.",
+							Computed:            true,
+						},
+						"cpu_usage": schema.Float64Attribute{
+							MarkdownDescription: "CPU usage in vCPU minutes.",
+							Computed:            true,
+						},
+						"memory_usage": schema.Float64Attribute{
+							MarkdownDescription: "Memory usage in GB minutes.",
+							Computed:            true,
+						},
+						"network_egress": schema.Float64Attribute{
+							MarkdownDescription: "Network egress in GB.",
+							Computed:            true,
+						},
+						"estimated_cost": schema.Float64Attribute{
+							MarkdownDescription: "Estimated cost in USD.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"totals": schema.SingleNestedAttribute{
+				MarkdownDescription: "Usage of the whole project or workspace, including usage not attributed to a service.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"cpu_usage": schema.Float64Attribute{
+						MarkdownDescription: "CPU usage in vCPU minutes.",
+						Computed:            true,
+					},
+					"memory_usage": schema.Float64Attribute{
+						MarkdownDescription: "Memory usage in GB minutes.",
+						Computed:            true,
+					},
+					"network_egress": schema.Float64Attribute{
+						MarkdownDescription: "Network egress in GB.",
+						Computed:            true,
+					},
+					"estimated_cost": schema.Float64Attribute{
+						MarkdownDescription: "Estimated cost in USD.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsageDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("project_id"),
+			path.MatchRoot("workspace_id"),
+		),
+	}
+}
+
+func (d *UsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := data.ProjectId.ValueStringPointer()
+	workspaceId := data.WorkspaceId.ValueStringPointer()
+
+	if projectId == nil && workspaceId == nil {
+		response, err := getUserWorkspaces(ctx, *d.client)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspaces, got error: %s", err))
+			return
+		}
+
+		if len(response.Me.Workspaces) != 1 {
+			resp.Diagnostics.AddError(
+				"Workspace Required",
+				fmt.Sprintf("The railway token has access to %d workspaces, set `workspace_id` or `project_id` to choose which usage to read.", len(response.Me.Workspaces)),
+			)
+			return
+		}
+
+		workspaceId = &response.Me.Workspaces[0].Id
+	}
+
+	var serviceIds map[string]bool
+
+	if !data.ServiceIds.IsNull() {
+		var ids []string
+
+		resp.Diagnostics.Append(data.ServiceIds.ElementsAs(ctx, &ids, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		serviceIds = make(map[string]bool, len(ids))
+
+		for _, id := range ids {
+			serviceIds[id] = true
+		}
+	}
+
+	response, err := getServiceUsage(ctx, *d.client, projectId, workspaceId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read usage, got error: %s", err))
+		return
+	}
+
+	var totals usageAmounts
+
+	type serviceKey struct {
+		projectId string
+		serviceId string
+	}
+
+	perService := map[serviceKey]*usageAmounts{}
+
+	for _, usage := range response.Usage {
+		totals.add(usage.Measurement, usage.Value)
+
+		if usage.Tags.ServiceId == nil {
+			continue
+		}
+
+		key := serviceKey{projectId: stringValueOrEmpty(usage.Tags.ProjectId), serviceId: *usage.Tags.ServiceId}
+
+		if serviceIds != nil && !serviceIds[key.serviceId] {
+			continue
+		}
+
+		if _, ok := perService[key]; !ok {
+			perService[key] = &usageAmounts{}
+		}
+
+		perService[key].add(usage.Measurement, usage.Value)
+	}
+
+	services := make([]UsageDataSourceServiceModel, 0, len(perService))
+
+	for key, amounts := range perService {
+		services = append(services, UsageDataSourceServiceModel{
+			ServiceId:     types.StringValue(key.serviceId),
+			ProjectId:     optionalString(key.projectId),
+			CpuUsage:      types.Float64Value(amounts.cpu),
+			MemoryUsage:   types.Float64Value(amounts.memory),
+			NetworkEgress: types.Float64Value(amounts.egress),
+			EstimatedCost: types.Float64Value(amounts.estimatedCost()),
+		})
+	}
+
+	sort.Slice(services, func(i, j int) bool {
+		if services[i].ProjectId.ValueString() != services[j].ProjectId.ValueString() {
+			return services[i].ProjectId.ValueString() < services[j].ProjectId.ValueString()
+		}
+
+		return services[i].ServiceId.ValueString() < services[j].ServiceId.ValueString()
+	})
+
+	data.Services = services
+	data.Totals = UsageDataSourceTotalsModel{
+		CpuUsage:      types.Float64Value(totals.cpu),
+		MemoryUsage:   types.Float64Value(totals.memory),
+		NetworkEgress: types.Float64Value(totals.egress),
+		EstimatedCost: types.Float64Value(totals.estimatedCost()),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &VariablesResolvedDataSource{}
+
+var variableReferenceRegex = regexp.MustCompile(`\$\{\{[^}]*\}\}`)
+
+func NewVariablesResolvedDataSource() datasource.DataSource {
+	return &VariablesResolvedDataSource{}
+}
+
+type VariablesResolvedDataSource struct {
+	client *graphql.Client
+}
+
+type VariablesResolvedDataSourceModel struct {
+	ProjectId     types.String `tfsdk:"project_id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	Variables     types.Map    `tfsdk:"variables"`
+}
+
+func (d *VariablesResolvedDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variables_resolved"
+}
+
+func (d *VariablesResolvedDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the variables of a Railway service in an environment with references to other variables resolved to their final values.
+
+References Railway can't resolve are reported as a warning listing the affected variables.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_variables_resolved" "api" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  service_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+output "database_url" {
+  value     = data.railway_variables_resolved.api.variables["DATABASE_URL"]
+  sensitive = true
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID the service belongs to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Environment ID to read the variables of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Service ID to read the variables of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"variables": schema.MapAttribute{
+				MarkdownDescription: "Variables of the service keyed by name, with references resolved.",
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *VariablesResolvedDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VariablesResolvedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VariablesResolvedDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rendered, err := getResolvedVariables(ctx, *d.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resolved variables, got error: %s", err))
+		return
+	}
+
+	unrendered, err := getVariables(ctx, *d.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variables, got error: %s", err))
+		return
+	}
+
+	variables := make(map[string]string, len(rendered.Variables))
+
+	for name, value := range rendered.Variables {
+		variables[name] = fmt.Sprintf("%v", value)
+	}
+
+	if unresolved := unresolvedVariables(unrendered.Variables, variables); len(unresolved) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Unresolved Variable References",
+			fmt.Sprintf("Railway could not resolve the references of these variables, they may refer to missing or cyclic variables: %s.", strings.Join(unresolved, ", ")),
+		)
+	}
+
+	variablesValue, diags := types.MapValueFrom(ctx, types.StringType, variables)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Variables = variablesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// unresolvedVariables lists the variables with references that still appear in, or were dropped from, the rendered values.
+func unresolvedVariables(unrendered map[string]interface{}, rendered map[string]string) []string {
+	var names []string
+
+	for name, value := range unrendered {
+		template, ok := value.(string)
+
+		if !ok || !variableReferenceRegex.MatchString(template) {
+			continue
+		}
+
+		resolved, ok := rendered[name]
+
+		if !ok || variableReferenceRegex.MatchString(resolved) || (resolved == "" && template != "") {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
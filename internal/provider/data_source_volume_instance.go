@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &VolumeInstanceDataSource{}
+
+func NewVolumeInstanceDataSource() datasource.DataSource {
+	return &VolumeInstanceDataSource{}
+}
+
+type VolumeInstanceDataSource struct {
+	client *graphql.Client
+}
+
+type VolumeInstanceDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	VolumeId      types.String `tfsdk:"volume_id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	MountPath     types.String `tfsdk:"mount_path"`
+	SizeMB        types.Int64  `tfsdk:"size_mb"`
+	Region        types.String `tfsdk:"region"`
+}
+
+func (d *VolumeInstanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_instance"
+}
+
+func (d *VolumeInstanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the configuration of a Railway volume in an environment.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_volume_instance" "data" {
+  volume_id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  environment_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+output "mount_path" {
+  value = data.railway_volume_instance.data.mount_path
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the volume instance.",
+				Computed:            true,
+			},
+			"volume_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the volume.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the volume is attached to, if any.",
+				Computed:            true,
+			},
+			"mount_path": schema.StringAttribute{
+				MarkdownDescription: "Mount path of the volume.",
+				Computed:            true,
+			},
+			"size_mb": schema.Int64Attribute{
+				MarkdownDescription: "Size of the volume in MB.",
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region the volume is stored in.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *VolumeInstanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VolumeInstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VolumeInstanceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := findVolumeInstance(ctx, *d.client, data.VolumeId.ValueString(), data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume instances, got error: %s", err))
+		return
+	}
+
+	if instance == nil {
+		resp.Diagnostics.AddError(
+			"Volume Instance Not Found",
+			fmt.Sprintf("Volume %s has no instance in environment %s.", data.VolumeId.ValueString(), data.EnvironmentId.ValueString()),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(instance.Id)
+	data.ServiceId = types.StringPointerValue(instance.ServiceId)
+	data.MountPath = types.StringValue(instance.MountPath)
+	data.SizeMB = types.Int64Value(int64(instance.SizeMB))
+	data.Region = types.StringPointerValue(instance.Region)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findVolumeInstance looks up the instance of a volume in an environment, returning nil when there is none.
+func findVolumeInstance(ctx context.Context, client graphql.Client, volumeId string, environmentId string) (*VolumeInstance, error) {
+	var after *string
+
+	for {
+		response, err := listEnvironmentVolumeInstances(ctx, client, environmentId, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		connection := response.Environment.VolumeInstances
+
+		for _, edge := range connection.Edges {
+			if edge.Node.VolumeId == volumeId {
+				return &edge.Node.VolumeInstance, nil
+			}
+		}
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			return nil, nil
+		}
+
+		after = &connection.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &VolumesDataSource{}
+
+func NewVolumesDataSource() datasource.DataSource {
+	return &VolumesDataSource{}
+}
+
+type VolumesDataSource struct {
+	client *graphql.Client
+}
+
+type VolumesDataSourceVolumeModel struct {
+	Id            types.String  `tfsdk:"id"`
+	Name          types.String  `tfsdk:"name"`
+	Size          types.Float64 `tfsdk:"size"`
+	Region        types.String  `tfsdk:"region"`
+	ServiceId     types.String  `tfsdk:"service_id"`
+	EnvironmentId types.String  `tfsdk:"environment_id"`
+	MountPath     types.String  `tfsdk:"mount_path"`
+}
+
+type VolumesDataSourceModel struct {
+	ProjectId types.String                   `tfsdk:"project_id"`
+	Volumes   []VolumesDataSourceVolumeModel `tfsdk:"volumes"`
+}
+
+func (d *VolumesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volumes"
+}
+
+func (d *VolumesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the Railway volumes of a project, sorted by name. A volume has one entry for every environment it exists in.
+
+## Example Usage
+
+` + "```hcl" + `
+data "railway_volumes" "all" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+output "volume_mount_paths" {
+  value = { for volume in data.railway_volumes.all.volumes : volume.name => volume.mount_path... }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID to list the volumes of.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be a valid UUID"),
+				},
+			},
+			"volumes": schema.ListNestedAttribute{
+				MarkdownDescription: "Volumes of the project, sorted by name and then environment. Empty when the project has no volumes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the volume.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the volume.",
+							Computed:            true,
+						},
+						"size": schema.Float64Attribute{
+							MarkdownDescription: "Size of the volume in MB.",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Region the volume is stored in.",
+							Computed:            true,
+						},
+						"service_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the service the volume is attached to, if any.",
+							Computed:            true,
+						},
+						"environment_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the environment the volume exists in.",
+							Computed:            true,
+						},
+						"mount_path": schema.StringAttribute{
+							MarkdownDescription: "Mount path of the volume.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VolumesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VolumesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VolumesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodes, err := listAllProjectVolumes(ctx, *d.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volumes, got error: %s", err))
+		return
+	}
+
+	volumes := []VolumesDataSourceVolumeModel{}
+
+	for _, node := range nodes {
+		// Volumes without instances are still listed so they can be cleaned up
+		if len(node.VolumeInstances.Edges) == 0 {
+			volumes = append(volumes, VolumesDataSourceVolumeModel{
+				Id:            types.StringValue(node.Id),
+				Name:          types.StringValue(node.Name),
+				Size:          types.Float64Null(),
+				Region:        types.StringNull(),
+				ServiceId:     types.StringNull(),
+				EnvironmentId: types.StringNull(),
+				MountPath:     types.StringNull(),
+			})
+
+			continue
+		}
+
+		for _, edge := range node.VolumeInstances.Edges {
+			instance := edge.Node
+
+			volumes = append(volumes, VolumesDataSourceVolumeModel{
+				Id:            types.StringValue(node.Id),
+				Name:          types.StringValue(node.Name),
+				Size:          types.Float64Value(float64(instance.SizeMB)),
+				Region:        types.StringPointerValue(instance.Region),
+				ServiceId:     types.StringPointerValue(instance.ServiceId),
+				EnvironmentId: types.StringValue(instance.EnvironmentId),
+				MountPath:     types.StringValue(instance.MountPath),
+			})
+		}
+	}
+
+	sort.Slice(volumes, func(i, j int) bool {
+		if volumes[i].Name.ValueString() != volumes[j].Name.ValueString() {
+			return volumes[i].Name.ValueString() < volumes[j].Name.ValueString()
+		}
+
+		if volumes[i].Id.ValueString() != volumes[j].Id.ValueString() {
+			return volumes[i].Id.ValueString() < volumes[j].Id.ValueString()
+		}
+
+		return volumes[i].EnvironmentId.ValueString() < volumes[j].EnvironmentId.ValueString()
+	})
+
+	data.Volumes = volumes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAllProjectVolumes reads every page of the volumes of a project.
+func listAllProjectVolumes(ctx context.Context, client graphql.Client, projectId string) ([]listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume, error) {
+	var volumes []listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume
+	var after *string
+
+	for {
+		response, err := listProjectVolumes(ctx, client, projectId, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		connection := response.Project.Volumes
+
+		for _, edge := range connection.Edges {
+			volumes = append(volumes, edge.Node)
+		}
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			return volumes, nil
+		}
+
+		after = &connection.PageInfo.EndCursor
+	}
+}
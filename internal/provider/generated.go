@@ -5,6 +5,7 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
@@ -19,6 +20,17 @@ const (
 	BuilderRailpack Builder = "RAILPACK"
 )
 
+type CertificateStatus string
+
+const (
+	CertificateStatusCertificateStatusTypeIssueFailed         CertificateStatus = "CERTIFICATE_STATUS_TYPE_ISSUE_FAILED"
+	CertificateStatusCertificateStatusTypeIssuing             CertificateStatus = "CERTIFICATE_STATUS_TYPE_ISSUING"
+	CertificateStatusCertificateStatusTypeUnspecified         CertificateStatus = "CERTIFICATE_STATUS_TYPE_UNSPECIFIED"
+	CertificateStatusCertificateStatusTypeValid               CertificateStatus = "CERTIFICATE_STATUS_TYPE_VALID"
+	CertificateStatusCertificateStatusTypeValidatingOwnership CertificateStatus = "CERTIFICATE_STATUS_TYPE_VALIDATING_OWNERSHIP"
+	CertificateStatusUnrecognized                             CertificateStatus = "UNRECOGNIZED"
+)
+
 // CustomDomain includes the GraphQL fields of CustomDomain requested by the fragment CustomDomain.
 type CustomDomain struct {
 	Id            string             `json:"id"`
@@ -66,6 +78,86 @@ func (v *CustomDomainCreateInput) GetServiceId() string { return v.ServiceId }
 // GetTargetPort returns CustomDomainCreateInput.TargetPort, and is useful for accessing the field via an interface.
 func (v *CustomDomainCreateInput) GetTargetPort() *int { return v.TargetPort }
 
+// CustomDomainRecords includes the GraphQL fields of CustomDomain requested by the fragment CustomDomainRecords.
+type CustomDomainRecords struct {
+	Id            string                                      `json:"id"`
+	Domain        string                                      `json:"domain"`
+	EnvironmentId string                                      `json:"environmentId"`
+	ServiceId     string                                      `json:"serviceId"`
+	ProjectId     string                                      `json:"projectId"`
+	Status        CustomDomainRecordsStatusCustomDomainStatus `json:"status"`
+}
+
+// GetId returns CustomDomainRecords.Id, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecords) GetId() string { return v.Id }
+
+// GetDomain returns CustomDomainRecords.Domain, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecords) GetDomain() string { return v.Domain }
+
+// GetEnvironmentId returns CustomDomainRecords.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecords) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns CustomDomainRecords.ServiceId, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecords) GetServiceId() string { return v.ServiceId }
+
+// GetProjectId returns CustomDomainRecords.ProjectId, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecords) GetProjectId() string { return v.ProjectId }
+
+// GetStatus returns CustomDomainRecords.Status, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecords) GetStatus() CustomDomainRecordsStatusCustomDomainStatus {
+	return v.Status
+}
+
+// CustomDomainRecordsStatusCustomDomainStatus includes the requested fields of the GraphQL type CustomDomainStatus.
+type CustomDomainRecordsStatusCustomDomainStatus struct {
+	CertificateStatus CertificateStatus                                                 `json:"certificateStatus"`
+	DnsRecords        []CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords `json:"dnsRecords"`
+}
+
+// GetCertificateStatus returns CustomDomainRecordsStatusCustomDomainStatus.CertificateStatus, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecordsStatusCustomDomainStatus) GetCertificateStatus() CertificateStatus {
+	return v.CertificateStatus
+}
+
+// GetDnsRecords returns CustomDomainRecordsStatusCustomDomainStatus.DnsRecords, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecordsStatusCustomDomainStatus) GetDnsRecords() []CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords {
+	return v.DnsRecords
+}
+
+// CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords includes the requested fields of the GraphQL type DNSRecords.
+type CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords struct {
+	RecordType    DNSRecordType   `json:"recordType"`
+	Fqdn          string          `json:"fqdn"`
+	RequiredValue string          `json:"requiredValue"`
+	CurrentValue  string          `json:"currentValue"`
+	Status        DNSRecordStatus `json:"status"`
+}
+
+// GetRecordType returns CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords.RecordType, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords) GetRecordType() DNSRecordType {
+	return v.RecordType
+}
+
+// GetFqdn returns CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords.Fqdn, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords) GetFqdn() string {
+	return v.Fqdn
+}
+
+// GetRequiredValue returns CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords.RequiredValue, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords) GetRequiredValue() string {
+	return v.RequiredValue
+}
+
+// GetCurrentValue returns CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords.CurrentValue, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords) GetCurrentValue() string {
+	return v.CurrentValue
+}
+
+// GetStatus returns CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords.Status, and is useful for accessing the field via an interface.
+func (v *CustomDomainRecordsStatusCustomDomainStatusDnsRecordsDNSRecords) GetStatus() DNSRecordStatus {
+	return v.Status
+}
+
 // CustomDomainStatus includes the requested fields of the GraphQL type CustomDomainStatus.
 type CustomDomainStatus struct {
 	DnsRecords []CustomDomainStatusDnsRecordsDNSRecords `json:"dnsRecords"`
@@ -92,11 +184,169 @@ func (v *CustomDomainStatusDnsRecordsDNSRecords) GetRequiredValue() string { ret
 // GetZone returns CustomDomainStatusDnsRecordsDNSRecords.Zone, and is useful for accessing the field via an interface.
 func (v *CustomDomainStatusDnsRecordsDNSRecords) GetZone() string { return v.Zone }
 
+type DNSRecordStatus string
+
+const (
+	DNSRecordStatusDnsRecordStatusPropagated     DNSRecordStatus = "DNS_RECORD_STATUS_PROPAGATED"
+	DNSRecordStatusDnsRecordStatusRequiresUpdate DNSRecordStatus = "DNS_RECORD_STATUS_REQUIRES_UPDATE"
+	DNSRecordStatusDnsRecordStatusUnspecified    DNSRecordStatus = "DNS_RECORD_STATUS_UNSPECIFIED"
+	DNSRecordStatusUnrecognized                  DNSRecordStatus = "UNRECOGNIZED"
+)
+
+type DNSRecordType string
+
+const (
+	DNSRecordTypeDnsRecordTypeA           DNSRecordType = "DNS_RECORD_TYPE_A"
+	DNSRecordTypeDnsRecordTypeCname       DNSRecordType = "DNS_RECORD_TYPE_CNAME"
+	DNSRecordTypeDnsRecordTypeNs          DNSRecordType = "DNS_RECORD_TYPE_NS"
+	DNSRecordTypeDnsRecordTypeUnspecified DNSRecordType = "DNS_RECORD_TYPE_UNSPECIFIED"
+	DNSRecordTypeUnrecognized             DNSRecordType = "UNRECOGNIZED"
+)
+
+type DeploymentListInput struct {
+	EnvironmentId  string                 `json:"environmentId"`
+	IncludeDeleted *bool                  `json:"includeDeleted,omitempty"`
+	ProjectId      *string                `json:"projectId,omitempty"`
+	ServiceId      string                 `json:"serviceId"`
+	Status         *DeploymentStatusInput `json:"status,omitempty"`
+}
+
+// GetEnvironmentId returns DeploymentListInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *DeploymentListInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetIncludeDeleted returns DeploymentListInput.IncludeDeleted, and is useful for accessing the field via an interface.
+func (v *DeploymentListInput) GetIncludeDeleted() *bool { return v.IncludeDeleted }
+
+// GetProjectId returns DeploymentListInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *DeploymentListInput) GetProjectId() *string { return v.ProjectId }
+
+// GetServiceId returns DeploymentListInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *DeploymentListInput) GetServiceId() string { return v.ServiceId }
+
+// GetStatus returns DeploymentListInput.Status, and is useful for accessing the field via an interface.
+func (v *DeploymentListInput) GetStatus() *DeploymentStatusInput { return v.Status }
+
+type DeploymentStatus string
+
+const (
+	DeploymentStatusBuilding      DeploymentStatus = "BUILDING"
+	DeploymentStatusCrashed       DeploymentStatus = "CRASHED"
+	DeploymentStatusDeploying     DeploymentStatus = "DEPLOYING"
+	DeploymentStatusFailed        DeploymentStatus = "FAILED"
+	DeploymentStatusInitializing  DeploymentStatus = "INITIALIZING"
+	DeploymentStatusNeedsApproval DeploymentStatus = "NEEDS_APPROVAL"
+	DeploymentStatusQueued        DeploymentStatus = "QUEUED"
+	DeploymentStatusRemoved       DeploymentStatus = "REMOVED"
+	DeploymentStatusRemoving      DeploymentStatus = "REMOVING"
+	DeploymentStatusSkipped       DeploymentStatus = "SKIPPED"
+	DeploymentStatusSleeping      DeploymentStatus = "SLEEPING"
+	DeploymentStatusSuccess       DeploymentStatus = "SUCCESS"
+	DeploymentStatusWaiting       DeploymentStatus = "WAITING"
+)
+
+type DeploymentStatusInput struct {
+	In    []DeploymentStatus `json:"in"`
+	NotIn []DeploymentStatus `json:"notIn,omitempty"`
+}
+
+// GetIn returns DeploymentStatusInput.In, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusInput) GetIn() []DeploymentStatus { return v.In }
+
+// GetNotIn returns DeploymentStatusInput.NotIn, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusInput) GetNotIn() []DeploymentStatus { return v.NotIn }
+
+// DeploymentTrigger includes the GraphQL fields of DeploymentTrigger requested by the fragment DeploymentTrigger.
+type DeploymentTrigger struct {
+	Id            string  `json:"id"`
+	ProjectId     string  `json:"projectId"`
+	EnvironmentId string  `json:"environmentId"`
+	ServiceId     *string `json:"serviceId"`
+	Repository    string  `json:"repository"`
+	Branch        string  `json:"branch"`
+	CheckSuites   bool    `json:"checkSuites"`
+}
+
+// GetId returns DeploymentTrigger.Id, and is useful for accessing the field via an interface.
+func (v *DeploymentTrigger) GetId() string { return v.Id }
+
+// GetProjectId returns DeploymentTrigger.ProjectId, and is useful for accessing the field via an interface.
+func (v *DeploymentTrigger) GetProjectId() string { return v.ProjectId }
+
+// GetEnvironmentId returns DeploymentTrigger.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *DeploymentTrigger) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns DeploymentTrigger.ServiceId, and is useful for accessing the field via an interface.
+func (v *DeploymentTrigger) GetServiceId() *string { return v.ServiceId }
+
+// GetRepository returns DeploymentTrigger.Repository, and is useful for accessing the field via an interface.
+func (v *DeploymentTrigger) GetRepository() string { return v.Repository }
+
+// GetBranch returns DeploymentTrigger.Branch, and is useful for accessing the field via an interface.
+func (v *DeploymentTrigger) GetBranch() string { return v.Branch }
+
+// GetCheckSuites returns DeploymentTrigger.CheckSuites, and is useful for accessing the field via an interface.
+func (v *DeploymentTrigger) GetCheckSuites() bool { return v.CheckSuites }
+
+type DeploymentTriggerCreateInput struct {
+	Branch        string  `json:"branch"`
+	CheckSuites   *bool   `json:"checkSuites,omitempty"`
+	EnvironmentId string  `json:"environmentId"`
+	ProjectId     string  `json:"projectId"`
+	Provider      string  `json:"provider"`
+	Repository    string  `json:"repository"`
+	RootDirectory *string `json:"rootDirectory,omitempty"`
+	ServiceId     string  `json:"serviceId"`
+}
+
+// GetBranch returns DeploymentTriggerCreateInput.Branch, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerCreateInput) GetBranch() string { return v.Branch }
+
+// GetCheckSuites returns DeploymentTriggerCreateInput.CheckSuites, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerCreateInput) GetCheckSuites() *bool { return v.CheckSuites }
+
+// GetEnvironmentId returns DeploymentTriggerCreateInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerCreateInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetProjectId returns DeploymentTriggerCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerCreateInput) GetProjectId() string { return v.ProjectId }
+
+// GetProvider returns DeploymentTriggerCreateInput.Provider, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerCreateInput) GetProvider() string { return v.Provider }
+
+// GetRepository returns DeploymentTriggerCreateInput.Repository, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerCreateInput) GetRepository() string { return v.Repository }
+
+// GetRootDirectory returns DeploymentTriggerCreateInput.RootDirectory, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerCreateInput) GetRootDirectory() *string { return v.RootDirectory }
+
+// GetServiceId returns DeploymentTriggerCreateInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerCreateInput) GetServiceId() string { return v.ServiceId }
+
+type DeploymentTriggerUpdateInput struct {
+	Branch        *string `json:"branch,omitempty"`
+	CheckSuites   *bool   `json:"checkSuites,omitempty"`
+	Repository    *string `json:"repository,omitempty"`
+	RootDirectory *string `json:"rootDirectory,omitempty"`
+}
+
+// GetBranch returns DeploymentTriggerUpdateInput.Branch, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerUpdateInput) GetBranch() *string { return v.Branch }
+
+// GetCheckSuites returns DeploymentTriggerUpdateInput.CheckSuites, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerUpdateInput) GetCheckSuites() *bool { return v.CheckSuites }
+
+// GetRepository returns DeploymentTriggerUpdateInput.Repository, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerUpdateInput) GetRepository() *string { return v.Repository }
+
+// GetRootDirectory returns DeploymentTriggerUpdateInput.RootDirectory, and is useful for accessing the field via an interface.
+func (v *DeploymentTriggerUpdateInput) GetRootDirectory() *string { return v.RootDirectory }
+
 // Environment includes the GraphQL fields of Environment requested by the fragment Environment.
 type Environment struct {
-	Id        string `json:"id"`
-	Name      string `json:"name"`
-	ProjectId string `json:"projectId"`
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	ProjectId   string `json:"projectId"`
+	IsEphemeral bool   `json:"isEphemeral"`
 }
 
 // GetId returns Environment.Id, and is useful for accessing the field via an interface.
@@ -108,6 +358,9 @@ func (v *Environment) GetName() string { return v.Name }
 // GetProjectId returns Environment.ProjectId, and is useful for accessing the field via an interface.
 func (v *Environment) GetProjectId() string { return v.ProjectId }
 
+// GetIsEphemeral returns Environment.IsEphemeral, and is useful for accessing the field via an interface.
+func (v *Environment) GetIsEphemeral() bool { return v.IsEphemeral }
+
 type EnvironmentCreateInput struct {
 	// If true, the changes will be applied in the background and the mutation will
 	// return immediately. If false, the mutation will wait for the changes to be
@@ -147,6 +400,97 @@ func (v *EnvironmentCreateInput) GetSourceEnvironmentId() *string { return v.Sou
 // GetStageInitialChanges returns EnvironmentCreateInput.StageInitialChanges, and is useful for accessing the field via an interface.
 func (v *EnvironmentCreateInput) GetStageInitialChanges() bool { return v.StageInitialChanges }
 
+type EnvironmentPatchStatus string
+
+const (
+	EnvironmentPatchStatusApplying  EnvironmentPatchStatus = "APPLYING"
+	EnvironmentPatchStatusCommitted EnvironmentPatchStatus = "COMMITTED"
+	EnvironmentPatchStatusStaged    EnvironmentPatchStatus = "STAGED"
+)
+
+// Shared log entry shape so every logs data source maps entries the same way
+type LogEntry struct {
+	// The timestamp of the log message in format RFC3339 (nano)
+	Timestamp string `json:"timestamp"`
+	// The contents of the log message
+	Message string `json:"message"`
+	// The severity of the log message (eg. err)
+	Severity *string `json:"severity"`
+	// The attributes that were parsed from a structured log
+	Attributes []LogEntryAttributesLogAttribute `json:"attributes"`
+}
+
+// GetTimestamp returns LogEntry.Timestamp, and is useful for accessing the field via an interface.
+func (v *LogEntry) GetTimestamp() string { return v.Timestamp }
+
+// GetMessage returns LogEntry.Message, and is useful for accessing the field via an interface.
+func (v *LogEntry) GetMessage() string { return v.Message }
+
+// GetSeverity returns LogEntry.Severity, and is useful for accessing the field via an interface.
+func (v *LogEntry) GetSeverity() *string { return v.Severity }
+
+// GetAttributes returns LogEntry.Attributes, and is useful for accessing the field via an interface.
+func (v *LogEntry) GetAttributes() []LogEntryAttributesLogAttribute { return v.Attributes }
+
+// LogEntryAttributesLogAttribute includes the requested fields of the GraphQL type LogAttribute.
+// The GraphQL type's documentation follows.
+//
+// The attributes associated with a structured log
+type LogEntryAttributesLogAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// GetKey returns LogEntryAttributesLogAttribute.Key, and is useful for accessing the field via an interface.
+func (v *LogEntryAttributesLogAttribute) GetKey() string { return v.Key }
+
+// GetValue returns LogEntryAttributesLogAttribute.Value, and is useful for accessing the field via an interface.
+func (v *LogEntryAttributesLogAttribute) GetValue() string { return v.Value }
+
+// A thing that can be measured on Railway.
+type MetricMeasurement string
+
+const (
+	MetricMeasurementBackupUsageGb          MetricMeasurement = "BACKUP_USAGE_GB"
+	MetricMeasurementCpuLimit               MetricMeasurement = "CPU_LIMIT"
+	MetricMeasurementCpuUsage               MetricMeasurement = "CPU_USAGE"
+	MetricMeasurementCpuUsage2              MetricMeasurement = "CPU_USAGE_2"
+	MetricMeasurementDiskUsageGb            MetricMeasurement = "DISK_USAGE_GB"
+	MetricMeasurementEphemeralDiskUsageGb   MetricMeasurement = "EPHEMERAL_DISK_USAGE_GB"
+	MetricMeasurementMeasurementUnspecified MetricMeasurement = "MEASUREMENT_UNSPECIFIED"
+	MetricMeasurementMemoryLimitGb          MetricMeasurement = "MEMORY_LIMIT_GB"
+	MetricMeasurementMemoryUsageGb          MetricMeasurement = "MEMORY_USAGE_GB"
+	MetricMeasurementNetworkRxGb            MetricMeasurement = "NETWORK_RX_GB"
+	MetricMeasurementNetworkTxGb            MetricMeasurement = "NETWORK_TX_GB"
+	MetricMeasurementUnrecognized           MetricMeasurement = "UNRECOGNIZED"
+)
+
+type Plan string
+
+const (
+	PlanFree  Plan = "FREE"
+	PlanHobby Plan = "HOBBY"
+	PlanPro   Plan = "PRO"
+)
+
+// Shared with the plan limits data source so both read the plan the same way
+type PlanLimits struct {
+	Id                    string                 `json:"id"`
+	SubscriptionType      SubscriptionPlanType   `json:"subscriptionType"`
+	SubscriptionPlanLimit map[string]interface{} `json:"subscriptionPlanLimit"`
+}
+
+// GetId returns PlanLimits.Id, and is useful for accessing the field via an interface.
+func (v *PlanLimits) GetId() string { return v.Id }
+
+// GetSubscriptionType returns PlanLimits.SubscriptionType, and is useful for accessing the field via an interface.
+func (v *PlanLimits) GetSubscriptionType() SubscriptionPlanType { return v.SubscriptionType }
+
+// GetSubscriptionPlanLimit returns PlanLimits.SubscriptionPlanLimit, and is useful for accessing the field via an interface.
+func (v *PlanLimits) GetSubscriptionPlanLimit() map[string]interface{} {
+	return v.SubscriptionPlanLimit
+}
+
 type PrivateNetworkCreateOrGetInput struct {
 	EnvironmentId string   `json:"environmentId"`
 	Name          string   `json:"name"`
@@ -193,13 +537,14 @@ func (v *PrivateNetworkEndpointCreateOrGetInput) GetTags() []string { return v.T
 
 // Project includes the GraphQL fields of Project requested by the fragment Project.
 type Project struct {
-	Id           string                                           `json:"id"`
-	Name         string                                           `json:"name"`
-	Description  string                                           `json:"description"`
-	IsPublic     bool                                             `json:"isPublic"`
-	PrDeploys    bool                                             `json:"prDeploys"`
-	Workspace    *ProjectWorkspace                                `json:"workspace"`
-	Environments ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
+	Id                string                                           `json:"id"`
+	Name              string                                           `json:"name"`
+	Description       string                                           `json:"description"`
+	IsPublic          bool                                             `json:"isPublic"`
+	PrDeploys         bool                                             `json:"prDeploys"`
+	BotPrEnvironments bool                                             `json:"botPrEnvironments"`
+	Workspace         *ProjectWorkspace                                `json:"workspace"`
+	Environments      ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
 }
 
 // GetId returns Project.Id, and is useful for accessing the field via an interface.
@@ -217,6 +562,9 @@ func (v *Project) GetIsPublic() bool { return v.IsPublic }
 // GetPrDeploys returns Project.PrDeploys, and is useful for accessing the field via an interface.
 func (v *Project) GetPrDeploys() bool { return v.PrDeploys }
 
+// GetBotPrEnvironments returns Project.BotPrEnvironments, and is useful for accessing the field via an interface.
+func (v *Project) GetBotPrEnvironments() bool { return v.BotPrEnvironments }
+
 // GetWorkspace returns Project.Workspace, and is useful for accessing the field via an interface.
 func (v *Project) GetWorkspace() *ProjectWorkspace { return v.Workspace }
 
@@ -297,9 +645,10 @@ func (v *ProjectEnvironmentsProjectEnvironmentsConnectionEdgesProjectEnvironment
 
 // ProjectEnvironmentsProjectEnvironmentsConnectionEdgesProjectEnvironmentsConnectionEdgeNodeEnvironment includes the requested fields of the GraphQL type Environment.
 type ProjectEnvironmentsProjectEnvironmentsConnectionEdgesProjectEnvironmentsConnectionEdgeNodeEnvironment struct {
-	Id        string    `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"createdAt"`
+	Id          string    `json:"id"`
+	Name        string    `json:"name"`
+	CreatedAt   time.Time `json:"createdAt"`
+	IsEphemeral bool      `json:"isEphemeral"`
 }
 
 // GetId returns ProjectEnvironmentsProjectEnvironmentsConnectionEdgesProjectEnvironmentsConnectionEdgeNodeEnvironment.Id, and is useful for accessing the field via an interface.
@@ -317,6 +666,79 @@ func (v *ProjectEnvironmentsProjectEnvironmentsConnectionEdgesProjectEnvironment
 	return v.CreatedAt
 }
 
+// GetIsEphemeral returns ProjectEnvironmentsProjectEnvironmentsConnectionEdgesProjectEnvironmentsConnectionEdgeNodeEnvironment.IsEphemeral, and is useful for accessing the field via an interface.
+func (v *ProjectEnvironmentsProjectEnvironmentsConnectionEdgesProjectEnvironmentsConnectionEdgeNodeEnvironment) GetIsEphemeral() bool {
+	return v.IsEphemeral
+}
+
+type ProjectInvitee struct {
+	Email string      `json:"email"`
+	Role  ProjectRole `json:"role"`
+}
+
+// GetEmail returns ProjectInvitee.Email, and is useful for accessing the field via an interface.
+func (v *ProjectInvitee) GetEmail() string { return v.Email }
+
+// GetRole returns ProjectInvitee.Role, and is useful for accessing the field via an interface.
+func (v *ProjectInvitee) GetRole() ProjectRole { return v.Role }
+
+// ProjectMember includes the GraphQL fields of ProjectMember requested by the fragment ProjectMember.
+type ProjectMember struct {
+	Id    string      `json:"id"`
+	Email string      `json:"email"`
+	Role  ProjectRole `json:"role"`
+}
+
+// GetId returns ProjectMember.Id, and is useful for accessing the field via an interface.
+func (v *ProjectMember) GetId() string { return v.Id }
+
+// GetEmail returns ProjectMember.Email, and is useful for accessing the field via an interface.
+func (v *ProjectMember) GetEmail() string { return v.Email }
+
+// GetRole returns ProjectMember.Role, and is useful for accessing the field via an interface.
+func (v *ProjectMember) GetRole() ProjectRole { return v.Role }
+
+type ProjectMemberRemoveInput struct {
+	ProjectId string `json:"projectId"`
+	UserId    string `json:"userId"`
+}
+
+// GetProjectId returns ProjectMemberRemoveInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *ProjectMemberRemoveInput) GetProjectId() string { return v.ProjectId }
+
+// GetUserId returns ProjectMemberRemoveInput.UserId, and is useful for accessing the field via an interface.
+func (v *ProjectMemberRemoveInput) GetUserId() string { return v.UserId }
+
+type ProjectMemberUpdateInput struct {
+	ProjectId string      `json:"projectId"`
+	Role      ProjectRole `json:"role"`
+	UserId    string      `json:"userId"`
+}
+
+// GetProjectId returns ProjectMemberUpdateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *ProjectMemberUpdateInput) GetProjectId() string { return v.ProjectId }
+
+// GetRole returns ProjectMemberUpdateInput.Role, and is useful for accessing the field via an interface.
+func (v *ProjectMemberUpdateInput) GetRole() ProjectRole { return v.Role }
+
+// GetUserId returns ProjectMemberUpdateInput.UserId, and is useful for accessing the field via an interface.
+func (v *ProjectMemberUpdateInput) GetUserId() string { return v.UserId }
+
+type ProjectRole string
+
+const (
+	ProjectRoleAdmin  ProjectRole = "ADMIN"
+	ProjectRoleMember ProjectRole = "MEMBER"
+	ProjectRoleViewer ProjectRole = "VIEWER"
+)
+
+type ProjectTransferInput struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetWorkspaceId returns ProjectTransferInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *ProjectTransferInput) GetWorkspaceId() string { return v.WorkspaceId }
+
 type ProjectUpdateInput struct {
 	BaseEnvironmentId *string `json:"baseEnvironmentId,omitempty"`
 	// Enable/disable pull request environments for PRs created by bots
@@ -649,16 +1071,25 @@ func (v *ServiceSourceInput) GetImage() *string { return v.Image }
 func (v *ServiceSourceInput) GetRepo() *string { return v.Repo }
 
 type ServiceUpdateInput struct {
-	Icon string `json:"icon"`
-	Name string `json:"name"`
+	Icon *string `json:"icon"`
+	Name string  `json:"name"`
 }
 
 // GetIcon returns ServiceUpdateInput.Icon, and is useful for accessing the field via an interface.
-func (v *ServiceUpdateInput) GetIcon() string { return v.Icon }
+func (v *ServiceUpdateInput) GetIcon() *string { return v.Icon }
 
 // GetName returns ServiceUpdateInput.Name, and is useful for accessing the field via an interface.
 func (v *ServiceUpdateInput) GetName() string { return v.Name }
 
+type SubscriptionPlanType string
+
+const (
+	SubscriptionPlanTypeFree  SubscriptionPlanType = "free"
+	SubscriptionPlanTypeHobby SubscriptionPlanType = "hobby"
+	SubscriptionPlanTypePro   SubscriptionPlanType = "pro"
+	SubscriptionPlanTypeTrial SubscriptionPlanType = "trial"
+)
+
 // TCPProxy includes the GraphQL fields of TCPProxy requested by the fragment TCPProxy.
 type TCPProxy struct {
 	Id              string `json:"id"`
@@ -702,6 +1133,69 @@ func (v *TCPProxyCreateInput) GetEnvironmentId() string { return v.EnvironmentId
 // GetServiceId returns TCPProxyCreateInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *TCPProxyCreateInput) GetServiceId() string { return v.ServiceId }
 
+type TemplateDeployV2Input struct {
+	EnvironmentId    *string                `json:"environmentId"`
+	ProjectId        *string                `json:"projectId"`
+	SerializedConfig map[string]interface{} `json:"serializedConfig"`
+	TemplateId       string                 `json:"templateId"`
+	WorkspaceId      *string                `json:"workspaceId,omitempty"`
+}
+
+// GetEnvironmentId returns TemplateDeployV2Input.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *TemplateDeployV2Input) GetEnvironmentId() *string { return v.EnvironmentId }
+
+// GetProjectId returns TemplateDeployV2Input.ProjectId, and is useful for accessing the field via an interface.
+func (v *TemplateDeployV2Input) GetProjectId() *string { return v.ProjectId }
+
+// GetSerializedConfig returns TemplateDeployV2Input.SerializedConfig, and is useful for accessing the field via an interface.
+func (v *TemplateDeployV2Input) GetSerializedConfig() map[string]interface{} {
+	return v.SerializedConfig
+}
+
+// GetTemplateId returns TemplateDeployV2Input.TemplateId, and is useful for accessing the field via an interface.
+func (v *TemplateDeployV2Input) GetTemplateId() string { return v.TemplateId }
+
+// GetWorkspaceId returns TemplateDeployV2Input.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *TemplateDeployV2Input) GetWorkspaceId() *string { return v.WorkspaceId }
+
+// UsageLimit includes the GraphQL fields of UsageLimit requested by the fragment UsageLimit.
+type UsageLimit struct {
+	Id        string `json:"id"`
+	HardLimit *int   `json:"hardLimit"`
+	SoftLimit int    `json:"softLimit"`
+}
+
+// GetId returns UsageLimit.Id, and is useful for accessing the field via an interface.
+func (v *UsageLimit) GetId() string { return v.Id }
+
+// GetHardLimit returns UsageLimit.HardLimit, and is useful for accessing the field via an interface.
+func (v *UsageLimit) GetHardLimit() *int { return v.HardLimit }
+
+// GetSoftLimit returns UsageLimit.SoftLimit, and is useful for accessing the field via an interface.
+func (v *UsageLimit) GetSoftLimit() int { return v.SoftLimit }
+
+type UsageLimitRemoveInput struct {
+	CustomerId string `json:"customerId"`
+}
+
+// GetCustomerId returns UsageLimitRemoveInput.CustomerId, and is useful for accessing the field via an interface.
+func (v *UsageLimitRemoveInput) GetCustomerId() string { return v.CustomerId }
+
+type UsageLimitSetInput struct {
+	CustomerId       string `json:"customerId"`
+	HardLimitDollars *int   `json:"hardLimitDollars"`
+	SoftLimitDollars int    `json:"softLimitDollars"`
+}
+
+// GetCustomerId returns UsageLimitSetInput.CustomerId, and is useful for accessing the field via an interface.
+func (v *UsageLimitSetInput) GetCustomerId() string { return v.CustomerId }
+
+// GetHardLimitDollars returns UsageLimitSetInput.HardLimitDollars, and is useful for accessing the field via an interface.
+func (v *UsageLimitSetInput) GetHardLimitDollars() *int { return v.HardLimitDollars }
+
+// GetSoftLimitDollars returns UsageLimitSetInput.SoftLimitDollars, and is useful for accessing the field via an interface.
+func (v *UsageLimitSetInput) GetSoftLimitDollars() int { return v.SoftLimitDollars }
+
 type VariableCollectionUpsertInput struct {
 	EnvironmentId string `json:"environmentId"`
 	ProjectId     string `json:"projectId"`
@@ -825,11 +1319,67 @@ func (v *VolumeCreateInput) GetRegion() *string { return v.Region }
 // GetServiceId returns VolumeCreateInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *VolumeCreateInput) GetServiceId() *string { return v.ServiceId }
 
+// VolumeInstance includes the GraphQL fields of VolumeInstance requested by the fragment VolumeInstance.
+type VolumeInstance struct {
+	Id            string  `json:"id"`
+	VolumeId      string  `json:"volumeId"`
+	EnvironmentId string  `json:"environmentId"`
+	ServiceId     *string `json:"serviceId"`
+	MountPath     string  `json:"mountPath"`
+	SizeMB        int     `json:"sizeMB"`
+	Region        *string `json:"region"`
+}
+
+// GetId returns VolumeInstance.Id, and is useful for accessing the field via an interface.
+func (v *VolumeInstance) GetId() string { return v.Id }
+
+// GetVolumeId returns VolumeInstance.VolumeId, and is useful for accessing the field via an interface.
+func (v *VolumeInstance) GetVolumeId() string { return v.VolumeId }
+
+// GetEnvironmentId returns VolumeInstance.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *VolumeInstance) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns VolumeInstance.ServiceId, and is useful for accessing the field via an interface.
+func (v *VolumeInstance) GetServiceId() *string { return v.ServiceId }
+
+// GetMountPath returns VolumeInstance.MountPath, and is useful for accessing the field via an interface.
+func (v *VolumeInstance) GetMountPath() string { return v.MountPath }
+
+// GetSizeMB returns VolumeInstance.SizeMB, and is useful for accessing the field via an interface.
+func (v *VolumeInstance) GetSizeMB() int { return v.SizeMB }
+
+// GetRegion returns VolumeInstance.Region, and is useful for accessing the field via an interface.
+func (v *VolumeInstance) GetRegion() *string { return v.Region }
+
+// VolumeInstanceBackup includes the GraphQL fields of VolumeInstanceBackup requested by the fragment VolumeInstanceBackup.
+type VolumeInstanceBackup struct {
+	Id        string     `json:"id"`
+	Name      *string    `json:"name"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	UsedMB    *int       `json:"usedMB"`
+}
+
+// GetId returns VolumeInstanceBackup.Id, and is useful for accessing the field via an interface.
+func (v *VolumeInstanceBackup) GetId() string { return v.Id }
+
+// GetName returns VolumeInstanceBackup.Name, and is useful for accessing the field via an interface.
+func (v *VolumeInstanceBackup) GetName() *string { return v.Name }
+
+// GetCreatedAt returns VolumeInstanceBackup.CreatedAt, and is useful for accessing the field via an interface.
+func (v *VolumeInstanceBackup) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetExpiresAt returns VolumeInstanceBackup.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *VolumeInstanceBackup) GetExpiresAt() *time.Time { return v.ExpiresAt }
+
+// GetUsedMB returns VolumeInstanceBackup.UsedMB, and is useful for accessing the field via an interface.
+func (v *VolumeInstanceBackup) GetUsedMB() *int { return v.UsedMB }
+
 type VolumeInstanceUpdateInput struct {
 	// The mount path of the volume instance. If not provided, the mount path will not be updated.
 	MountPath string `json:"mountPath"`
 	// The service to attach the volume to. If not provided, the volume will be disconnected.
-	ServiceId string `json:"serviceId"`
+	ServiceId *string `json:"serviceId"`
 	// The state of the volume instance. If not provided, the state will not be updated.
 	State *VolumeState `json:"state,omitempty"`
 }
@@ -838,7 +1388,7 @@ type VolumeInstanceUpdateInput struct {
 func (v *VolumeInstanceUpdateInput) GetMountPath() string { return v.MountPath }
 
 // GetServiceId returns VolumeInstanceUpdateInput.ServiceId, and is useful for accessing the field via an interface.
-func (v *VolumeInstanceUpdateInput) GetServiceId() string { return v.ServiceId }
+func (v *VolumeInstanceUpdateInput) GetServiceId() *string { return v.ServiceId }
 
 // GetState returns VolumeInstanceUpdateInput.State, and is useful for accessing the field via an interface.
 func (v *VolumeInstanceUpdateInput) GetState() *VolumeState { return v.State }
@@ -886,11 +1436,12 @@ func (v *VolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeIn
 
 // VolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance includes the requested fields of the GraphQL type VolumeInstance.
 type VolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance struct {
-	Id            string `json:"id"`
-	EnvironmentId string `json:"environmentId"`
-	ServiceId     string `json:"serviceId"`
-	MountPath     string `json:"mountPath"`
-	SizeMB        int    `json:"sizeMB"`
+	Id            string  `json:"id"`
+	EnvironmentId string  `json:"environmentId"`
+	ServiceId     string  `json:"serviceId"`
+	MountPath     string  `json:"mountPath"`
+	SizeMB        int     `json:"sizeMB"`
+	Region        *string `json:"region"`
 }
 
 // GetId returns VolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance.Id, and is useful for accessing the field via an interface.
@@ -918,6 +1469,44 @@ func (v *VolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeIn
 	return v.SizeMB
 }
 
+// GetRegion returns VolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance.Region, and is useful for accessing the field via an interface.
+func (v *VolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance) GetRegion() *string {
+	return v.Region
+}
+
+type WorkflowStatus string
+
+const (
+	WorkflowStatusComplete WorkflowStatus = "Complete"
+	WorkflowStatusError    WorkflowStatus = "Error"
+	WorkflowStatusNotfound WorkflowStatus = "NotFound"
+	WorkflowStatusRunning  WorkflowStatus = "Running"
+)
+
+// __commitEnvironmentPatchInput is used internally by genqlient
+type __commitEnvironmentPatchInput struct {
+	EnvironmentId string                 `json:"environmentId"`
+	Patch         map[string]interface{} `json:"patch"`
+}
+
+// GetEnvironmentId returns __commitEnvironmentPatchInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__commitEnvironmentPatchInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetPatch returns __commitEnvironmentPatchInput.Patch, and is useful for accessing the field via an interface.
+func (v *__commitEnvironmentPatchInput) GetPatch() map[string]interface{} { return v.Patch }
+
+// __commitEnvironmentStagedChangesInput is used internally by genqlient
+type __commitEnvironmentStagedChangesInput struct {
+	EnvironmentId string  `json:"environmentId"`
+	CommitMessage *string `json:"commitMessage"`
+}
+
+// GetEnvironmentId returns __commitEnvironmentStagedChangesInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__commitEnvironmentStagedChangesInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetCommitMessage returns __commitEnvironmentStagedChangesInput.CommitMessage, and is useful for accessing the field via an interface.
+func (v *__commitEnvironmentStagedChangesInput) GetCommitMessage() *string { return v.CommitMessage }
+
 // __connectServiceInput is used internally by genqlient
 type __connectServiceInput struct {
 	Id    string              `json:"id"`
@@ -938,6 +1527,14 @@ type __createCustomDomainInput struct {
 // GetInput returns __createCustomDomainInput.Input, and is useful for accessing the field via an interface.
 func (v *__createCustomDomainInput) GetInput() CustomDomainCreateInput { return v.Input }
 
+// __createDeploymentTriggerInput is used internally by genqlient
+type __createDeploymentTriggerInput struct {
+	Input DeploymentTriggerCreateInput `json:"input"`
+}
+
+// GetInput returns __createDeploymentTriggerInput.Input, and is useful for accessing the field via an interface.
+func (v *__createDeploymentTriggerInput) GetInput() DeploymentTriggerCreateInput { return v.Input }
+
 // __createEnvironmentInput is used internally by genqlient
 type __createEnvironmentInput struct {
 	Input EnvironmentCreateInput `json:"input"`
@@ -972,6 +1569,18 @@ type __createProjectInput struct {
 // GetInput returns __createProjectInput.Input, and is useful for accessing the field via an interface.
 func (v *__createProjectInput) GetInput() ProjectCreateInput { return v.Input }
 
+// __createProjectInvitationInput is used internally by genqlient
+type __createProjectInvitationInput struct {
+	ProjectId string         `json:"projectId"`
+	Input     ProjectInvitee `json:"input"`
+}
+
+// GetProjectId returns __createProjectInvitationInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__createProjectInvitationInput) GetProjectId() string { return v.ProjectId }
+
+// GetInput returns __createProjectInvitationInput.Input, and is useful for accessing the field via an interface.
+func (v *__createProjectInvitationInput) GetInput() ProjectInvitee { return v.Input }
+
 // __createServiceDomainInput is used internally by genqlient
 type __createServiceDomainInput struct {
 	Input ServiceDomainCreateInput `json:"input"`
@@ -1004,6 +1613,14 @@ type __createVolumeInput struct {
 // GetInput returns __createVolumeInput.Input, and is useful for accessing the field via an interface.
 func (v *__createVolumeInput) GetInput() VolumeCreateInput { return v.Input }
 
+// __createVolumeInstanceBackupInput is used internally by genqlient
+type __createVolumeInstanceBackupInput struct {
+	VolumeInstanceId string `json:"volumeInstanceId"`
+}
+
+// GetVolumeInstanceId returns __createVolumeInstanceBackupInput.VolumeInstanceId, and is useful for accessing the field via an interface.
+func (v *__createVolumeInstanceBackupInput) GetVolumeInstanceId() string { return v.VolumeInstanceId }
+
 // __deleteCustomDomainInput is used internally by genqlient
 type __deleteCustomDomainInput struct {
 	Id string `json:"id"`
@@ -1012,6 +1629,14 @@ type __deleteCustomDomainInput struct {
 // GetId returns __deleteCustomDomainInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteCustomDomainInput) GetId() string { return v.Id }
 
+// __deleteDeploymentTriggerInput is used internally by genqlient
+type __deleteDeploymentTriggerInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteDeploymentTriggerInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteDeploymentTriggerInput) GetId() string { return v.Id }
+
 // __deleteEnvironmentInput is used internally by genqlient
 type __deleteEnvironmentInput struct {
 	Id string `json:"id"`
@@ -1046,6 +1671,14 @@ type __deleteProjectInput struct {
 // GetId returns __deleteProjectInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteProjectInput) GetId() string { return v.Id }
 
+// __deleteProjectInvitationInput is used internally by genqlient
+type __deleteProjectInvitationInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteProjectInvitationInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteProjectInvitationInput) GetId() string { return v.Id }
+
 // __deleteServiceDomainInput is used internally by genqlient
 type __deleteServiceDomainInput struct {
 	Id string `json:"id"`
@@ -1086,6 +1719,28 @@ type __deleteVolumeInput struct {
 // GetId returns __deleteVolumeInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteVolumeInput) GetId() string { return v.Id }
 
+// __deleteVolumeInstanceBackupInput is used internally by genqlient
+type __deleteVolumeInstanceBackupInput struct {
+	VolumeInstanceId       string `json:"volumeInstanceId"`
+	VolumeInstanceBackupId string `json:"volumeInstanceBackupId"`
+}
+
+// GetVolumeInstanceId returns __deleteVolumeInstanceBackupInput.VolumeInstanceId, and is useful for accessing the field via an interface.
+func (v *__deleteVolumeInstanceBackupInput) GetVolumeInstanceId() string { return v.VolumeInstanceId }
+
+// GetVolumeInstanceBackupId returns __deleteVolumeInstanceBackupInput.VolumeInstanceBackupId, and is useful for accessing the field via an interface.
+func (v *__deleteVolumeInstanceBackupInput) GetVolumeInstanceBackupId() string {
+	return v.VolumeInstanceBackupId
+}
+
+// __deployTemplateInput is used internally by genqlient
+type __deployTemplateInput struct {
+	Input TemplateDeployV2Input `json:"input"`
+}
+
+// GetInput returns __deployTemplateInput.Input, and is useful for accessing the field via an interface.
+func (v *__deployTemplateInput) GetInput() TemplateDeployV2Input { return v.Input }
+
 // __disconnectServiceInput is used internally by genqlient
 type __disconnectServiceInput struct {
 	Id string `json:"id"`
@@ -1094,31 +1749,91 @@ type __disconnectServiceInput struct {
 // GetId returns __disconnectServiceInput.Id, and is useful for accessing the field via an interface.
 func (v *__disconnectServiceInput) GetId() string { return v.Id }
 
-// __getEnvironmentInput is used internally by genqlient
-type __getEnvironmentInput struct {
-	Id string `json:"id"`
+// __getBuildLogsInput is used internally by genqlient
+type __getBuildLogsInput struct {
+	DeploymentId string `json:"deploymentId"`
+	Limit        int    `json:"limit"`
 }
 
-// GetId returns __getEnvironmentInput.Id, and is useful for accessing the field via an interface.
-func (v *__getEnvironmentInput) GetId() string { return v.Id }
+// GetDeploymentId returns __getBuildLogsInput.DeploymentId, and is useful for accessing the field via an interface.
+func (v *__getBuildLogsInput) GetDeploymentId() string { return v.DeploymentId }
+
+// GetLimit returns __getBuildLogsInput.Limit, and is useful for accessing the field via an interface.
+func (v *__getBuildLogsInput) GetLimit() int { return v.Limit }
 
-// __getEnvironmentsInput is used internally by genqlient
-type __getEnvironmentsInput struct {
+// __getCustomDomainRecordsInput is used internally by genqlient
+type __getCustomDomainRecordsInput struct {
+	Id        string `json:"id"`
 	ProjectId string `json:"projectId"`
 }
 
-// GetProjectId returns __getEnvironmentsInput.ProjectId, and is useful for accessing the field via an interface.
-func (v *__getEnvironmentsInput) GetProjectId() string { return v.ProjectId }
+// GetId returns __getCustomDomainRecordsInput.Id, and is useful for accessing the field via an interface.
+func (v *__getCustomDomainRecordsInput) GetId() string { return v.Id }
 
-// __getPrivateNetworkEndpointInput is used internally by genqlient
-type __getPrivateNetworkEndpointInput struct {
-	EnvironmentId    *string `json:"environmentId"`
-	PrivateNetworkId *string `json:"privateNetworkId"`
-	ServiceId        *string `json:"serviceId"`
+// GetProjectId returns __getCustomDomainRecordsInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__getCustomDomainRecordsInput) GetProjectId() string { return v.ProjectId }
+
+// __getDeploymentInput is used internally by genqlient
+type __getDeploymentInput struct {
+	Id string `json:"id"`
 }
 
-// GetEnvironmentId returns __getPrivateNetworkEndpointInput.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *__getPrivateNetworkEndpointInput) GetEnvironmentId() *string { return v.EnvironmentId }
+// GetId returns __getDeploymentInput.Id, and is useful for accessing the field via an interface.
+func (v *__getDeploymentInput) GetId() string { return v.Id }
+
+// __getDeploymentRollbackTargetInput is used internally by genqlient
+type __getDeploymentRollbackTargetInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getDeploymentRollbackTargetInput.Id, and is useful for accessing the field via an interface.
+func (v *__getDeploymentRollbackTargetInput) GetId() string { return v.Id }
+
+// __getDeploymentTriggerInput is used internally by genqlient
+type __getDeploymentTriggerInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getDeploymentTriggerInput.Id, and is useful for accessing the field via an interface.
+func (v *__getDeploymentTriggerInput) GetId() string { return v.Id }
+
+// __getEnvironmentInput is used internally by genqlient
+type __getEnvironmentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getEnvironmentInput.Id, and is useful for accessing the field via an interface.
+func (v *__getEnvironmentInput) GetId() string { return v.Id }
+
+// __getEnvironmentServiceIdsInput is used internally by genqlient
+type __getEnvironmentServiceIdsInput struct {
+	EnvironmentId string  `json:"environmentId"`
+	After         *string `json:"after"`
+}
+
+// GetEnvironmentId returns __getEnvironmentServiceIdsInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__getEnvironmentServiceIdsInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetAfter returns __getEnvironmentServiceIdsInput.After, and is useful for accessing the field via an interface.
+func (v *__getEnvironmentServiceIdsInput) GetAfter() *string { return v.After }
+
+// __getEnvironmentStagedChangesInput is used internally by genqlient
+type __getEnvironmentStagedChangesInput struct {
+	EnvironmentId string `json:"environmentId"`
+}
+
+// GetEnvironmentId returns __getEnvironmentStagedChangesInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__getEnvironmentStagedChangesInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// __getPrivateNetworkEndpointInput is used internally by genqlient
+type __getPrivateNetworkEndpointInput struct {
+	EnvironmentId    *string `json:"environmentId"`
+	PrivateNetworkId *string `json:"privateNetworkId"`
+	ServiceId        *string `json:"serviceId"`
+}
+
+// GetEnvironmentId returns __getPrivateNetworkEndpointInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__getPrivateNetworkEndpointInput) GetEnvironmentId() *string { return v.EnvironmentId }
 
 // GetPrivateNetworkId returns __getPrivateNetworkEndpointInput.PrivateNetworkId, and is useful for accessing the field via an interface.
 func (v *__getPrivateNetworkEndpointInput) GetPrivateNetworkId() *string { return v.PrivateNetworkId }
@@ -1142,6 +1857,38 @@ type __getProjectInput struct {
 // GetId returns __getProjectInput.Id, and is useful for accessing the field via an interface.
 func (v *__getProjectInput) GetId() string { return v.Id }
 
+// __getProjectPlanLimitsInput is used internally by genqlient
+type __getProjectPlanLimitsInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getProjectPlanLimitsInput.Id, and is useful for accessing the field via an interface.
+func (v *__getProjectPlanLimitsInput) GetId() string { return v.Id }
+
+// __getProjectWorkspaceInput is used internally by genqlient
+type __getProjectWorkspaceInput struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetWorkspaceId returns __getProjectWorkspaceInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__getProjectWorkspaceInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// __getResolvedVariablesInput is used internally by genqlient
+type __getResolvedVariablesInput struct {
+	ProjectId     string `json:"projectId"`
+	EnvironmentId string `json:"environmentId"`
+	ServiceId     string `json:"serviceId"`
+}
+
+// GetProjectId returns __getResolvedVariablesInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__getResolvedVariablesInput) GetProjectId() string { return v.ProjectId }
+
+// GetEnvironmentId returns __getResolvedVariablesInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__getResolvedVariablesInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns __getResolvedVariablesInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getResolvedVariablesInput) GetServiceId() string { return v.ServiceId }
+
 // __getServiceInput is used internally by genqlient
 type __getServiceInput struct {
 	Id string `json:"id"`
@@ -1174,6 +1921,18 @@ func (v *__getServiceInstanceInput) GetEnvironmentId() string { return v.Environ
 // GetServiceId returns __getServiceInstanceInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *__getServiceInstanceInput) GetServiceId() string { return v.ServiceId }
 
+// __getServiceInstanceLimitOverrideInput is used internally by genqlient
+type __getServiceInstanceLimitOverrideInput struct {
+	EnvironmentId string `json:"environmentId"`
+	ServiceId     string `json:"serviceId"`
+}
+
+// GetEnvironmentId returns __getServiceInstanceLimitOverrideInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__getServiceInstanceLimitOverrideInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns __getServiceInstanceLimitOverrideInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getServiceInstanceLimitOverrideInput) GetServiceId() string { return v.ServiceId }
+
 // __getServiceInstancesInput is used internally by genqlient
 type __getServiceInstancesInput struct {
 	ServiceId string `json:"serviceId"`
@@ -1182,6 +1941,62 @@ type __getServiceInstancesInput struct {
 // GetServiceId returns __getServiceInstancesInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *__getServiceInstancesInput) GetServiceId() string { return v.ServiceId }
 
+// __getServiceMetricsInput is used internally by genqlient
+type __getServiceMetricsInput struct {
+	ServiceId         string            `json:"serviceId"`
+	EnvironmentId     string            `json:"environmentId"`
+	Measurement       MetricMeasurement `json:"measurement"`
+	StartDate         time.Time         `json:"startDate"`
+	EndDate           time.Time         `json:"endDate"`
+	SampleRateSeconds int               `json:"sampleRateSeconds"`
+}
+
+// GetServiceId returns __getServiceMetricsInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getServiceMetricsInput) GetServiceId() string { return v.ServiceId }
+
+// GetEnvironmentId returns __getServiceMetricsInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__getServiceMetricsInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetMeasurement returns __getServiceMetricsInput.Measurement, and is useful for accessing the field via an interface.
+func (v *__getServiceMetricsInput) GetMeasurement() MetricMeasurement { return v.Measurement }
+
+// GetStartDate returns __getServiceMetricsInput.StartDate, and is useful for accessing the field via an interface.
+func (v *__getServiceMetricsInput) GetStartDate() time.Time { return v.StartDate }
+
+// GetEndDate returns __getServiceMetricsInput.EndDate, and is useful for accessing the field via an interface.
+func (v *__getServiceMetricsInput) GetEndDate() time.Time { return v.EndDate }
+
+// GetSampleRateSeconds returns __getServiceMetricsInput.SampleRateSeconds, and is useful for accessing the field via an interface.
+func (v *__getServiceMetricsInput) GetSampleRateSeconds() int { return v.SampleRateSeconds }
+
+// __getServicePlanLimitsInput is used internally by genqlient
+type __getServicePlanLimitsInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getServicePlanLimitsInput.Id, and is useful for accessing the field via an interface.
+func (v *__getServicePlanLimitsInput) GetId() string { return v.Id }
+
+// __getServiceRepoTriggersInput is used internally by genqlient
+type __getServiceRepoTriggersInput struct {
+	ServiceId string `json:"serviceId"`
+}
+
+// GetServiceId returns __getServiceRepoTriggersInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getServiceRepoTriggersInput) GetServiceId() string { return v.ServiceId }
+
+// __getServiceUsageInput is used internally by genqlient
+type __getServiceUsageInput struct {
+	ProjectId   *string `json:"projectId"`
+	WorkspaceId *string `json:"workspaceId"`
+}
+
+// GetProjectId returns __getServiceUsageInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__getServiceUsageInput) GetProjectId() *string { return v.ProjectId }
+
+// GetWorkspaceId returns __getServiceUsageInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__getServiceUsageInput) GetWorkspaceId() *string { return v.WorkspaceId }
+
 // __getSharedVariablesInput is used internally by genqlient
 type __getSharedVariablesInput struct {
 	ProjectId     string `json:"projectId"`
@@ -1206,6 +2021,14 @@ func (v *__getTcpProxyInput) GetEnvironmentId() string { return v.EnvironmentId
 // GetServiceId returns __getTcpProxyInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *__getTcpProxyInput) GetServiceId() string { return v.ServiceId }
 
+// __getTemplateInput is used internally by genqlient
+type __getTemplateInput struct {
+	Code string `json:"code"`
+}
+
+// GetCode returns __getTemplateInput.Code, and is useful for accessing the field via an interface.
+func (v *__getTemplateInput) GetCode() string { return v.Code }
+
 // __getVariablesInput is used internally by genqlient
 type __getVariablesInput struct {
 	ProjectId     string `json:"projectId"`
@@ -1222,6 +2045,14 @@ func (v *__getVariablesInput) GetEnvironmentId() string { return v.EnvironmentId
 // GetServiceId returns __getVariablesInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *__getVariablesInput) GetServiceId() string { return v.ServiceId }
 
+// __getVolumeInstanceStateInput is used internally by genqlient
+type __getVolumeInstanceStateInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getVolumeInstanceStateInput.Id, and is useful for accessing the field via an interface.
+func (v *__getVolumeInstanceStateInput) GetId() string { return v.Id }
+
 // __getVolumeInstancesInput is used internally by genqlient
 type __getVolumeInstancesInput struct {
 	Id string `json:"id"`
@@ -1230,6 +2061,38 @@ type __getVolumeInstancesInput struct {
 // GetId returns __getVolumeInstancesInput.Id, and is useful for accessing the field via an interface.
 func (v *__getVolumeInstancesInput) GetId() string { return v.Id }
 
+// __getWorkflowStatusInput is used internally by genqlient
+type __getWorkflowStatusInput struct {
+	WorkflowId string `json:"workflowId"`
+}
+
+// GetWorkflowId returns __getWorkflowStatusInput.WorkflowId, and is useful for accessing the field via an interface.
+func (v *__getWorkflowStatusInput) GetWorkflowId() string { return v.WorkflowId }
+
+// __getWorkspaceUsageLimitInput is used internally by genqlient
+type __getWorkspaceUsageLimitInput struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetWorkspaceId returns __getWorkspaceUsageLimitInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__getWorkspaceUsageLimitInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// __listCustomDomainRecordsInput is used internally by genqlient
+type __listCustomDomainRecordsInput struct {
+	EnvironmentId string `json:"environmentId"`
+	ServiceId     string `json:"serviceId"`
+	ProjectId     string `json:"projectId"`
+}
+
+// GetEnvironmentId returns __listCustomDomainRecordsInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__listCustomDomainRecordsInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns __listCustomDomainRecordsInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__listCustomDomainRecordsInput) GetServiceId() string { return v.ServiceId }
+
+// GetProjectId returns __listCustomDomainRecordsInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listCustomDomainRecordsInput) GetProjectId() string { return v.ProjectId }
+
 // __listCustomDomainsInput is used internally by genqlient
 type __listCustomDomainsInput struct {
 	EnvironmentId string `json:"environmentId"`
@@ -1262,6 +2125,154 @@ func (v *__listDeploymentTriggersInput) GetEnvironmentId() string { return v.Env
 // GetServiceId returns __listDeploymentTriggersInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *__listDeploymentTriggersInput) GetServiceId() string { return v.ServiceId }
 
+// __listDeploymentsInput is used internally by genqlient
+type __listDeploymentsInput struct {
+	Input DeploymentListInput `json:"input"`
+	First int                 `json:"first"`
+	After *string             `json:"after"`
+}
+
+// GetInput returns __listDeploymentsInput.Input, and is useful for accessing the field via an interface.
+func (v *__listDeploymentsInput) GetInput() DeploymentListInput { return v.Input }
+
+// GetFirst returns __listDeploymentsInput.First, and is useful for accessing the field via an interface.
+func (v *__listDeploymentsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __listDeploymentsInput.After, and is useful for accessing the field via an interface.
+func (v *__listDeploymentsInput) GetAfter() *string { return v.After }
+
+// __listDomainsInput is used internally by genqlient
+type __listDomainsInput struct {
+	EnvironmentId string `json:"environmentId"`
+	ServiceId     string `json:"serviceId"`
+	ProjectId     string `json:"projectId"`
+}
+
+// GetEnvironmentId returns __listDomainsInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__listDomainsInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns __listDomainsInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__listDomainsInput) GetServiceId() string { return v.ServiceId }
+
+// GetProjectId returns __listDomainsInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listDomainsInput) GetProjectId() string { return v.ProjectId }
+
+// __listEnvironmentCustomDomainRecordsInput is used internally by genqlient
+type __listEnvironmentCustomDomainRecordsInput struct {
+	EnvironmentId string  `json:"environmentId"`
+	ProjectId     string  `json:"projectId"`
+	After         *string `json:"after"`
+}
+
+// GetEnvironmentId returns __listEnvironmentCustomDomainRecordsInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__listEnvironmentCustomDomainRecordsInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetProjectId returns __listEnvironmentCustomDomainRecordsInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listEnvironmentCustomDomainRecordsInput) GetProjectId() string { return v.ProjectId }
+
+// GetAfter returns __listEnvironmentCustomDomainRecordsInput.After, and is useful for accessing the field via an interface.
+func (v *__listEnvironmentCustomDomainRecordsInput) GetAfter() *string { return v.After }
+
+// __listEnvironmentVolumeInstancesInput is used internally by genqlient
+type __listEnvironmentVolumeInstancesInput struct {
+	EnvironmentId string  `json:"environmentId"`
+	After         *string `json:"after"`
+}
+
+// GetEnvironmentId returns __listEnvironmentVolumeInstancesInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__listEnvironmentVolumeInstancesInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetAfter returns __listEnvironmentVolumeInstancesInput.After, and is useful for accessing the field via an interface.
+func (v *__listEnvironmentVolumeInstancesInput) GetAfter() *string { return v.After }
+
+// __listEnvironmentsInput is used internally by genqlient
+type __listEnvironmentsInput struct {
+	ProjectId   string  `json:"projectId"`
+	IsEphemeral *bool   `json:"isEphemeral"`
+	After       *string `json:"after"`
+}
+
+// GetProjectId returns __listEnvironmentsInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listEnvironmentsInput) GetProjectId() string { return v.ProjectId }
+
+// GetIsEphemeral returns __listEnvironmentsInput.IsEphemeral, and is useful for accessing the field via an interface.
+func (v *__listEnvironmentsInput) GetIsEphemeral() *bool { return v.IsEphemeral }
+
+// GetAfter returns __listEnvironmentsInput.After, and is useful for accessing the field via an interface.
+func (v *__listEnvironmentsInput) GetAfter() *string { return v.After }
+
+// __listEventsInput is used internally by genqlient
+type __listEventsInput struct {
+	ProjectId     string  `json:"projectId"`
+	EnvironmentId *string `json:"environmentId"`
+	First         int     `json:"first"`
+	After         *string `json:"after"`
+}
+
+// GetProjectId returns __listEventsInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listEventsInput) GetProjectId() string { return v.ProjectId }
+
+// GetEnvironmentId returns __listEventsInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__listEventsInput) GetEnvironmentId() *string { return v.EnvironmentId }
+
+// GetFirst returns __listEventsInput.First, and is useful for accessing the field via an interface.
+func (v *__listEventsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __listEventsInput.After, and is useful for accessing the field via an interface.
+func (v *__listEventsInput) GetAfter() *string { return v.After }
+
+// __listProjectInvitationsInput is used internally by genqlient
+type __listProjectInvitationsInput struct {
+	ProjectId string `json:"projectId"`
+}
+
+// GetProjectId returns __listProjectInvitationsInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listProjectInvitationsInput) GetProjectId() string { return v.ProjectId }
+
+// __listProjectMembersInput is used internally by genqlient
+type __listProjectMembersInput struct {
+	ProjectId string `json:"projectId"`
+}
+
+// GetProjectId returns __listProjectMembersInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listProjectMembersInput) GetProjectId() string { return v.ProjectId }
+
+// __listProjectServicesInput is used internally by genqlient
+type __listProjectServicesInput struct {
+	ProjectId string  `json:"projectId"`
+	After     *string `json:"after"`
+}
+
+// GetProjectId returns __listProjectServicesInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listProjectServicesInput) GetProjectId() string { return v.ProjectId }
+
+// GetAfter returns __listProjectServicesInput.After, and is useful for accessing the field via an interface.
+func (v *__listProjectServicesInput) GetAfter() *string { return v.After }
+
+// __listProjectTokensInput is used internally by genqlient
+type __listProjectTokensInput struct {
+	ProjectId string  `json:"projectId"`
+	After     *string `json:"after"`
+}
+
+// GetProjectId returns __listProjectTokensInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listProjectTokensInput) GetProjectId() string { return v.ProjectId }
+
+// GetAfter returns __listProjectTokensInput.After, and is useful for accessing the field via an interface.
+func (v *__listProjectTokensInput) GetAfter() *string { return v.After }
+
+// __listProjectVolumesInput is used internally by genqlient
+type __listProjectVolumesInput struct {
+	ProjectId string  `json:"projectId"`
+	After     *string `json:"after"`
+}
+
+// GetProjectId returns __listProjectVolumesInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listProjectVolumesInput) GetProjectId() string { return v.ProjectId }
+
+// GetAfter returns __listProjectVolumesInput.After, and is useful for accessing the field via an interface.
+func (v *__listProjectVolumesInput) GetAfter() *string { return v.After }
+
 // __listServiceDomainsInput is used internally by genqlient
 type __listServiceDomainsInput struct {
 	EnvironmentId string `json:"environmentId"`
@@ -1278,6 +2289,34 @@ func (v *__listServiceDomainsInput) GetServiceId() string { return v.ServiceId }
 // GetProjectId returns __listServiceDomainsInput.ProjectId, and is useful for accessing the field via an interface.
 func (v *__listServiceDomainsInput) GetProjectId() string { return v.ProjectId }
 
+// __listTcpProxyProjectsInput is used internally by genqlient
+type __listTcpProxyProjectsInput struct {
+	After *string `json:"after"`
+}
+
+// GetAfter returns __listTcpProxyProjectsInput.After, and is useful for accessing the field via an interface.
+func (v *__listTcpProxyProjectsInput) GetAfter() *string { return v.After }
+
+// __listVolumeInstanceBackupsInput is used internally by genqlient
+type __listVolumeInstanceBackupsInput struct {
+	VolumeInstanceId string `json:"volumeInstanceId"`
+}
+
+// GetVolumeInstanceId returns __listVolumeInstanceBackupsInput.VolumeInstanceId, and is useful for accessing the field via an interface.
+func (v *__listVolumeInstanceBackupsInput) GetVolumeInstanceId() string { return v.VolumeInstanceId }
+
+// __redeployDeploymentInput is used internally by genqlient
+type __redeployDeploymentInput struct {
+	Id                  string `json:"id"`
+	UsePreviousImageTag bool   `json:"usePreviousImageTag"`
+}
+
+// GetId returns __redeployDeploymentInput.Id, and is useful for accessing the field via an interface.
+func (v *__redeployDeploymentInput) GetId() string { return v.Id }
+
+// GetUsePreviousImageTag returns __redeployDeploymentInput.UsePreviousImageTag, and is useful for accessing the field via an interface.
+func (v *__redeployDeploymentInput) GetUsePreviousImageTag() bool { return v.UsePreviousImageTag }
+
 // __redeployServiceInstanceInput is used internally by genqlient
 type __redeployServiceInstanceInput struct {
 	EnvironmentId string `json:"environmentId"`
@@ -1302,6 +2341,100 @@ func (v *__redeployServiceInstanceWithEnvInput) GetEnvironmentId() string { retu
 // GetServiceId returns __redeployServiceInstanceWithEnvInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *__redeployServiceInstanceWithEnvInput) GetServiceId() string { return v.ServiceId }
 
+// __removeProjectMemberInput is used internally by genqlient
+type __removeProjectMemberInput struct {
+	Input ProjectMemberRemoveInput `json:"input"`
+}
+
+// GetInput returns __removeProjectMemberInput.Input, and is useful for accessing the field via an interface.
+func (v *__removeProjectMemberInput) GetInput() ProjectMemberRemoveInput { return v.Input }
+
+// __removeUsageLimitInput is used internally by genqlient
+type __removeUsageLimitInput struct {
+	Input UsageLimitRemoveInput `json:"input"`
+}
+
+// GetInput returns __removeUsageLimitInput.Input, and is useful for accessing the field via an interface.
+func (v *__removeUsageLimitInput) GetInput() UsageLimitRemoveInput { return v.Input }
+
+// __restartDeploymentInput is used internally by genqlient
+type __restartDeploymentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __restartDeploymentInput.Id, and is useful for accessing the field via an interface.
+func (v *__restartDeploymentInput) GetId() string { return v.Id }
+
+// __restoreVolumeInstanceBackupInput is used internally by genqlient
+type __restoreVolumeInstanceBackupInput struct {
+	VolumeInstanceId       string `json:"volumeInstanceId"`
+	VolumeInstanceBackupId string `json:"volumeInstanceBackupId"`
+}
+
+// GetVolumeInstanceId returns __restoreVolumeInstanceBackupInput.VolumeInstanceId, and is useful for accessing the field via an interface.
+func (v *__restoreVolumeInstanceBackupInput) GetVolumeInstanceId() string { return v.VolumeInstanceId }
+
+// GetVolumeInstanceBackupId returns __restoreVolumeInstanceBackupInput.VolumeInstanceBackupId, and is useful for accessing the field via an interface.
+func (v *__restoreVolumeInstanceBackupInput) GetVolumeInstanceBackupId() string {
+	return v.VolumeInstanceBackupId
+}
+
+// __rollbackDeploymentInput is used internally by genqlient
+type __rollbackDeploymentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __rollbackDeploymentInput.Id, and is useful for accessing the field via an interface.
+func (v *__rollbackDeploymentInput) GetId() string { return v.Id }
+
+// __setUsageLimitInput is used internally by genqlient
+type __setUsageLimitInput struct {
+	Input UsageLimitSetInput `json:"input"`
+}
+
+// GetInput returns __setUsageLimitInput.Input, and is useful for accessing the field via an interface.
+func (v *__setUsageLimitInput) GetInput() UsageLimitSetInput { return v.Input }
+
+// __transferProjectInput is used internally by genqlient
+type __transferProjectInput struct {
+	ProjectId string               `json:"projectId"`
+	Input     ProjectTransferInput `json:"input"`
+}
+
+// GetProjectId returns __transferProjectInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__transferProjectInput) GetProjectId() string { return v.ProjectId }
+
+// GetInput returns __transferProjectInput.Input, and is useful for accessing the field via an interface.
+func (v *__transferProjectInput) GetInput() ProjectTransferInput { return v.Input }
+
+// __updateDeploymentTriggerInput is used internally by genqlient
+type __updateDeploymentTriggerInput struct {
+	Id    string                       `json:"id"`
+	Input DeploymentTriggerUpdateInput `json:"input"`
+}
+
+// GetId returns __updateDeploymentTriggerInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateDeploymentTriggerInput) GetId() string { return v.Id }
+
+// GetInput returns __updateDeploymentTriggerInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateDeploymentTriggerInput) GetInput() DeploymentTriggerUpdateInput { return v.Input }
+
+// __updateEnvironmentVolumeInstanceInput is used internally by genqlient
+type __updateEnvironmentVolumeInstanceInput struct {
+	VolumeId      string                    `json:"volumeId"`
+	EnvironmentId string                    `json:"environmentId"`
+	Input         VolumeInstanceUpdateInput `json:"input"`
+}
+
+// GetVolumeId returns __updateEnvironmentVolumeInstanceInput.VolumeId, and is useful for accessing the field via an interface.
+func (v *__updateEnvironmentVolumeInstanceInput) GetVolumeId() string { return v.VolumeId }
+
+// GetEnvironmentId returns __updateEnvironmentVolumeInstanceInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__updateEnvironmentVolumeInstanceInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetInput returns __updateEnvironmentVolumeInstanceInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateEnvironmentVolumeInstanceInput) GetInput() VolumeInstanceUpdateInput { return v.Input }
+
 // __updateProjectInput is used internally by genqlient
 type __updateProjectInput struct {
 	Id    string             `json:"id"`
@@ -1314,6 +2447,14 @@ func (v *__updateProjectInput) GetId() string { return v.Id }
 // GetInput returns __updateProjectInput.Input, and is useful for accessing the field via an interface.
 func (v *__updateProjectInput) GetInput() ProjectUpdateInput { return v.Input }
 
+// __updateProjectMemberInput is used internally by genqlient
+type __updateProjectMemberInput struct {
+	Input ProjectMemberUpdateInput `json:"input"`
+}
+
+// GetInput returns __updateProjectMemberInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateProjectMemberInput) GetInput() ProjectMemberUpdateInput { return v.Input }
+
 // __updateServiceDomainInput is used internally by genqlient
 type __updateServiceDomainInput struct {
 	Input ServiceDomainUpdateInput `json:"input"`
@@ -1412,6 +2553,28 @@ type __upsertVariableInput struct {
 // GetInput returns __upsertVariableInput.Input, and is useful for accessing the field via an interface.
 func (v *__upsertVariableInput) GetInput() VariableUpsertInput { return v.Input }
 
+// commitEnvironmentPatchResponse is returned by commitEnvironmentPatch on success.
+type commitEnvironmentPatchResponse struct {
+	// Commit the provided patch to the environment.
+	EnvironmentPatchCommit string `json:"environmentPatchCommit"`
+}
+
+// GetEnvironmentPatchCommit returns commitEnvironmentPatchResponse.EnvironmentPatchCommit, and is useful for accessing the field via an interface.
+func (v *commitEnvironmentPatchResponse) GetEnvironmentPatchCommit() string {
+	return v.EnvironmentPatchCommit
+}
+
+// commitEnvironmentStagedChangesResponse is returned by commitEnvironmentStagedChanges on success.
+type commitEnvironmentStagedChangesResponse struct {
+	// Commit the provided patch to the environment.
+	EnvironmentPatchCommit string `json:"environmentPatchCommit"`
+}
+
+// GetEnvironmentPatchCommit returns commitEnvironmentStagedChangesResponse.EnvironmentPatchCommit, and is useful for accessing the field via an interface.
+func (v *commitEnvironmentStagedChangesResponse) GetEnvironmentPatchCommit() string {
+	return v.EnvironmentPatchCommit
+}
+
 // connectServiceResponse is returned by connectService on success.
 type connectServiceResponse struct {
 	// Connect a service to a source
@@ -1582,33 +2745,57 @@ func (v *createCustomDomainResponse) GetCustomDomainCreate() createCustomDomainC
 	return v.CustomDomainCreate
 }
 
-// createEnvironmentEnvironmentCreateEnvironment includes the requested fields of the GraphQL type Environment.
-type createEnvironmentEnvironmentCreateEnvironment struct {
-	Environment `json:"-"`
+// createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger includes the requested fields of the GraphQL type DeploymentTrigger.
+type createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger struct {
+	DeploymentTrigger `json:"-"`
 }
 
-// GetId returns createEnvironmentEnvironmentCreateEnvironment.Id, and is useful for accessing the field via an interface.
-func (v *createEnvironmentEnvironmentCreateEnvironment) GetId() string { return v.Environment.Id }
+// GetId returns createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger.Id, and is useful for accessing the field via an interface.
+func (v *createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger) GetId() string {
+	return v.DeploymentTrigger.Id
+}
 
-// GetName returns createEnvironmentEnvironmentCreateEnvironment.Name, and is useful for accessing the field via an interface.
-func (v *createEnvironmentEnvironmentCreateEnvironment) GetName() string { return v.Environment.Name }
+// GetProjectId returns createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger.ProjectId, and is useful for accessing the field via an interface.
+func (v *createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger) GetProjectId() string {
+	return v.DeploymentTrigger.ProjectId
+}
 
-// GetProjectId returns createEnvironmentEnvironmentCreateEnvironment.ProjectId, and is useful for accessing the field via an interface.
-func (v *createEnvironmentEnvironmentCreateEnvironment) GetProjectId() string {
-	return v.Environment.ProjectId
+// GetEnvironmentId returns createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger) GetEnvironmentId() string {
+	return v.DeploymentTrigger.EnvironmentId
 }
 
-func (v *createEnvironmentEnvironmentCreateEnvironment) UnmarshalJSON(b []byte) error {
+// GetServiceId returns createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger.ServiceId, and is useful for accessing the field via an interface.
+func (v *createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger) GetServiceId() *string {
+	return v.DeploymentTrigger.ServiceId
+}
+
+// GetRepository returns createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger.Repository, and is useful for accessing the field via an interface.
+func (v *createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger) GetRepository() string {
+	return v.DeploymentTrigger.Repository
+}
+
+// GetBranch returns createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger.Branch, and is useful for accessing the field via an interface.
+func (v *createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger) GetBranch() string {
+	return v.DeploymentTrigger.Branch
+}
+
+// GetCheckSuites returns createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger.CheckSuites, and is useful for accessing the field via an interface.
+func (v *createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger) GetCheckSuites() bool {
+	return v.DeploymentTrigger.CheckSuites
+}
+
+func (v *createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*createEnvironmentEnvironmentCreateEnvironment
+		*createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.createEnvironmentEnvironmentCreateEnvironment = v
+	firstPass.createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1616,22 +2803,30 @@ func (v *createEnvironmentEnvironmentCreateEnvironment) UnmarshalJSON(b []byte)
 	}
 
 	err = json.Unmarshal(
-		b, &v.Environment)
+		b, &v.DeploymentTrigger)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalcreateEnvironmentEnvironmentCreateEnvironment struct {
+type __premarshalcreateDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger struct {
 	Id string `json:"id"`
 
-	Name string `json:"name"`
-
 	ProjectId string `json:"projectId"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId *string `json:"serviceId"`
+
+	Repository string `json:"repository"`
+
+	Branch string `json:"branch"`
+
+	CheckSuites bool `json:"checkSuites"`
 }
 
-func (v *createEnvironmentEnvironmentCreateEnvironment) MarshalJSON() ([]byte, error) {
+func (v *createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1639,22 +2834,111 @@ func (v *createEnvironmentEnvironmentCreateEnvironment) MarshalJSON() ([]byte, e
 	return json.Marshal(premarshaled)
 }
 
-func (v *createEnvironmentEnvironmentCreateEnvironment) __premarshalJSON() (*__premarshalcreateEnvironmentEnvironmentCreateEnvironment, error) {
-	var retval __premarshalcreateEnvironmentEnvironmentCreateEnvironment
+func (v *createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger) __premarshalJSON() (*__premarshalcreateDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger, error) {
+	var retval __premarshalcreateDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger
 
-	retval.Id = v.Environment.Id
-	retval.Name = v.Environment.Name
-	retval.ProjectId = v.Environment.ProjectId
+	retval.Id = v.DeploymentTrigger.Id
+	retval.ProjectId = v.DeploymentTrigger.ProjectId
+	retval.EnvironmentId = v.DeploymentTrigger.EnvironmentId
+	retval.ServiceId = v.DeploymentTrigger.ServiceId
+	retval.Repository = v.DeploymentTrigger.Repository
+	retval.Branch = v.DeploymentTrigger.Branch
+	retval.CheckSuites = v.DeploymentTrigger.CheckSuites
 	return &retval, nil
 }
 
-// createEnvironmentResponse is returned by createEnvironment on success.
-type createEnvironmentResponse struct {
-	// Creates a new environment.
-	EnvironmentCreate createEnvironmentEnvironmentCreateEnvironment `json:"environmentCreate"`
+// createDeploymentTriggerResponse is returned by createDeploymentTrigger on success.
+type createDeploymentTriggerResponse struct {
+	// Creates a deployment trigger.
+	DeploymentTriggerCreate createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger `json:"deploymentTriggerCreate"`
 }
 
-// GetEnvironmentCreate returns createEnvironmentResponse.EnvironmentCreate, and is useful for accessing the field via an interface.
+// GetDeploymentTriggerCreate returns createDeploymentTriggerResponse.DeploymentTriggerCreate, and is useful for accessing the field via an interface.
+func (v *createDeploymentTriggerResponse) GetDeploymentTriggerCreate() createDeploymentTriggerDeploymentTriggerCreateDeploymentTrigger {
+	return v.DeploymentTriggerCreate
+}
+
+// createEnvironmentEnvironmentCreateEnvironment includes the requested fields of the GraphQL type Environment.
+type createEnvironmentEnvironmentCreateEnvironment struct {
+	Environment `json:"-"`
+}
+
+// GetId returns createEnvironmentEnvironmentCreateEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *createEnvironmentEnvironmentCreateEnvironment) GetId() string { return v.Environment.Id }
+
+// GetName returns createEnvironmentEnvironmentCreateEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *createEnvironmentEnvironmentCreateEnvironment) GetName() string { return v.Environment.Name }
+
+// GetProjectId returns createEnvironmentEnvironmentCreateEnvironment.ProjectId, and is useful for accessing the field via an interface.
+func (v *createEnvironmentEnvironmentCreateEnvironment) GetProjectId() string {
+	return v.Environment.ProjectId
+}
+
+// GetIsEphemeral returns createEnvironmentEnvironmentCreateEnvironment.IsEphemeral, and is useful for accessing the field via an interface.
+func (v *createEnvironmentEnvironmentCreateEnvironment) GetIsEphemeral() bool {
+	return v.Environment.IsEphemeral
+}
+
+func (v *createEnvironmentEnvironmentCreateEnvironment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createEnvironmentEnvironmentCreateEnvironment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createEnvironmentEnvironmentCreateEnvironment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Environment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateEnvironmentEnvironmentCreateEnvironment struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	ProjectId string `json:"projectId"`
+
+	IsEphemeral bool `json:"isEphemeral"`
+}
+
+func (v *createEnvironmentEnvironmentCreateEnvironment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createEnvironmentEnvironmentCreateEnvironment) __premarshalJSON() (*__premarshalcreateEnvironmentEnvironmentCreateEnvironment, error) {
+	var retval __premarshalcreateEnvironmentEnvironmentCreateEnvironment
+
+	retval.Id = v.Environment.Id
+	retval.Name = v.Environment.Name
+	retval.ProjectId = v.Environment.ProjectId
+	retval.IsEphemeral = v.Environment.IsEphemeral
+	return &retval, nil
+}
+
+// createEnvironmentResponse is returned by createEnvironment on success.
+type createEnvironmentResponse struct {
+	// Creates a new environment.
+	EnvironmentCreate createEnvironmentEnvironmentCreateEnvironment `json:"environmentCreate"`
+}
+
+// GetEnvironmentCreate returns createEnvironmentResponse.EnvironmentCreate, and is useful for accessing the field via an interface.
 func (v *createEnvironmentResponse) GetEnvironmentCreate() createEnvironmentEnvironmentCreateEnvironment {
 	return v.EnvironmentCreate
 }
@@ -1761,6 +3045,31 @@ func (v *createOrGetPrivateNetworkResponse) GetPrivateNetworkCreateOrGet() creat
 	return v.PrivateNetworkCreateOrGet
 }
 
+// createProjectInvitationProjectInvitationCreateProjectInvitation includes the requested fields of the GraphQL type ProjectInvitation.
+type createProjectInvitationProjectInvitationCreateProjectInvitation struct {
+	Id    string `json:"id"`
+	Email string `json:"email"`
+}
+
+// GetId returns createProjectInvitationProjectInvitationCreateProjectInvitation.Id, and is useful for accessing the field via an interface.
+func (v *createProjectInvitationProjectInvitationCreateProjectInvitation) GetId() string { return v.Id }
+
+// GetEmail returns createProjectInvitationProjectInvitationCreateProjectInvitation.Email, and is useful for accessing the field via an interface.
+func (v *createProjectInvitationProjectInvitationCreateProjectInvitation) GetEmail() string {
+	return v.Email
+}
+
+// createProjectInvitationResponse is returned by createProjectInvitation on success.
+type createProjectInvitationResponse struct {
+	// Create an invitation for a project
+	ProjectInvitationCreate createProjectInvitationProjectInvitationCreateProjectInvitation `json:"projectInvitationCreate"`
+}
+
+// GetProjectInvitationCreate returns createProjectInvitationResponse.ProjectInvitationCreate, and is useful for accessing the field via an interface.
+func (v *createProjectInvitationResponse) GetProjectInvitationCreate() createProjectInvitationProjectInvitationCreateProjectInvitation {
+	return v.ProjectInvitationCreate
+}
+
 // createProjectProjectCreateProject includes the requested fields of the GraphQL type Project.
 type createProjectProjectCreateProject struct {
 	Project `json:"-"`
@@ -1781,6 +3090,11 @@ func (v *createProjectProjectCreateProject) GetIsPublic() bool { return v.Projec
 // GetPrDeploys returns createProjectProjectCreateProject.PrDeploys, and is useful for accessing the field via an interface.
 func (v *createProjectProjectCreateProject) GetPrDeploys() bool { return v.Project.PrDeploys }
 
+// GetBotPrEnvironments returns createProjectProjectCreateProject.BotPrEnvironments, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProject) GetBotPrEnvironments() bool {
+	return v.Project.BotPrEnvironments
+}
+
 // GetWorkspace returns createProjectProjectCreateProject.Workspace, and is useful for accessing the field via an interface.
 func (v *createProjectProjectCreateProject) GetWorkspace() *ProjectWorkspace {
 	return v.Project.Workspace
@@ -1827,6 +3141,8 @@ type __premarshalcreateProjectProjectCreateProject struct {
 
 	PrDeploys bool `json:"prDeploys"`
 
+	BotPrEnvironments bool `json:"botPrEnvironments"`
+
 	Workspace *ProjectWorkspace `json:"workspace"`
 
 	Environments ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
@@ -1848,6 +3164,7 @@ func (v *createProjectProjectCreateProject) __premarshalJSON() (*__premarshalcre
 	retval.Description = v.Project.Description
 	retval.IsPublic = v.Project.IsPublic
 	retval.PrDeploys = v.Project.PrDeploys
+	retval.BotPrEnvironments = v.Project.BotPrEnvironments
 	retval.Workspace = v.Project.Workspace
 	retval.Environments = v.Project.Environments
 	return &retval, nil
@@ -2133,6 +3450,27 @@ func (v *createTcpProxyTcpProxyCreateTCPProxy) __premarshalJSON() (*__premarshal
 	return &retval, nil
 }
 
+// createVolumeInstanceBackupResponse is returned by createVolumeInstanceBackup on success.
+type createVolumeInstanceBackupResponse struct {
+	// Create backup of a volume instance
+	VolumeInstanceBackupCreate createVolumeInstanceBackupVolumeInstanceBackupCreateWorkflowId `json:"volumeInstanceBackupCreate"`
+}
+
+// GetVolumeInstanceBackupCreate returns createVolumeInstanceBackupResponse.VolumeInstanceBackupCreate, and is useful for accessing the field via an interface.
+func (v *createVolumeInstanceBackupResponse) GetVolumeInstanceBackupCreate() createVolumeInstanceBackupVolumeInstanceBackupCreateWorkflowId {
+	return v.VolumeInstanceBackupCreate
+}
+
+// createVolumeInstanceBackupVolumeInstanceBackupCreateWorkflowId includes the requested fields of the GraphQL type WorkflowId.
+type createVolumeInstanceBackupVolumeInstanceBackupCreateWorkflowId struct {
+	WorkflowId *string `json:"workflowId"`
+}
+
+// GetWorkflowId returns createVolumeInstanceBackupVolumeInstanceBackupCreateWorkflowId.WorkflowId, and is useful for accessing the field via an interface.
+func (v *createVolumeInstanceBackupVolumeInstanceBackupCreateWorkflowId) GetWorkflowId() *string {
+	return v.WorkflowId
+}
+
 // createVolumeResponse is returned by createVolume on success.
 type createVolumeResponse struct {
 	// Create a persistent volume in a project
@@ -2219,6 +3557,17 @@ type deleteCustomDomainResponse struct {
 // GetCustomDomainDelete returns deleteCustomDomainResponse.CustomDomainDelete, and is useful for accessing the field via an interface.
 func (v *deleteCustomDomainResponse) GetCustomDomainDelete() bool { return v.CustomDomainDelete }
 
+// deleteDeploymentTriggerResponse is returned by deleteDeploymentTrigger on success.
+type deleteDeploymentTriggerResponse struct {
+	// Deletes a deployment trigger.
+	DeploymentTriggerDelete bool `json:"deploymentTriggerDelete"`
+}
+
+// GetDeploymentTriggerDelete returns deleteDeploymentTriggerResponse.DeploymentTriggerDelete, and is useful for accessing the field via an interface.
+func (v *deleteDeploymentTriggerResponse) GetDeploymentTriggerDelete() bool {
+	return v.DeploymentTriggerDelete
+}
+
 // deleteEnvironmentResponse is returned by deleteEnvironment on success.
 type deleteEnvironmentResponse struct {
 	// Deletes an environment.
@@ -2250,6 +3599,17 @@ func (v *deletePrivateNetworksForEnvironmentResponse) GetPrivateNetworksForEnvir
 	return v.PrivateNetworksForEnvironmentDelete
 }
 
+// deleteProjectInvitationResponse is returned by deleteProjectInvitation on success.
+type deleteProjectInvitationResponse struct {
+	// Delete an invitation for a project
+	ProjectInvitationDelete bool `json:"projectInvitationDelete"`
+}
+
+// GetProjectInvitationDelete returns deleteProjectInvitationResponse.ProjectInvitationDelete, and is useful for accessing the field via an interface.
+func (v *deleteProjectInvitationResponse) GetProjectInvitationDelete() bool {
+	return v.ProjectInvitationDelete
+}
+
 // deleteProjectResponse is returned by deleteProject on success.
 type deleteProjectResponse struct {
 	// Deletes a project.
@@ -2295,6 +3655,27 @@ type deleteVariableResponse struct {
 // GetVariableDelete returns deleteVariableResponse.VariableDelete, and is useful for accessing the field via an interface.
 func (v *deleteVariableResponse) GetVariableDelete() bool { return v.VariableDelete }
 
+// deleteVolumeInstanceBackupResponse is returned by deleteVolumeInstanceBackup on success.
+type deleteVolumeInstanceBackupResponse struct {
+	// Deletes volume instance backup
+	VolumeInstanceBackupDelete deleteVolumeInstanceBackupVolumeInstanceBackupDeleteWorkflowId `json:"volumeInstanceBackupDelete"`
+}
+
+// GetVolumeInstanceBackupDelete returns deleteVolumeInstanceBackupResponse.VolumeInstanceBackupDelete, and is useful for accessing the field via an interface.
+func (v *deleteVolumeInstanceBackupResponse) GetVolumeInstanceBackupDelete() deleteVolumeInstanceBackupVolumeInstanceBackupDeleteWorkflowId {
+	return v.VolumeInstanceBackupDelete
+}
+
+// deleteVolumeInstanceBackupVolumeInstanceBackupDeleteWorkflowId includes the requested fields of the GraphQL type WorkflowId.
+type deleteVolumeInstanceBackupVolumeInstanceBackupDeleteWorkflowId struct {
+	WorkflowId *string `json:"workflowId"`
+}
+
+// GetWorkflowId returns deleteVolumeInstanceBackupVolumeInstanceBackupDeleteWorkflowId.WorkflowId, and is useful for accessing the field via an interface.
+func (v *deleteVolumeInstanceBackupVolumeInstanceBackupDeleteWorkflowId) GetWorkflowId() *string {
+	return v.WorkflowId
+}
+
 // deleteVolumeResponse is returned by deleteVolume on success.
 type deleteVolumeResponse struct {
 	// Delete a persistent volume in a project
@@ -2304,6 +3685,33 @@ type deleteVolumeResponse struct {
 // GetVolumeDelete returns deleteVolumeResponse.VolumeDelete, and is useful for accessing the field via an interface.
 func (v *deleteVolumeResponse) GetVolumeDelete() bool { return v.VolumeDelete }
 
+// deployTemplateResponse is returned by deployTemplate on success.
+type deployTemplateResponse struct {
+	// Deploys a template using the serialized template config
+	TemplateDeployV2 deployTemplateTemplateDeployV2TemplateDeployPayload `json:"templateDeployV2"`
+}
+
+// GetTemplateDeployV2 returns deployTemplateResponse.TemplateDeployV2, and is useful for accessing the field via an interface.
+func (v *deployTemplateResponse) GetTemplateDeployV2() deployTemplateTemplateDeployV2TemplateDeployPayload {
+	return v.TemplateDeployV2
+}
+
+// deployTemplateTemplateDeployV2TemplateDeployPayload includes the requested fields of the GraphQL type TemplateDeployPayload.
+type deployTemplateTemplateDeployV2TemplateDeployPayload struct {
+	ProjectId  string  `json:"projectId"`
+	WorkflowId *string `json:"workflowId"`
+}
+
+// GetProjectId returns deployTemplateTemplateDeployV2TemplateDeployPayload.ProjectId, and is useful for accessing the field via an interface.
+func (v *deployTemplateTemplateDeployV2TemplateDeployPayload) GetProjectId() string {
+	return v.ProjectId
+}
+
+// GetWorkflowId returns deployTemplateTemplateDeployV2TemplateDeployPayload.WorkflowId, and is useful for accessing the field via an interface.
+func (v *deployTemplateTemplateDeployV2TemplateDeployPayload) GetWorkflowId() *string {
+	return v.WorkflowId
+}
+
 // disconnectServiceResponse is returned by disconnectService on success.
 type disconnectServiceResponse struct {
 	// Disconnect a service from a repo
@@ -2323,31 +3731,39 @@ type disconnectServiceServiceDisconnectService struct {
 // GetId returns disconnectServiceServiceDisconnectService.Id, and is useful for accessing the field via an interface.
 func (v *disconnectServiceServiceDisconnectService) GetId() string { return v.Id }
 
-// getEnvironmentEnvironment includes the requested fields of the GraphQL type Environment.
-type getEnvironmentEnvironment struct {
-	Environment `json:"-"`
+// getBuildLogsBuildLogsLog includes the requested fields of the GraphQL type Log.
+// The GraphQL type's documentation follows.
+//
+// The result of a logs query.
+type getBuildLogsBuildLogsLog struct {
+	LogEntry `json:"-"`
 }
 
-// GetId returns getEnvironmentEnvironment.Id, and is useful for accessing the field via an interface.
-func (v *getEnvironmentEnvironment) GetId() string { return v.Environment.Id }
+// GetTimestamp returns getBuildLogsBuildLogsLog.Timestamp, and is useful for accessing the field via an interface.
+func (v *getBuildLogsBuildLogsLog) GetTimestamp() string { return v.LogEntry.Timestamp }
 
-// GetName returns getEnvironmentEnvironment.Name, and is useful for accessing the field via an interface.
-func (v *getEnvironmentEnvironment) GetName() string { return v.Environment.Name }
+// GetMessage returns getBuildLogsBuildLogsLog.Message, and is useful for accessing the field via an interface.
+func (v *getBuildLogsBuildLogsLog) GetMessage() string { return v.LogEntry.Message }
 
-// GetProjectId returns getEnvironmentEnvironment.ProjectId, and is useful for accessing the field via an interface.
-func (v *getEnvironmentEnvironment) GetProjectId() string { return v.Environment.ProjectId }
+// GetSeverity returns getBuildLogsBuildLogsLog.Severity, and is useful for accessing the field via an interface.
+func (v *getBuildLogsBuildLogsLog) GetSeverity() *string { return v.LogEntry.Severity }
 
-func (v *getEnvironmentEnvironment) UnmarshalJSON(b []byte) error {
+// GetAttributes returns getBuildLogsBuildLogsLog.Attributes, and is useful for accessing the field via an interface.
+func (v *getBuildLogsBuildLogsLog) GetAttributes() []LogEntryAttributesLogAttribute {
+	return v.LogEntry.Attributes
+}
+
+func (v *getBuildLogsBuildLogsLog) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getEnvironmentEnvironment
+		*getBuildLogsBuildLogsLog
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getEnvironmentEnvironment = v
+	firstPass.getBuildLogsBuildLogsLog = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2355,22 +3771,24 @@ func (v *getEnvironmentEnvironment) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Environment)
+		b, &v.LogEntry)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetEnvironmentEnvironment struct {
-	Id string `json:"id"`
+type __premarshalgetBuildLogsBuildLogsLog struct {
+	Timestamp string `json:"timestamp"`
 
-	Name string `json:"name"`
+	Message string `json:"message"`
 
-	ProjectId string `json:"projectId"`
+	Severity *string `json:"severity"`
+
+	Attributes []LogEntryAttributesLogAttribute `json:"attributes"`
 }
 
-func (v *getEnvironmentEnvironment) MarshalJSON() ([]byte, error) {
+func (v *getBuildLogsBuildLogsLog) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2378,75 +3796,67 @@ func (v *getEnvironmentEnvironment) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getEnvironmentEnvironment) __premarshalJSON() (*__premarshalgetEnvironmentEnvironment, error) {
-	var retval __premarshalgetEnvironmentEnvironment
+func (v *getBuildLogsBuildLogsLog) __premarshalJSON() (*__premarshalgetBuildLogsBuildLogsLog, error) {
+	var retval __premarshalgetBuildLogsBuildLogsLog
 
-	retval.Id = v.Environment.Id
-	retval.Name = v.Environment.Name
-	retval.ProjectId = v.Environment.ProjectId
+	retval.Timestamp = v.LogEntry.Timestamp
+	retval.Message = v.LogEntry.Message
+	retval.Severity = v.LogEntry.Severity
+	retval.Attributes = v.LogEntry.Attributes
 	return &retval, nil
 }
 
-// getEnvironmentResponse is returned by getEnvironment on success.
-type getEnvironmentResponse struct {
-	// Find a single environment
-	Environment getEnvironmentEnvironment `json:"environment"`
+// getBuildLogsResponse is returned by getBuildLogs on success.
+type getBuildLogsResponse struct {
+	// Fetch logs for a build
+	BuildLogs []getBuildLogsBuildLogsLog `json:"buildLogs"`
 }
 
-// GetEnvironment returns getEnvironmentResponse.Environment, and is useful for accessing the field via an interface.
-func (v *getEnvironmentResponse) GetEnvironment() getEnvironmentEnvironment { return v.Environment }
-
-// getEnvironmentsEnvironmentsQueryEnvironmentsConnection includes the requested fields of the GraphQL type QueryEnvironmentsConnection.
-type getEnvironmentsEnvironmentsQueryEnvironmentsConnection struct {
-	Edges []getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge `json:"edges"`
-}
+// GetBuildLogs returns getBuildLogsResponse.BuildLogs, and is useful for accessing the field via an interface.
+func (v *getBuildLogsResponse) GetBuildLogs() []getBuildLogsBuildLogsLog { return v.BuildLogs }
 
-// GetEdges returns getEnvironmentsEnvironmentsQueryEnvironmentsConnection.Edges, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnection) GetEdges() []getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge {
-	return v.Edges
+// getCustomDomainRecordsCustomDomain includes the requested fields of the GraphQL type CustomDomain.
+type getCustomDomainRecordsCustomDomain struct {
+	CustomDomainRecords `json:"-"`
 }
 
-// getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge includes the requested fields of the GraphQL type QueryEnvironmentsConnectionEdge.
-type getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge struct {
-	Node getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment `json:"node"`
-}
+// GetId returns getCustomDomainRecordsCustomDomain.Id, and is useful for accessing the field via an interface.
+func (v *getCustomDomainRecordsCustomDomain) GetId() string { return v.CustomDomainRecords.Id }
 
-// GetNode returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge.Node, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge) GetNode() getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment {
-	return v.Node
-}
+// GetDomain returns getCustomDomainRecordsCustomDomain.Domain, and is useful for accessing the field via an interface.
+func (v *getCustomDomainRecordsCustomDomain) GetDomain() string { return v.CustomDomainRecords.Domain }
 
-// getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment includes the requested fields of the GraphQL type Environment.
-type getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment struct {
-	Environment `json:"-"`
+// GetEnvironmentId returns getCustomDomainRecordsCustomDomain.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getCustomDomainRecordsCustomDomain) GetEnvironmentId() string {
+	return v.CustomDomainRecords.EnvironmentId
 }
 
-// GetId returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.Id, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetId() string {
-	return v.Environment.Id
+// GetServiceId returns getCustomDomainRecordsCustomDomain.ServiceId, and is useful for accessing the field via an interface.
+func (v *getCustomDomainRecordsCustomDomain) GetServiceId() string {
+	return v.CustomDomainRecords.ServiceId
 }
 
-// GetName returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.Name, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetName() string {
-	return v.Environment.Name
+// GetProjectId returns getCustomDomainRecordsCustomDomain.ProjectId, and is useful for accessing the field via an interface.
+func (v *getCustomDomainRecordsCustomDomain) GetProjectId() string {
+	return v.CustomDomainRecords.ProjectId
 }
 
-// GetProjectId returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.ProjectId, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetProjectId() string {
-	return v.Environment.ProjectId
+// GetStatus returns getCustomDomainRecordsCustomDomain.Status, and is useful for accessing the field via an interface.
+func (v *getCustomDomainRecordsCustomDomain) GetStatus() CustomDomainRecordsStatusCustomDomainStatus {
+	return v.CustomDomainRecords.Status
 }
 
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) UnmarshalJSON(b []byte) error {
+func (v *getCustomDomainRecordsCustomDomain) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment
+		*getCustomDomainRecordsCustomDomain
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment = v
+	firstPass.getCustomDomainRecordsCustomDomain = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2454,22 +3864,28 @@ func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnviron
 	}
 
 	err = json.Unmarshal(
-		b, &v.Environment)
+		b, &v.CustomDomainRecords)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment struct {
+type __premarshalgetCustomDomainRecordsCustomDomain struct {
 	Id string `json:"id"`
 
-	Name string `json:"name"`
+	Domain string `json:"domain"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
 
 	ProjectId string `json:"projectId"`
+
+	Status CustomDomainRecordsStatusCustomDomainStatus `json:"status"`
 }
 
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) MarshalJSON() ([]byte, error) {
+func (v *getCustomDomainRecordsCustomDomain) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2477,149 +3893,1001 @@ func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnviron
 	return json.Marshal(premarshaled)
 }
 
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) __premarshalJSON() (*__premarshalgetEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment, error) {
-	var retval __premarshalgetEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment
+func (v *getCustomDomainRecordsCustomDomain) __premarshalJSON() (*__premarshalgetCustomDomainRecordsCustomDomain, error) {
+	var retval __premarshalgetCustomDomainRecordsCustomDomain
 
-	retval.Id = v.Environment.Id
-	retval.Name = v.Environment.Name
-	retval.ProjectId = v.Environment.ProjectId
+	retval.Id = v.CustomDomainRecords.Id
+	retval.Domain = v.CustomDomainRecords.Domain
+	retval.EnvironmentId = v.CustomDomainRecords.EnvironmentId
+	retval.ServiceId = v.CustomDomainRecords.ServiceId
+	retval.ProjectId = v.CustomDomainRecords.ProjectId
+	retval.Status = v.CustomDomainRecords.Status
 	return &retval, nil
 }
 
-// getEnvironmentsResponse is returned by getEnvironments on success.
-type getEnvironmentsResponse struct {
-	// Gets all environments for a project.
-	Environments getEnvironmentsEnvironmentsQueryEnvironmentsConnection `json:"environments"`
+// getCustomDomainRecordsResponse is returned by getCustomDomainRecords on success.
+type getCustomDomainRecordsResponse struct {
+	// Fetch details for a custom domain
+	CustomDomain getCustomDomainRecordsCustomDomain `json:"customDomain"`
 }
 
-// GetEnvironments returns getEnvironmentsResponse.Environments, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsResponse) GetEnvironments() getEnvironmentsEnvironmentsQueryEnvironmentsConnection {
-	return v.Environments
+// GetCustomDomain returns getCustomDomainRecordsResponse.CustomDomain, and is useful for accessing the field via an interface.
+func (v *getCustomDomainRecordsResponse) GetCustomDomain() getCustomDomainRecordsCustomDomain {
+	return v.CustomDomain
 }
 
-// getPrivateNetworkEndpointPrivateNetworkEndpoint includes the requested fields of the GraphQL type PrivateNetworkEndpoint.
-type getPrivateNetworkEndpointPrivateNetworkEndpoint struct {
-	PublicId          *string   `json:"publicId"`
-	DnsName           *string   `json:"dnsName"`
-	PrivateIps        []*string `json:"privateIps"`
-	ServiceInstanceId *string   `json:"serviceInstanceId"`
-	Tags              []*string `json:"tags"`
+// getDeploymentDeployment includes the requested fields of the GraphQL type Deployment.
+type getDeploymentDeployment struct {
+	Id            string                 `json:"id"`
+	Status        DeploymentStatus       `json:"status"`
+	ServiceId     string                 `json:"serviceId"`
+	EnvironmentId string                 `json:"environmentId"`
+	CreatedAt     time.Time              `json:"createdAt"`
+	Url           string                 `json:"url"`
+	Meta          map[string]interface{} `json:"meta"`
+}
+
+// GetId returns getDeploymentDeployment.Id, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetId() string { return v.Id }
+
+// GetStatus returns getDeploymentDeployment.Status, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetStatus() DeploymentStatus { return v.Status }
+
+// GetServiceId returns getDeploymentDeployment.ServiceId, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetServiceId() string { return v.ServiceId }
+
+// GetEnvironmentId returns getDeploymentDeployment.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetCreatedAt returns getDeploymentDeployment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetUrl returns getDeploymentDeployment.Url, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetUrl() string { return v.Url }
+
+// GetMeta returns getDeploymentDeployment.Meta, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetMeta() map[string]interface{} { return v.Meta }
+
+// getDeploymentResponse is returned by getDeployment on success.
+type getDeploymentResponse struct {
+	// Find a single deployment
+	Deployment getDeploymentDeployment `json:"deployment"`
+}
+
+// GetDeployment returns getDeploymentResponse.Deployment, and is useful for accessing the field via an interface.
+func (v *getDeploymentResponse) GetDeployment() getDeploymentDeployment { return v.Deployment }
+
+// getDeploymentRollbackTargetDeployment includes the requested fields of the GraphQL type Deployment.
+type getDeploymentRollbackTargetDeployment struct {
+	Id            string                 `json:"id"`
+	ServiceId     string                 `json:"serviceId"`
+	EnvironmentId string                 `json:"environmentId"`
+	CanRollback   bool                   `json:"canRollback"`
+	Meta          map[string]interface{} `json:"meta"`
+}
+
+// GetId returns getDeploymentRollbackTargetDeployment.Id, and is useful for accessing the field via an interface.
+func (v *getDeploymentRollbackTargetDeployment) GetId() string { return v.Id }
+
+// GetServiceId returns getDeploymentRollbackTargetDeployment.ServiceId, and is useful for accessing the field via an interface.
+func (v *getDeploymentRollbackTargetDeployment) GetServiceId() string { return v.ServiceId }
+
+// GetEnvironmentId returns getDeploymentRollbackTargetDeployment.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getDeploymentRollbackTargetDeployment) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetCanRollback returns getDeploymentRollbackTargetDeployment.CanRollback, and is useful for accessing the field via an interface.
+func (v *getDeploymentRollbackTargetDeployment) GetCanRollback() bool { return v.CanRollback }
+
+// GetMeta returns getDeploymentRollbackTargetDeployment.Meta, and is useful for accessing the field via an interface.
+func (v *getDeploymentRollbackTargetDeployment) GetMeta() map[string]interface{} { return v.Meta }
+
+// getDeploymentRollbackTargetResponse is returned by getDeploymentRollbackTarget on success.
+type getDeploymentRollbackTargetResponse struct {
+	// Find a single deployment
+	Deployment getDeploymentRollbackTargetDeployment `json:"deployment"`
+}
+
+// GetDeployment returns getDeploymentRollbackTargetResponse.Deployment, and is useful for accessing the field via an interface.
+func (v *getDeploymentRollbackTargetResponse) GetDeployment() getDeploymentRollbackTargetDeployment {
+	return v.Deployment
+}
+
+// getDeploymentTriggerNode includes the requested fields of the GraphQL interface Node.
+//
+// getDeploymentTriggerNode is implemented by the following types:
+// getDeploymentTriggerNodeAdoptionInfo
+// getDeploymentTriggerNodeApiToken
+// getDeploymentTriggerNodeBanReasonHistory
+// getDeploymentTriggerNodeContainer
+// getDeploymentTriggerNodeCredit
+// getDeploymentTriggerNodeCustomer
+// getDeploymentTriggerNodeDeployment
+// getDeploymentTriggerNodeDeploymentEvent
+// getDeploymentTriggerNodeDeploymentInstanceExecution
+// getDeploymentTriggerNodeDeploymentSnapshot
+// getDeploymentTriggerNodeDeploymentTrigger
+// getDeploymentTriggerNodeEnvironment
+// getDeploymentTriggerNodeEnvironmentPatch
+// getDeploymentTriggerNodeEvent
+// getDeploymentTriggerNodeIntegration
+// getDeploymentTriggerNodeIntegrationAuth
+// getDeploymentTriggerNodeInviteCode
+// getDeploymentTriggerNodeObservabilityDashboard
+// getDeploymentTriggerNodeObservabilityDashboardAlert
+// getDeploymentTriggerNodeObservabilityDashboardItem
+// getDeploymentTriggerNodeObservabilityDashboardItemInstance
+// getDeploymentTriggerNodeObservabilityDashboardMonitor
+// getDeploymentTriggerNodePasskey
+// getDeploymentTriggerNodePlanLimitOverride
+// getDeploymentTriggerNodePlugin
+// getDeploymentTriggerNodePreferenceOverride
+// getDeploymentTriggerNodePreferences
+// getDeploymentTriggerNodeProject
+// getDeploymentTriggerNodeProjectPermission
+// getDeploymentTriggerNodeProjectToken
+// getDeploymentTriggerNodeProjectWebhook
+// getDeploymentTriggerNodeProviderAuth
+// getDeploymentTriggerNodeReferralInfo
+// getDeploymentTriggerNodeRefundRequest
+// getDeploymentTriggerNodeReissuedInvoice
+// getDeploymentTriggerNodeService
+// getDeploymentTriggerNodeServiceInstance
+// getDeploymentTriggerNodeSession
+// getDeploymentTriggerNodeTeam
+// getDeploymentTriggerNodeTeamPermission
+// getDeploymentTriggerNodeTemplate
+// getDeploymentTriggerNodeTemplateService
+// getDeploymentTriggerNodeUsageAnomaly
+// getDeploymentTriggerNodeUsageLimit
+// getDeploymentTriggerNodeUser
+// getDeploymentTriggerNodeUserGithubRepo
+// getDeploymentTriggerNodeVariable
+// getDeploymentTriggerNodeVolume
+// getDeploymentTriggerNodeVolumeInstance
+// getDeploymentTriggerNodeVolumeInstanceBackupSchedule
+// getDeploymentTriggerNodeWithdrawal
+// getDeploymentTriggerNodeWithdrawalAccount
+// getDeploymentTriggerNodeWorkspace
+type getDeploymentTriggerNode interface {
+	implementsGraphQLInterfacegetDeploymentTriggerNode()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getDeploymentTriggerNodeAdoptionInfo) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeApiToken) implementsGraphQLInterfacegetDeploymentTriggerNode()     {}
+func (v *getDeploymentTriggerNodeBanReasonHistory) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeContainer) implementsGraphQLInterfacegetDeploymentTriggerNode()  {}
+func (v *getDeploymentTriggerNodeCredit) implementsGraphQLInterfacegetDeploymentTriggerNode()     {}
+func (v *getDeploymentTriggerNodeCustomer) implementsGraphQLInterfacegetDeploymentTriggerNode()   {}
+func (v *getDeploymentTriggerNodeDeployment) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeDeploymentEvent) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeDeploymentInstanceExecution) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeDeploymentSnapshot) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeDeploymentTrigger) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeEnvironment) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeEnvironmentPatch) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeEvent) implementsGraphQLInterfacegetDeploymentTriggerNode()       {}
+func (v *getDeploymentTriggerNodeIntegration) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeIntegrationAuth) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeInviteCode) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeObservabilityDashboard) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeObservabilityDashboardAlert) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeObservabilityDashboardItem) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeObservabilityDashboardItemInstance) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeObservabilityDashboardMonitor) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodePasskey) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodePlanLimitOverride) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodePlugin) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodePreferenceOverride) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodePreferences) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeProject) implementsGraphQLInterfacegetDeploymentTriggerNode()     {}
+func (v *getDeploymentTriggerNodeProjectPermission) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeProjectToken) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeProjectWebhook) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeProviderAuth) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeReferralInfo) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeRefundRequest) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeReissuedInvoice) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeService) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeServiceInstance) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeSession) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeTeam) implementsGraphQLInterfacegetDeploymentTriggerNode()    {}
+func (v *getDeploymentTriggerNodeTeamPermission) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeTemplate) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeTemplateService) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeUsageAnomaly) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeUsageLimit) implementsGraphQLInterfacegetDeploymentTriggerNode()   {}
+func (v *getDeploymentTriggerNodeUser) implementsGraphQLInterfacegetDeploymentTriggerNode()         {}
+func (v *getDeploymentTriggerNodeUserGithubRepo) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeVariable) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeVolume) implementsGraphQLInterfacegetDeploymentTriggerNode()   {}
+func (v *getDeploymentTriggerNodeVolumeInstance) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeVolumeInstanceBackupSchedule) implementsGraphQLInterfacegetDeploymentTriggerNode() {
+}
+func (v *getDeploymentTriggerNodeWithdrawal) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
+func (v *getDeploymentTriggerNodeWithdrawalAccount) implementsGraphQLInterfacegetDeploymentTriggerNode() {
 }
+func (v *getDeploymentTriggerNodeWorkspace) implementsGraphQLInterfacegetDeploymentTriggerNode() {}
 
-// GetPublicId returns getPrivateNetworkEndpointPrivateNetworkEndpoint.PublicId, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetPublicId() *string { return v.PublicId }
+func __unmarshalgetDeploymentTriggerNode(b []byte, v *getDeploymentTriggerNode) error {
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetDnsName returns getPrivateNetworkEndpointPrivateNetworkEndpoint.DnsName, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetDnsName() *string { return v.DnsName }
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
 
-// GetPrivateIps returns getPrivateNetworkEndpointPrivateNetworkEndpoint.PrivateIps, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetPrivateIps() []*string {
-	return v.PrivateIps
+	switch tn.TypeName {
+	case "AdoptionInfo":
+		*v = new(getDeploymentTriggerNodeAdoptionInfo)
+		return json.Unmarshal(b, *v)
+	case "ApiToken":
+		*v = new(getDeploymentTriggerNodeApiToken)
+		return json.Unmarshal(b, *v)
+	case "BanReasonHistory":
+		*v = new(getDeploymentTriggerNodeBanReasonHistory)
+		return json.Unmarshal(b, *v)
+	case "Container":
+		*v = new(getDeploymentTriggerNodeContainer)
+		return json.Unmarshal(b, *v)
+	case "Credit":
+		*v = new(getDeploymentTriggerNodeCredit)
+		return json.Unmarshal(b, *v)
+	case "Customer":
+		*v = new(getDeploymentTriggerNodeCustomer)
+		return json.Unmarshal(b, *v)
+	case "Deployment":
+		*v = new(getDeploymentTriggerNodeDeployment)
+		return json.Unmarshal(b, *v)
+	case "DeploymentEvent":
+		*v = new(getDeploymentTriggerNodeDeploymentEvent)
+		return json.Unmarshal(b, *v)
+	case "DeploymentInstanceExecution":
+		*v = new(getDeploymentTriggerNodeDeploymentInstanceExecution)
+		return json.Unmarshal(b, *v)
+	case "DeploymentSnapshot":
+		*v = new(getDeploymentTriggerNodeDeploymentSnapshot)
+		return json.Unmarshal(b, *v)
+	case "DeploymentTrigger":
+		*v = new(getDeploymentTriggerNodeDeploymentTrigger)
+		return json.Unmarshal(b, *v)
+	case "Environment":
+		*v = new(getDeploymentTriggerNodeEnvironment)
+		return json.Unmarshal(b, *v)
+	case "EnvironmentPatch":
+		*v = new(getDeploymentTriggerNodeEnvironmentPatch)
+		return json.Unmarshal(b, *v)
+	case "Event":
+		*v = new(getDeploymentTriggerNodeEvent)
+		return json.Unmarshal(b, *v)
+	case "Integration":
+		*v = new(getDeploymentTriggerNodeIntegration)
+		return json.Unmarshal(b, *v)
+	case "IntegrationAuth":
+		*v = new(getDeploymentTriggerNodeIntegrationAuth)
+		return json.Unmarshal(b, *v)
+	case "InviteCode":
+		*v = new(getDeploymentTriggerNodeInviteCode)
+		return json.Unmarshal(b, *v)
+	case "ObservabilityDashboard":
+		*v = new(getDeploymentTriggerNodeObservabilityDashboard)
+		return json.Unmarshal(b, *v)
+	case "ObservabilityDashboardAlert":
+		*v = new(getDeploymentTriggerNodeObservabilityDashboardAlert)
+		return json.Unmarshal(b, *v)
+	case "ObservabilityDashboardItem":
+		*v = new(getDeploymentTriggerNodeObservabilityDashboardItem)
+		return json.Unmarshal(b, *v)
+	case "ObservabilityDashboardItemInstance":
+		*v = new(getDeploymentTriggerNodeObservabilityDashboardItemInstance)
+		return json.Unmarshal(b, *v)
+	case "ObservabilityDashboardMonitor":
+		*v = new(getDeploymentTriggerNodeObservabilityDashboardMonitor)
+		return json.Unmarshal(b, *v)
+	case "Passkey":
+		*v = new(getDeploymentTriggerNodePasskey)
+		return json.Unmarshal(b, *v)
+	case "PlanLimitOverride":
+		*v = new(getDeploymentTriggerNodePlanLimitOverride)
+		return json.Unmarshal(b, *v)
+	case "Plugin":
+		*v = new(getDeploymentTriggerNodePlugin)
+		return json.Unmarshal(b, *v)
+	case "PreferenceOverride":
+		*v = new(getDeploymentTriggerNodePreferenceOverride)
+		return json.Unmarshal(b, *v)
+	case "Preferences":
+		*v = new(getDeploymentTriggerNodePreferences)
+		return json.Unmarshal(b, *v)
+	case "Project":
+		*v = new(getDeploymentTriggerNodeProject)
+		return json.Unmarshal(b, *v)
+	case "ProjectPermission":
+		*v = new(getDeploymentTriggerNodeProjectPermission)
+		return json.Unmarshal(b, *v)
+	case "ProjectToken":
+		*v = new(getDeploymentTriggerNodeProjectToken)
+		return json.Unmarshal(b, *v)
+	case "ProjectWebhook":
+		*v = new(getDeploymentTriggerNodeProjectWebhook)
+		return json.Unmarshal(b, *v)
+	case "ProviderAuth":
+		*v = new(getDeploymentTriggerNodeProviderAuth)
+		return json.Unmarshal(b, *v)
+	case "ReferralInfo":
+		*v = new(getDeploymentTriggerNodeReferralInfo)
+		return json.Unmarshal(b, *v)
+	case "RefundRequest":
+		*v = new(getDeploymentTriggerNodeRefundRequest)
+		return json.Unmarshal(b, *v)
+	case "ReissuedInvoice":
+		*v = new(getDeploymentTriggerNodeReissuedInvoice)
+		return json.Unmarshal(b, *v)
+	case "Service":
+		*v = new(getDeploymentTriggerNodeService)
+		return json.Unmarshal(b, *v)
+	case "ServiceInstance":
+		*v = new(getDeploymentTriggerNodeServiceInstance)
+		return json.Unmarshal(b, *v)
+	case "Session":
+		*v = new(getDeploymentTriggerNodeSession)
+		return json.Unmarshal(b, *v)
+	case "Team":
+		*v = new(getDeploymentTriggerNodeTeam)
+		return json.Unmarshal(b, *v)
+	case "TeamPermission":
+		*v = new(getDeploymentTriggerNodeTeamPermission)
+		return json.Unmarshal(b, *v)
+	case "Template":
+		*v = new(getDeploymentTriggerNodeTemplate)
+		return json.Unmarshal(b, *v)
+	case "TemplateService":
+		*v = new(getDeploymentTriggerNodeTemplateService)
+		return json.Unmarshal(b, *v)
+	case "UsageAnomaly":
+		*v = new(getDeploymentTriggerNodeUsageAnomaly)
+		return json.Unmarshal(b, *v)
+	case "UsageLimit":
+		*v = new(getDeploymentTriggerNodeUsageLimit)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(getDeploymentTriggerNodeUser)
+		return json.Unmarshal(b, *v)
+	case "UserGithubRepo":
+		*v = new(getDeploymentTriggerNodeUserGithubRepo)
+		return json.Unmarshal(b, *v)
+	case "Variable":
+		*v = new(getDeploymentTriggerNodeVariable)
+		return json.Unmarshal(b, *v)
+	case "Volume":
+		*v = new(getDeploymentTriggerNodeVolume)
+		return json.Unmarshal(b, *v)
+	case "VolumeInstance":
+		*v = new(getDeploymentTriggerNodeVolumeInstance)
+		return json.Unmarshal(b, *v)
+	case "VolumeInstanceBackupSchedule":
+		*v = new(getDeploymentTriggerNodeVolumeInstanceBackupSchedule)
+		return json.Unmarshal(b, *v)
+	case "Withdrawal":
+		*v = new(getDeploymentTriggerNodeWithdrawal)
+		return json.Unmarshal(b, *v)
+	case "WithdrawalAccount":
+		*v = new(getDeploymentTriggerNodeWithdrawalAccount)
+		return json.Unmarshal(b, *v)
+	case "Workspace":
+		*v = new(getDeploymentTriggerNodeWorkspace)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Node.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getDeploymentTriggerNode: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetDeploymentTriggerNode(v *getDeploymentTriggerNode) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getDeploymentTriggerNodeAdoptionInfo:
+		typename = "AdoptionInfo"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeAdoptionInfo
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeApiToken:
+		typename = "ApiToken"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeApiToken
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeBanReasonHistory:
+		typename = "BanReasonHistory"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeBanReasonHistory
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeContainer:
+		typename = "Container"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeContainer
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeCredit:
+		typename = "Credit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeCredit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeCustomer:
+		typename = "Customer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeCustomer
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeDeployment:
+		typename = "Deployment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeDeployment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeDeploymentEvent:
+		typename = "DeploymentEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeDeploymentEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeDeploymentInstanceExecution:
+		typename = "DeploymentInstanceExecution"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeDeploymentInstanceExecution
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeDeploymentSnapshot:
+		typename = "DeploymentSnapshot"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeDeploymentSnapshot
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeDeploymentTrigger:
+		typename = "DeploymentTrigger"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetDeploymentTriggerNodeDeploymentTrigger
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeEnvironment:
+		typename = "Environment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeEnvironment
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeEnvironmentPatch:
+		typename = "EnvironmentPatch"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeEnvironmentPatch
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeEvent:
+		typename = "Event"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeIntegration:
+		typename = "Integration"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeIntegration
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeIntegrationAuth:
+		typename = "IntegrationAuth"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeIntegrationAuth
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeInviteCode:
+		typename = "InviteCode"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeInviteCode
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeObservabilityDashboard:
+		typename = "ObservabilityDashboard"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeObservabilityDashboard
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeObservabilityDashboardAlert:
+		typename = "ObservabilityDashboardAlert"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeObservabilityDashboardAlert
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeObservabilityDashboardItem:
+		typename = "ObservabilityDashboardItem"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeObservabilityDashboardItem
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeObservabilityDashboardItemInstance:
+		typename = "ObservabilityDashboardItemInstance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeObservabilityDashboardItemInstance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeObservabilityDashboardMonitor:
+		typename = "ObservabilityDashboardMonitor"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeObservabilityDashboardMonitor
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodePasskey:
+		typename = "Passkey"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodePasskey
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodePlanLimitOverride:
+		typename = "PlanLimitOverride"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodePlanLimitOverride
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodePlugin:
+		typename = "Plugin"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodePlugin
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodePreferenceOverride:
+		typename = "PreferenceOverride"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodePreferenceOverride
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodePreferences:
+		typename = "Preferences"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodePreferences
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeProject:
+		typename = "Project"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeProject
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeProjectPermission:
+		typename = "ProjectPermission"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeProjectPermission
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeProjectToken:
+		typename = "ProjectToken"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeProjectToken
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeProjectWebhook:
+		typename = "ProjectWebhook"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeProjectWebhook
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeProviderAuth:
+		typename = "ProviderAuth"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeProviderAuth
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeReferralInfo:
+		typename = "ReferralInfo"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeReferralInfo
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeRefundRequest:
+		typename = "RefundRequest"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeRefundRequest
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeReissuedInvoice:
+		typename = "ReissuedInvoice"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeReissuedInvoice
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeService:
+		typename = "Service"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeService
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeServiceInstance:
+		typename = "ServiceInstance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeServiceInstance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeSession:
+		typename = "Session"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeSession
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeTeam:
+		typename = "Team"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeTeam
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeTeamPermission:
+		typename = "TeamPermission"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeTeamPermission
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeTemplate:
+		typename = "Template"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeTemplate
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeTemplateService:
+		typename = "TemplateService"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeTemplateService
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeUsageAnomaly:
+		typename = "UsageAnomaly"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeUsageAnomaly
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeUsageLimit:
+		typename = "UsageLimit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeUsageLimit
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeUserGithubRepo:
+		typename = "UserGithubRepo"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeUserGithubRepo
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeVariable:
+		typename = "Variable"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeVariable
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeVolume:
+		typename = "Volume"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeVolume
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeVolumeInstance:
+		typename = "VolumeInstance"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeVolumeInstance
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeVolumeInstanceBackupSchedule:
+		typename = "VolumeInstanceBackupSchedule"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeVolumeInstanceBackupSchedule
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeWithdrawal:
+		typename = "Withdrawal"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeWithdrawal
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeWithdrawalAccount:
+		typename = "WithdrawalAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeWithdrawalAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *getDeploymentTriggerNodeWorkspace:
+		typename = "Workspace"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getDeploymentTriggerNodeWorkspace
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getDeploymentTriggerNode: "%T"`, v)
+	}
 }
 
-// GetServiceInstanceId returns getPrivateNetworkEndpointPrivateNetworkEndpoint.ServiceInstanceId, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetServiceInstanceId() *string {
-	return v.ServiceInstanceId
+// getDeploymentTriggerNodeAdoptionInfo includes the requested fields of the GraphQL type AdoptionInfo.
+type getDeploymentTriggerNodeAdoptionInfo struct {
+	Typename string `json:"__typename"`
 }
 
-// GetTags returns getPrivateNetworkEndpointPrivateNetworkEndpoint.Tags, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetTags() []*string { return v.Tags }
+// GetTypename returns getDeploymentTriggerNodeAdoptionInfo.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeAdoptionInfo) GetTypename() string { return v.Typename }
 
-// getPrivateNetworkEndpointResponse is returned by getPrivateNetworkEndpoint on success.
-type getPrivateNetworkEndpointResponse struct {
-	// Get a private network endpoint for a service instance.
-	PrivateNetworkEndpoint *getPrivateNetworkEndpointPrivateNetworkEndpoint `json:"privateNetworkEndpoint"`
+// getDeploymentTriggerNodeApiToken includes the requested fields of the GraphQL type ApiToken.
+type getDeploymentTriggerNodeApiToken struct {
+	Typename string `json:"__typename"`
 }
 
-// GetPrivateNetworkEndpoint returns getPrivateNetworkEndpointResponse.PrivateNetworkEndpoint, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworkEndpointResponse) GetPrivateNetworkEndpoint() *getPrivateNetworkEndpointPrivateNetworkEndpoint {
-	return v.PrivateNetworkEndpoint
+// GetTypename returns getDeploymentTriggerNodeApiToken.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeApiToken) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeBanReasonHistory includes the requested fields of the GraphQL type BanReasonHistory.
+type getDeploymentTriggerNodeBanReasonHistory struct {
+	Typename string `json:"__typename"`
 }
 
-// getPrivateNetworksPrivateNetworksPrivateNetwork includes the requested fields of the GraphQL type PrivateNetwork.
-type getPrivateNetworksPrivateNetworksPrivateNetwork struct {
-	PublicId      string   `json:"publicId"`
-	Name          string   `json:"name"`
-	DnsName       string   `json:"dnsName"`
-	NetworkId     int64    `json:"networkId"`
-	EnvironmentId string   `json:"environmentId"`
-	ProjectId     string   `json:"projectId"`
-	Tags          []string `json:"tags"`
+// GetTypename returns getDeploymentTriggerNodeBanReasonHistory.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeBanReasonHistory) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeContainer includes the requested fields of the GraphQL type Container.
+type getDeploymentTriggerNodeContainer struct {
+	Typename string `json:"__typename"`
 }
 
-// GetPublicId returns getPrivateNetworksPrivateNetworksPrivateNetwork.PublicId, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetPublicId() string { return v.PublicId }
+// GetTypename returns getDeploymentTriggerNodeContainer.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeContainer) GetTypename() string { return v.Typename }
 
-// GetName returns getPrivateNetworksPrivateNetworksPrivateNetwork.Name, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetName() string { return v.Name }
+// getDeploymentTriggerNodeCredit includes the requested fields of the GraphQL type Credit.
+type getDeploymentTriggerNodeCredit struct {
+	Typename string `json:"__typename"`
+}
 
-// GetDnsName returns getPrivateNetworksPrivateNetworksPrivateNetwork.DnsName, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetDnsName() string { return v.DnsName }
+// GetTypename returns getDeploymentTriggerNodeCredit.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeCredit) GetTypename() string { return v.Typename }
 
-// GetNetworkId returns getPrivateNetworksPrivateNetworksPrivateNetwork.NetworkId, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetNetworkId() int64 { return v.NetworkId }
+// getDeploymentTriggerNodeCustomer includes the requested fields of the GraphQL type Customer.
+type getDeploymentTriggerNodeCustomer struct {
+	Typename string `json:"__typename"`
+}
 
-// GetEnvironmentId returns getPrivateNetworksPrivateNetworksPrivateNetwork.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetEnvironmentId() string {
-	return v.EnvironmentId
+// GetTypename returns getDeploymentTriggerNodeCustomer.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeCustomer) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeDeployment includes the requested fields of the GraphQL type Deployment.
+type getDeploymentTriggerNodeDeployment struct {
+	Typename string `json:"__typename"`
 }
 
-// GetProjectId returns getPrivateNetworksPrivateNetworksPrivateNetwork.ProjectId, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetProjectId() string { return v.ProjectId }
+// GetTypename returns getDeploymentTriggerNodeDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeployment) GetTypename() string { return v.Typename }
 
-// GetTags returns getPrivateNetworksPrivateNetworksPrivateNetwork.Tags, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetTags() []string { return v.Tags }
+// getDeploymentTriggerNodeDeploymentEvent includes the requested fields of the GraphQL type DeploymentEvent.
+type getDeploymentTriggerNodeDeploymentEvent struct {
+	Typename string `json:"__typename"`
+}
 
-// getPrivateNetworksResponse is returned by getPrivateNetworks on success.
-type getPrivateNetworksResponse struct {
-	// List private networks for an environment.
-	PrivateNetworks []getPrivateNetworksPrivateNetworksPrivateNetwork `json:"privateNetworks"`
+// GetTypename returns getDeploymentTriggerNodeDeploymentEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeploymentEvent) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeDeploymentInstanceExecution includes the requested fields of the GraphQL type DeploymentInstanceExecution.
+type getDeploymentTriggerNodeDeploymentInstanceExecution struct {
+	Typename string `json:"__typename"`
 }
 
-// GetPrivateNetworks returns getPrivateNetworksResponse.PrivateNetworks, and is useful for accessing the field via an interface.
-func (v *getPrivateNetworksResponse) GetPrivateNetworks() []getPrivateNetworksPrivateNetworksPrivateNetwork {
-	return v.PrivateNetworks
+// GetTypename returns getDeploymentTriggerNodeDeploymentInstanceExecution.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeploymentInstanceExecution) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeDeploymentSnapshot includes the requested fields of the GraphQL type DeploymentSnapshot.
+type getDeploymentTriggerNodeDeploymentSnapshot struct {
+	Typename string `json:"__typename"`
 }
 
-// getProjectProject includes the requested fields of the GraphQL type Project.
-type getProjectProject struct {
-	Project `json:"-"`
+// GetTypename returns getDeploymentTriggerNodeDeploymentSnapshot.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeploymentSnapshot) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeDeploymentTrigger includes the requested fields of the GraphQL type DeploymentTrigger.
+type getDeploymentTriggerNodeDeploymentTrigger struct {
+	Typename          string `json:"__typename"`
+	DeploymentTrigger `json:"-"`
 }
 
-// GetId returns getProjectProject.Id, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetId() string { return v.Project.Id }
+// GetTypename returns getDeploymentTriggerNodeDeploymentTrigger.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeploymentTrigger) GetTypename() string { return v.Typename }
 
-// GetName returns getProjectProject.Name, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetName() string { return v.Project.Name }
+// GetId returns getDeploymentTriggerNodeDeploymentTrigger.Id, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeploymentTrigger) GetId() string { return v.DeploymentTrigger.Id }
 
-// GetDescription returns getProjectProject.Description, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetDescription() string { return v.Project.Description }
+// GetProjectId returns getDeploymentTriggerNodeDeploymentTrigger.ProjectId, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeploymentTrigger) GetProjectId() string {
+	return v.DeploymentTrigger.ProjectId
+}
 
-// GetIsPublic returns getProjectProject.IsPublic, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetIsPublic() bool { return v.Project.IsPublic }
+// GetEnvironmentId returns getDeploymentTriggerNodeDeploymentTrigger.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeploymentTrigger) GetEnvironmentId() string {
+	return v.DeploymentTrigger.EnvironmentId
+}
 
-// GetPrDeploys returns getProjectProject.PrDeploys, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetPrDeploys() bool { return v.Project.PrDeploys }
+// GetServiceId returns getDeploymentTriggerNodeDeploymentTrigger.ServiceId, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeploymentTrigger) GetServiceId() *string {
+	return v.DeploymentTrigger.ServiceId
+}
 
-// GetWorkspace returns getProjectProject.Workspace, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetWorkspace() *ProjectWorkspace { return v.Project.Workspace }
+// GetRepository returns getDeploymentTriggerNodeDeploymentTrigger.Repository, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeploymentTrigger) GetRepository() string {
+	return v.DeploymentTrigger.Repository
+}
 
-// GetEnvironments returns getProjectProject.Environments, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetEnvironments() ProjectEnvironmentsProjectEnvironmentsConnection {
-	return v.Project.Environments
+// GetBranch returns getDeploymentTriggerNodeDeploymentTrigger.Branch, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeploymentTrigger) GetBranch() string {
+	return v.DeploymentTrigger.Branch
 }
 
-func (v *getProjectProject) UnmarshalJSON(b []byte) error {
+// GetCheckSuites returns getDeploymentTriggerNodeDeploymentTrigger.CheckSuites, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeDeploymentTrigger) GetCheckSuites() bool {
+	return v.DeploymentTrigger.CheckSuites
+}
+
+func (v *getDeploymentTriggerNodeDeploymentTrigger) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getProjectProject
+		*getDeploymentTriggerNodeDeploymentTrigger
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getProjectProject = v
+	firstPass.getDeploymentTriggerNodeDeploymentTrigger = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2627,30 +4895,32 @@ func (v *getProjectProject) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Project)
+		b, &v.DeploymentTrigger)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetProjectProject struct {
+type __premarshalgetDeploymentTriggerNodeDeploymentTrigger struct {
+	Typename string `json:"__typename"`
+
 	Id string `json:"id"`
 
-	Name string `json:"name"`
+	ProjectId string `json:"projectId"`
 
-	Description string `json:"description"`
+	EnvironmentId string `json:"environmentId"`
 
-	IsPublic bool `json:"isPublic"`
+	ServiceId *string `json:"serviceId"`
 
-	PrDeploys bool `json:"prDeploys"`
+	Repository string `json:"repository"`
 
-	Workspace *ProjectWorkspace `json:"workspace"`
+	Branch string `json:"branch"`
 
-	Environments ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
+	CheckSuites bool `json:"checkSuites"`
 }
 
-func (v *getProjectProject) MarshalJSON() ([]byte, error) {
+func (v *getDeploymentTriggerNodeDeploymentTrigger) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2658,306 +4928,408 @@ func (v *getProjectProject) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getProjectProject) __premarshalJSON() (*__premarshalgetProjectProject, error) {
-	var retval __premarshalgetProjectProject
+func (v *getDeploymentTriggerNodeDeploymentTrigger) __premarshalJSON() (*__premarshalgetDeploymentTriggerNodeDeploymentTrigger, error) {
+	var retval __premarshalgetDeploymentTriggerNodeDeploymentTrigger
 
-	retval.Id = v.Project.Id
-	retval.Name = v.Project.Name
-	retval.Description = v.Project.Description
-	retval.IsPublic = v.Project.IsPublic
-	retval.PrDeploys = v.Project.PrDeploys
-	retval.Workspace = v.Project.Workspace
-	retval.Environments = v.Project.Environments
+	retval.Typename = v.Typename
+	retval.Id = v.DeploymentTrigger.Id
+	retval.ProjectId = v.DeploymentTrigger.ProjectId
+	retval.EnvironmentId = v.DeploymentTrigger.EnvironmentId
+	retval.ServiceId = v.DeploymentTrigger.ServiceId
+	retval.Repository = v.DeploymentTrigger.Repository
+	retval.Branch = v.DeploymentTrigger.Branch
+	retval.CheckSuites = v.DeploymentTrigger.CheckSuites
 	return &retval, nil
 }
 
-// getProjectResponse is returned by getProject on success.
-type getProjectResponse struct {
-	// Get a project by ID
-	Project getProjectProject `json:"project"`
+// getDeploymentTriggerNodeEnvironment includes the requested fields of the GraphQL type Environment.
+type getDeploymentTriggerNodeEnvironment struct {
+	Typename string `json:"__typename"`
 }
 
-// GetProject returns getProjectResponse.Project, and is useful for accessing the field via an interface.
-func (v *getProjectResponse) GetProject() getProjectProject { return v.Project }
+// GetTypename returns getDeploymentTriggerNodeEnvironment.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeEnvironment) GetTypename() string { return v.Typename }
 
-// getServiceInstanceForResourceResponse is returned by getServiceInstanceForResource on success.
-type getServiceInstanceForResourceResponse struct {
-	// Get a service instance belonging to a service and environment
-	ServiceInstance getServiceInstanceForResourceServiceInstance `json:"serviceInstance"`
+// getDeploymentTriggerNodeEnvironmentPatch includes the requested fields of the GraphQL type EnvironmentPatch.
+type getDeploymentTriggerNodeEnvironmentPatch struct {
+	Typename string `json:"__typename"`
 }
 
-// GetServiceInstance returns getServiceInstanceForResourceResponse.ServiceInstance, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceResponse) GetServiceInstance() getServiceInstanceForResourceServiceInstance {
-	return v.ServiceInstance
-}
+// GetTypename returns getDeploymentTriggerNodeEnvironmentPatch.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeEnvironmentPatch) GetTypename() string { return v.Typename }
 
-// getServiceInstanceForResourceServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
-type getServiceInstanceForResourceServiceInstance struct {
-	Id                      string                                                           `json:"id"`
-	EnvironmentId           string                                                           `json:"environmentId"`
-	ServiceId               string                                                           `json:"serviceId"`
-	Source                  *getServiceInstanceForResourceServiceInstanceSourceServiceSource `json:"source"`
-	Builder                 Builder                                                          `json:"builder"`
-	BuildCommand            *string                                                          `json:"buildCommand"`
-	StartCommand            *string                                                          `json:"startCommand"`
-	PreDeployCommand        map[string]interface{}                                           `json:"preDeployCommand"`
-	HealthcheckPath         *string                                                          `json:"healthcheckPath"`
-	HealthcheckTimeout      *int                                                             `json:"healthcheckTimeout"`
-	RestartPolicyType       RestartPolicyType                                                `json:"restartPolicyType"`
-	RestartPolicyMaxRetries int                                                              `json:"restartPolicyMaxRetries"`
-	SleepApplication        *bool                                                            `json:"sleepApplication"`
+// getDeploymentTriggerNodeEvent includes the requested fields of the GraphQL type Event.
+type getDeploymentTriggerNodeEvent struct {
+	Typename string `json:"__typename"`
 }
 
-// GetId returns getServiceInstanceForResourceServiceInstance.Id, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetId() string { return v.Id }
+// GetTypename returns getDeploymentTriggerNodeEvent.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeEvent) GetTypename() string { return v.Typename }
 
-// GetEnvironmentId returns getServiceInstanceForResourceServiceInstance.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetEnvironmentId() string {
-	return v.EnvironmentId
+// getDeploymentTriggerNodeIntegration includes the requested fields of the GraphQL type Integration.
+type getDeploymentTriggerNodeIntegration struct {
+	Typename string `json:"__typename"`
 }
 
-// GetServiceId returns getServiceInstanceForResourceServiceInstance.ServiceId, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetServiceId() string { return v.ServiceId }
+// GetTypename returns getDeploymentTriggerNodeIntegration.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeIntegration) GetTypename() string { return v.Typename }
 
-// GetSource returns getServiceInstanceForResourceServiceInstance.Source, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetSource() *getServiceInstanceForResourceServiceInstanceSourceServiceSource {
-	return v.Source
+// getDeploymentTriggerNodeIntegrationAuth includes the requested fields of the GraphQL type IntegrationAuth.
+type getDeploymentTriggerNodeIntegrationAuth struct {
+	Typename string `json:"__typename"`
 }
 
-// GetBuilder returns getServiceInstanceForResourceServiceInstance.Builder, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetBuilder() Builder { return v.Builder }
+// GetTypename returns getDeploymentTriggerNodeIntegrationAuth.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeIntegrationAuth) GetTypename() string { return v.Typename }
 
-// GetBuildCommand returns getServiceInstanceForResourceServiceInstance.BuildCommand, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetBuildCommand() *string {
-	return v.BuildCommand
+// getDeploymentTriggerNodeInviteCode includes the requested fields of the GraphQL type InviteCode.
+type getDeploymentTriggerNodeInviteCode struct {
+	Typename string `json:"__typename"`
 }
 
-// GetStartCommand returns getServiceInstanceForResourceServiceInstance.StartCommand, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetStartCommand() *string {
-	return v.StartCommand
+// GetTypename returns getDeploymentTriggerNodeInviteCode.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeInviteCode) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeObservabilityDashboard includes the requested fields of the GraphQL type ObservabilityDashboard.
+type getDeploymentTriggerNodeObservabilityDashboard struct {
+	Typename string `json:"__typename"`
 }
 
-// GetPreDeployCommand returns getServiceInstanceForResourceServiceInstance.PreDeployCommand, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetPreDeployCommand() map[string]interface{} {
-	return v.PreDeployCommand
+// GetTypename returns getDeploymentTriggerNodeObservabilityDashboard.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeObservabilityDashboard) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeObservabilityDashboardAlert includes the requested fields of the GraphQL type ObservabilityDashboardAlert.
+type getDeploymentTriggerNodeObservabilityDashboardAlert struct {
+	Typename string `json:"__typename"`
 }
 
-// GetHealthcheckPath returns getServiceInstanceForResourceServiceInstance.HealthcheckPath, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetHealthcheckPath() *string {
-	return v.HealthcheckPath
+// GetTypename returns getDeploymentTriggerNodeObservabilityDashboardAlert.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeObservabilityDashboardAlert) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeObservabilityDashboardItem includes the requested fields of the GraphQL type ObservabilityDashboardItem.
+type getDeploymentTriggerNodeObservabilityDashboardItem struct {
+	Typename string `json:"__typename"`
 }
 
-// GetHealthcheckTimeout returns getServiceInstanceForResourceServiceInstance.HealthcheckTimeout, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetHealthcheckTimeout() *int {
-	return v.HealthcheckTimeout
+// GetTypename returns getDeploymentTriggerNodeObservabilityDashboardItem.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeObservabilityDashboardItem) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeObservabilityDashboardItemInstance includes the requested fields of the GraphQL type ObservabilityDashboardItemInstance.
+type getDeploymentTriggerNodeObservabilityDashboardItemInstance struct {
+	Typename string `json:"__typename"`
 }
 
-// GetRestartPolicyType returns getServiceInstanceForResourceServiceInstance.RestartPolicyType, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetRestartPolicyType() RestartPolicyType {
-	return v.RestartPolicyType
+// GetTypename returns getDeploymentTriggerNodeObservabilityDashboardItemInstance.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeObservabilityDashboardItemInstance) GetTypename() string {
+	return v.Typename
 }
 
-// GetRestartPolicyMaxRetries returns getServiceInstanceForResourceServiceInstance.RestartPolicyMaxRetries, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetRestartPolicyMaxRetries() int {
-	return v.RestartPolicyMaxRetries
+// getDeploymentTriggerNodeObservabilityDashboardMonitor includes the requested fields of the GraphQL type ObservabilityDashboardMonitor.
+type getDeploymentTriggerNodeObservabilityDashboardMonitor struct {
+	Typename string `json:"__typename"`
 }
 
-// GetSleepApplication returns getServiceInstanceForResourceServiceInstance.SleepApplication, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstance) GetSleepApplication() *bool {
-	return v.SleepApplication
+// GetTypename returns getDeploymentTriggerNodeObservabilityDashboardMonitor.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeObservabilityDashboardMonitor) GetTypename() string {
+	return v.Typename
 }
 
-// getServiceInstanceForResourceServiceInstanceSourceServiceSource includes the requested fields of the GraphQL type ServiceSource.
-type getServiceInstanceForResourceServiceInstanceSourceServiceSource struct {
-	Image *string `json:"image"`
-	Repo  *string `json:"repo"`
+// getDeploymentTriggerNodePasskey includes the requested fields of the GraphQL type Passkey.
+type getDeploymentTriggerNodePasskey struct {
+	Typename string `json:"__typename"`
 }
 
-// GetImage returns getServiceInstanceForResourceServiceInstanceSourceServiceSource.Image, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstanceSourceServiceSource) GetImage() *string {
-	return v.Image
+// GetTypename returns getDeploymentTriggerNodePasskey.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodePasskey) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodePlanLimitOverride includes the requested fields of the GraphQL type PlanLimitOverride.
+type getDeploymentTriggerNodePlanLimitOverride struct {
+	Typename string `json:"__typename"`
 }
 
-// GetRepo returns getServiceInstanceForResourceServiceInstanceSourceServiceSource.Repo, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceForResourceServiceInstanceSourceServiceSource) GetRepo() *string {
-	return v.Repo
+// GetTypename returns getDeploymentTriggerNodePlanLimitOverride.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodePlanLimitOverride) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodePlugin includes the requested fields of the GraphQL type Plugin.
+type getDeploymentTriggerNodePlugin struct {
+	Typename string `json:"__typename"`
 }
 
-// getServiceInstanceResponse is returned by getServiceInstance on success.
-type getServiceInstanceResponse struct {
-	// Get a service instance belonging to a service and environment
-	ServiceInstance getServiceInstanceServiceInstance `json:"serviceInstance"`
+// GetTypename returns getDeploymentTriggerNodePlugin.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodePlugin) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodePreferenceOverride includes the requested fields of the GraphQL type PreferenceOverride.
+type getDeploymentTriggerNodePreferenceOverride struct {
+	Typename string `json:"__typename"`
 }
 
-// GetServiceInstance returns getServiceInstanceResponse.ServiceInstance, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceResponse) GetServiceInstance() getServiceInstanceServiceInstance {
-	return v.ServiceInstance
+// GetTypename returns getDeploymentTriggerNodePreferenceOverride.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodePreferenceOverride) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodePreferences includes the requested fields of the GraphQL type Preferences.
+type getDeploymentTriggerNodePreferences struct {
+	Typename string `json:"__typename"`
 }
 
-// getServiceInstanceServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
-type getServiceInstanceServiceInstance struct {
-	Source            *getServiceInstanceServiceInstanceSourceServiceSource `json:"source"`
-	RootDirectory     *string                                               `json:"rootDirectory"`
-	RailwayConfigFile *string                                               `json:"railwayConfigFile"`
-	CronSchedule      *string                                               `json:"cronSchedule"`
-	LatestDeployment  getServiceInstanceServiceInstanceLatestDeployment     `json:"latestDeployment"`
+// GetTypename returns getDeploymentTriggerNodePreferences.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodePreferences) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeProject includes the requested fields of the GraphQL type Project.
+type getDeploymentTriggerNodeProject struct {
+	Typename string `json:"__typename"`
 }
 
-// GetSource returns getServiceInstanceServiceInstance.Source, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstance) GetSource() *getServiceInstanceServiceInstanceSourceServiceSource {
-	return v.Source
+// GetTypename returns getDeploymentTriggerNodeProject.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeProject) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeProjectPermission includes the requested fields of the GraphQL type ProjectPermission.
+type getDeploymentTriggerNodeProjectPermission struct {
+	Typename string `json:"__typename"`
 }
 
-// GetRootDirectory returns getServiceInstanceServiceInstance.RootDirectory, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstance) GetRootDirectory() *string { return v.RootDirectory }
+// GetTypename returns getDeploymentTriggerNodeProjectPermission.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeProjectPermission) GetTypename() string { return v.Typename }
 
-// GetRailwayConfigFile returns getServiceInstanceServiceInstance.RailwayConfigFile, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstance) GetRailwayConfigFile() *string {
-	return v.RailwayConfigFile
+// getDeploymentTriggerNodeProjectToken includes the requested fields of the GraphQL type ProjectToken.
+type getDeploymentTriggerNodeProjectToken struct {
+	Typename string `json:"__typename"`
 }
 
-// GetCronSchedule returns getServiceInstanceServiceInstance.CronSchedule, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstance) GetCronSchedule() *string { return v.CronSchedule }
+// GetTypename returns getDeploymentTriggerNodeProjectToken.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeProjectToken) GetTypename() string { return v.Typename }
 
-// GetLatestDeployment returns getServiceInstanceServiceInstance.LatestDeployment, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstance) GetLatestDeployment() getServiceInstanceServiceInstanceLatestDeployment {
-	return v.LatestDeployment
+// getDeploymentTriggerNodeProjectWebhook includes the requested fields of the GraphQL type ProjectWebhook.
+type getDeploymentTriggerNodeProjectWebhook struct {
+	Typename string `json:"__typename"`
 }
 
-// getServiceInstanceServiceInstanceLatestDeployment includes the requested fields of the GraphQL type Deployment.
-type getServiceInstanceServiceInstanceLatestDeployment struct {
-	Meta map[string]interface{} `json:"meta"`
+// GetTypename returns getDeploymentTriggerNodeProjectWebhook.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeProjectWebhook) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeProviderAuth includes the requested fields of the GraphQL type ProviderAuth.
+type getDeploymentTriggerNodeProviderAuth struct {
+	Typename string `json:"__typename"`
 }
 
-// GetMeta returns getServiceInstanceServiceInstanceLatestDeployment.Meta, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstanceLatestDeployment) GetMeta() map[string]interface{} {
-	return v.Meta
+// GetTypename returns getDeploymentTriggerNodeProviderAuth.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeProviderAuth) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeReferralInfo includes the requested fields of the GraphQL type ReferralInfo.
+type getDeploymentTriggerNodeReferralInfo struct {
+	Typename string `json:"__typename"`
 }
 
-// getServiceInstanceServiceInstanceSourceServiceSource includes the requested fields of the GraphQL type ServiceSource.
-type getServiceInstanceServiceInstanceSourceServiceSource struct {
-	Image *string `json:"image"`
-	Repo  *string `json:"repo"`
+// GetTypename returns getDeploymentTriggerNodeReferralInfo.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeReferralInfo) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeRefundRequest includes the requested fields of the GraphQL type RefundRequest.
+type getDeploymentTriggerNodeRefundRequest struct {
+	Typename string `json:"__typename"`
 }
 
-// GetImage returns getServiceInstanceServiceInstanceSourceServiceSource.Image, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstanceSourceServiceSource) GetImage() *string { return v.Image }
+// GetTypename returns getDeploymentTriggerNodeRefundRequest.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeRefundRequest) GetTypename() string { return v.Typename }
 
-// GetRepo returns getServiceInstanceServiceInstanceSourceServiceSource.Repo, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstanceSourceServiceSource) GetRepo() *string { return v.Repo }
+// getDeploymentTriggerNodeReissuedInvoice includes the requested fields of the GraphQL type ReissuedInvoice.
+type getDeploymentTriggerNodeReissuedInvoice struct {
+	Typename string `json:"__typename"`
+}
 
-// getServiceInstancesResponse is returned by getServiceInstances on success.
-type getServiceInstancesResponse struct {
-	// Get a service by ID
-	Service getServiceInstancesService `json:"service"`
+// GetTypename returns getDeploymentTriggerNodeReissuedInvoice.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeReissuedInvoice) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeService includes the requested fields of the GraphQL type Service.
+type getDeploymentTriggerNodeService struct {
+	Typename string `json:"__typename"`
 }
 
-// GetService returns getServiceInstancesResponse.Service, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesResponse) GetService() getServiceInstancesService { return v.Service }
+// GetTypename returns getDeploymentTriggerNodeService.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeService) GetTypename() string { return v.Typename }
 
-// getServiceInstancesService includes the requested fields of the GraphQL type Service.
-type getServiceInstancesService struct {
-	ServiceInstances getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection `json:"serviceInstances"`
+// getDeploymentTriggerNodeServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
+type getDeploymentTriggerNodeServiceInstance struct {
+	Typename string `json:"__typename"`
 }
 
-// GetServiceInstances returns getServiceInstancesService.ServiceInstances, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesService) GetServiceInstances() getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection {
-	return v.ServiceInstances
+// GetTypename returns getDeploymentTriggerNodeServiceInstance.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeServiceInstance) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeSession includes the requested fields of the GraphQL type Session.
+type getDeploymentTriggerNodeSession struct {
+	Typename string `json:"__typename"`
 }
 
-// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection includes the requested fields of the GraphQL type ServiceServiceInstancesConnection.
-type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection struct {
-	Edges []getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge `json:"edges"`
+// GetTypename returns getDeploymentTriggerNodeSession.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeSession) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeTeam includes the requested fields of the GraphQL type Team.
+type getDeploymentTriggerNodeTeam struct {
+	Typename string `json:"__typename"`
 }
 
-// GetEdges returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection.Edges, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection) GetEdges() []getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge {
-	return v.Edges
+// GetTypename returns getDeploymentTriggerNodeTeam.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeTeam) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeTeamPermission includes the requested fields of the GraphQL type TeamPermission.
+type getDeploymentTriggerNodeTeamPermission struct {
+	Typename string `json:"__typename"`
 }
 
-// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge includes the requested fields of the GraphQL type ServiceServiceInstancesConnectionEdge.
-type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge struct {
-	Node getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance `json:"node"`
+// GetTypename returns getDeploymentTriggerNodeTeamPermission.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeTeamPermission) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeTemplate includes the requested fields of the GraphQL type Template.
+type getDeploymentTriggerNodeTemplate struct {
+	Typename string `json:"__typename"`
 }
 
-// GetNode returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge.Node, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge) GetNode() getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance {
-	return v.Node
+// GetTypename returns getDeploymentTriggerNodeTemplate.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeTemplate) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeTemplateService includes the requested fields of the GraphQL type TemplateService.
+type getDeploymentTriggerNodeTemplateService struct {
+	Typename string `json:"__typename"`
 }
 
-// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
-type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance struct {
-	Id            string `json:"id"`
-	EnvironmentId string `json:"environmentId"`
+// GetTypename returns getDeploymentTriggerNodeTemplateService.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeTemplateService) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeUsageAnomaly includes the requested fields of the GraphQL type UsageAnomaly.
+type getDeploymentTriggerNodeUsageAnomaly struct {
+	Typename string `json:"__typename"`
 }
 
-// GetId returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance.Id, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance) GetId() string {
-	return v.Id
+// GetTypename returns getDeploymentTriggerNodeUsageAnomaly.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeUsageAnomaly) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeUsageLimit includes the requested fields of the GraphQL type UsageLimit.
+type getDeploymentTriggerNodeUsageLimit struct {
+	Typename string `json:"__typename"`
 }
 
-// GetEnvironmentId returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance) GetEnvironmentId() string {
-	return v.EnvironmentId
+// GetTypename returns getDeploymentTriggerNodeUsageLimit.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeUsageLimit) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeUser includes the requested fields of the GraphQL type User.
+type getDeploymentTriggerNodeUser struct {
+	Typename string `json:"__typename"`
 }
 
-// getServiceResponse is returned by getService on success.
-type getServiceResponse struct {
-	// Get a service by ID
-	Service getServiceService `json:"service"`
+// GetTypename returns getDeploymentTriggerNodeUser.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeUser) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeUserGithubRepo includes the requested fields of the GraphQL type UserGithubRepo.
+type getDeploymentTriggerNodeUserGithubRepo struct {
+	Typename string `json:"__typename"`
 }
 
-// GetService returns getServiceResponse.Service, and is useful for accessing the field via an interface.
-func (v *getServiceResponse) GetService() getServiceService { return v.Service }
+// GetTypename returns getDeploymentTriggerNodeUserGithubRepo.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeUserGithubRepo) GetTypename() string { return v.Typename }
 
-// getServiceService includes the requested fields of the GraphQL type Service.
-type getServiceService struct {
-	Service `json:"-"`
+// getDeploymentTriggerNodeVariable includes the requested fields of the GraphQL type Variable.
+type getDeploymentTriggerNodeVariable struct {
+	Typename string `json:"__typename"`
 }
 
-// GetId returns getServiceService.Id, and is useful for accessing the field via an interface.
-func (v *getServiceService) GetId() string { return v.Service.Id }
+// GetTypename returns getDeploymentTriggerNodeVariable.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeVariable) GetTypename() string { return v.Typename }
 
-// GetName returns getServiceService.Name, and is useful for accessing the field via an interface.
-func (v *getServiceService) GetName() string { return v.Service.Name }
+// getDeploymentTriggerNodeVolume includes the requested fields of the GraphQL type Volume.
+type getDeploymentTriggerNodeVolume struct {
+	Typename string `json:"__typename"`
+}
 
-// GetProjectId returns getServiceService.ProjectId, and is useful for accessing the field via an interface.
-func (v *getServiceService) GetProjectId() string { return v.Service.ProjectId }
+// GetTypename returns getDeploymentTriggerNodeVolume.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeVolume) GetTypename() string { return v.Typename }
 
-func (v *getServiceService) UnmarshalJSON(b []byte) error {
+// getDeploymentTriggerNodeVolumeInstance includes the requested fields of the GraphQL type VolumeInstance.
+type getDeploymentTriggerNodeVolumeInstance struct {
+	Typename string `json:"__typename"`
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetTypename returns getDeploymentTriggerNodeVolumeInstance.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeVolumeInstance) GetTypename() string { return v.Typename }
 
-	var firstPass struct {
-		*getServiceService
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.getServiceService = v
+// getDeploymentTriggerNodeVolumeInstanceBackupSchedule includes the requested fields of the GraphQL type VolumeInstanceBackupSchedule.
+type getDeploymentTriggerNodeVolumeInstanceBackupSchedule struct {
+	Typename string `json:"__typename"`
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetTypename returns getDeploymentTriggerNodeVolumeInstanceBackupSchedule.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeVolumeInstanceBackupSchedule) GetTypename() string {
+	return v.Typename
+}
 
-	err = json.Unmarshal(
-		b, &v.Service)
+// getDeploymentTriggerNodeWithdrawal includes the requested fields of the GraphQL type Withdrawal.
+type getDeploymentTriggerNodeWithdrawal struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getDeploymentTriggerNodeWithdrawal.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeWithdrawal) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeWithdrawalAccount includes the requested fields of the GraphQL type WithdrawalAccount.
+type getDeploymentTriggerNodeWithdrawalAccount struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getDeploymentTriggerNodeWithdrawalAccount.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeWithdrawalAccount) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerNodeWorkspace includes the requested fields of the GraphQL type Workspace.
+type getDeploymentTriggerNodeWorkspace struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getDeploymentTriggerNodeWorkspace.Typename, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerNodeWorkspace) GetTypename() string { return v.Typename }
+
+// getDeploymentTriggerResponse is returned by getDeploymentTrigger on success.
+type getDeploymentTriggerResponse struct {
+	Node getDeploymentTriggerNode `json:"-"`
+}
+
+// GetNode returns getDeploymentTriggerResponse.Node, and is useful for accessing the field via an interface.
+func (v *getDeploymentTriggerResponse) GetNode() getDeploymentTriggerNode { return v.Node }
+
+func (v *getDeploymentTriggerResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getDeploymentTriggerResponse
+		Node json.RawMessage `json:"node"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getDeploymentTriggerResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
+
+	{
+		dst := &v.Node
+		src := firstPass.Node
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalgetDeploymentTriggerNode(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal getDeploymentTriggerResponse.Node: %w", err)
+			}
+		}
+	}
 	return nil
 }
 
-type __premarshalgetServiceService struct {
-	Id string `json:"id"`
-
-	Name string `json:"name"`
-
-	ProjectId string `json:"projectId"`
+type __premarshalgetDeploymentTriggerResponse struct {
+	Node json.RawMessage `json:"node"`
 }
 
-func (v *getServiceService) MarshalJSON() ([]byte, error) {
+func (v *getDeploymentTriggerResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2965,67 +5337,68 @@ func (v *getServiceService) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getServiceService) __premarshalJSON() (*__premarshalgetServiceService, error) {
-	var retval __premarshalgetServiceService
+func (v *getDeploymentTriggerResponse) __premarshalJSON() (*__premarshalgetDeploymentTriggerResponse, error) {
+	var retval __premarshalgetDeploymentTriggerResponse
 
-	retval.Id = v.Service.Id
-	retval.Name = v.Service.Name
-	retval.ProjectId = v.Service.ProjectId
+	{
+
+		dst := &retval.Node
+		src := v.Node
+		var err error
+		*dst, err = __marshalgetDeploymentTriggerNode(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal getDeploymentTriggerResponse.Node: %w", err)
+		}
+	}
 	return &retval, nil
 }
 
-// getSharedVariablesResponse is returned by getSharedVariables on success.
-type getSharedVariablesResponse struct {
-	// All variables by pluginId or serviceId. If neither are provided, all shared variables are returned.
-	Variables map[string]interface{} `json:"variables"`
+// getEnvironmentEnvironment includes the requested fields of the GraphQL type Environment.
+type getEnvironmentEnvironment struct {
+	Environment      `json:"-"`
+	CreatedAt        time.Time                                                                      `json:"createdAt"`
+	ServiceInstances getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnection `json:"serviceInstances"`
+	VolumeInstances  getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection   `json:"volumeInstances"`
 }
 
-// GetVariables returns getSharedVariablesResponse.Variables, and is useful for accessing the field via an interface.
-func (v *getSharedVariablesResponse) GetVariables() map[string]interface{} { return v.Variables }
+// GetCreatedAt returns getEnvironmentEnvironment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetCreatedAt() time.Time { return v.CreatedAt }
 
-// getTcpProxyResponse is returned by getTcpProxy on success.
-type getTcpProxyResponse struct {
-	// All TCP proxies for a service instance
-	TcpProxies []getTcpProxyTcpProxiesTCPProxy `json:"tcpProxies"`
+// GetServiceInstances returns getEnvironmentEnvironment.ServiceInstances, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetServiceInstances() getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnection {
+	return v.ServiceInstances
 }
 
-// GetTcpProxies returns getTcpProxyResponse.TcpProxies, and is useful for accessing the field via an interface.
-func (v *getTcpProxyResponse) GetTcpProxies() []getTcpProxyTcpProxiesTCPProxy { return v.TcpProxies }
-
-// getTcpProxyTcpProxiesTCPProxy includes the requested fields of the GraphQL type TCPProxy.
-type getTcpProxyTcpProxiesTCPProxy struct {
-	TCPProxy `json:"-"`
+// GetVolumeInstances returns getEnvironmentEnvironment.VolumeInstances, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetVolumeInstances() getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection {
+	return v.VolumeInstances
 }
 
-// GetId returns getTcpProxyTcpProxiesTCPProxy.Id, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetId() string { return v.TCPProxy.Id }
-
-// GetApplicationPort returns getTcpProxyTcpProxiesTCPProxy.ApplicationPort, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetApplicationPort() int { return v.TCPProxy.ApplicationPort }
-
-// GetProxyPort returns getTcpProxyTcpProxiesTCPProxy.ProxyPort, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetProxyPort() int { return v.TCPProxy.ProxyPort }
+// GetId returns getEnvironmentEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetId() string { return v.Environment.Id }
 
-// GetDomain returns getTcpProxyTcpProxiesTCPProxy.Domain, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetDomain() string { return v.TCPProxy.Domain }
+// GetName returns getEnvironmentEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetName() string { return v.Environment.Name }
 
-// GetEnvironmentId returns getTcpProxyTcpProxiesTCPProxy.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetEnvironmentId() string { return v.TCPProxy.EnvironmentId }
+// GetProjectId returns getEnvironmentEnvironment.ProjectId, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetProjectId() string { return v.Environment.ProjectId }
 
-// GetServiceId returns getTcpProxyTcpProxiesTCPProxy.ServiceId, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetServiceId() string { return v.TCPProxy.ServiceId }
+// GetIsEphemeral returns getEnvironmentEnvironment.IsEphemeral, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetIsEphemeral() bool { return v.Environment.IsEphemeral }
 
-func (v *getTcpProxyTcpProxiesTCPProxy) UnmarshalJSON(b []byte) error {
+func (v *getEnvironmentEnvironment) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getTcpProxyTcpProxiesTCPProxy
+		*getEnvironmentEnvironment
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getTcpProxyTcpProxiesTCPProxy = v
+	firstPass.getEnvironmentEnvironment = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3033,28 +5406,30 @@ func (v *getTcpProxyTcpProxiesTCPProxy) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.TCPProxy)
+		b, &v.Environment)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetTcpProxyTcpProxiesTCPProxy struct {
-	Id string `json:"id"`
+type __premarshalgetEnvironmentEnvironment struct {
+	CreatedAt time.Time `json:"createdAt"`
 
-	ApplicationPort int `json:"applicationPort"`
+	ServiceInstances getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnection `json:"serviceInstances"`
 
-	ProxyPort int `json:"proxyPort"`
+	VolumeInstances getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection `json:"volumeInstances"`
 
-	Domain string `json:"domain"`
+	Id string `json:"id"`
 
-	EnvironmentId string `json:"environmentId"`
+	Name string `json:"name"`
 
-	ServiceId string `json:"serviceId"`
+	ProjectId string `json:"projectId"`
+
+	IsEphemeral bool `json:"isEphemeral"`
 }
 
-func (v *getTcpProxyTcpProxiesTCPProxy) MarshalJSON() ([]byte, error) {
+func (v *getEnvironmentEnvironment) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3062,339 +5437,307 @@ func (v *getTcpProxyTcpProxiesTCPProxy) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getTcpProxyTcpProxiesTCPProxy) __premarshalJSON() (*__premarshalgetTcpProxyTcpProxiesTCPProxy, error) {
-	var retval __premarshalgetTcpProxyTcpProxiesTCPProxy
+func (v *getEnvironmentEnvironment) __premarshalJSON() (*__premarshalgetEnvironmentEnvironment, error) {
+	var retval __premarshalgetEnvironmentEnvironment
 
-	retval.Id = v.TCPProxy.Id
-	retval.ApplicationPort = v.TCPProxy.ApplicationPort
-	retval.ProxyPort = v.TCPProxy.ProxyPort
-	retval.Domain = v.TCPProxy.Domain
-	retval.EnvironmentId = v.TCPProxy.EnvironmentId
-	retval.ServiceId = v.TCPProxy.ServiceId
+	retval.CreatedAt = v.CreatedAt
+	retval.ServiceInstances = v.ServiceInstances
+	retval.VolumeInstances = v.VolumeInstances
+	retval.Id = v.Environment.Id
+	retval.Name = v.Environment.Name
+	retval.ProjectId = v.Environment.ProjectId
+	retval.IsEphemeral = v.Environment.IsEphemeral
 	return &retval, nil
 }
 
-// getVariablesResponse is returned by getVariables on success.
-type getVariablesResponse struct {
-	// All variables by pluginId or serviceId. If neither are provided, all shared variables are returned.
-	Variables map[string]interface{} `json:"variables"`
+// getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnection includes the requested fields of the GraphQL type EnvironmentServiceInstancesConnection.
+type getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnection struct {
+	Edges []getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge `json:"edges"`
 }
 
-// GetVariables returns getVariablesResponse.Variables, and is useful for accessing the field via an interface.
-func (v *getVariablesResponse) GetVariables() map[string]interface{} { return v.Variables }
-
-// getVolumeInstancesProject includes the requested fields of the GraphQL type Project.
-type getVolumeInstancesProject struct {
-	Volumes getVolumeInstancesProjectVolumesProjectVolumesConnection `json:"volumes"`
+// GetEdges returns getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnection) GetEdges() []getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge {
+	return v.Edges
 }
 
-// GetVolumes returns getVolumeInstancesProject.Volumes, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProject) GetVolumes() getVolumeInstancesProjectVolumesProjectVolumesConnection {
-	return v.Volumes
+// getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge includes the requested fields of the GraphQL type EnvironmentServiceInstancesConnectionEdge.
+type getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge struct {
+	Node getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance `json:"node"`
 }
 
-// getVolumeInstancesProjectVolumesProjectVolumesConnection includes the requested fields of the GraphQL type ProjectVolumesConnection.
-type getVolumeInstancesProjectVolumesProjectVolumesConnection struct {
-	Edges []getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge `json:"edges"`
+// GetNode returns getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge) GetNode() getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance {
+	return v.Node
 }
 
-// GetEdges returns getVolumeInstancesProjectVolumesProjectVolumesConnection.Edges, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnection) GetEdges() []getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge {
-	return v.Edges
+// getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
+type getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance struct {
+	ServiceId   string `json:"serviceId"`
+	ServiceName string `json:"serviceName"`
 }
 
-// getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge includes the requested fields of the GraphQL type ProjectVolumesConnectionEdge.
-type getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge struct {
-	Node getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume `json:"node"`
+// GetServiceId returns getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance.ServiceId, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance) GetServiceId() string {
+	return v.ServiceId
 }
 
-// GetNode returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge.Node, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge) GetNode() getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume {
-	return v.Node
+// GetServiceName returns getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance.ServiceName, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance) GetServiceName() string {
+	return v.ServiceName
 }
 
-// getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume includes the requested fields of the GraphQL type Volume.
-type getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume struct {
-	Volume `json:"-"`
+// getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection includes the requested fields of the GraphQL type EnvironmentVolumeInstancesConnection.
+type getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection struct {
+	Edges []getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge `json:"edges"`
 }
 
-// GetId returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.Id, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetId() string {
-	return v.Volume.Id
+// GetEdges returns getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection) GetEdges() []getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge {
+	return v.Edges
 }
 
-// GetName returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.Name, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetName() string {
-	return v.Volume.Name
+// getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge includes the requested fields of the GraphQL type EnvironmentVolumeInstancesConnectionEdge.
+type getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge struct {
+	Node getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance `json:"node"`
 }
 
-// GetVolumeInstances returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.VolumeInstances, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetVolumeInstances() VolumeVolumeInstancesVolumeVolumeInstancesConnection {
-	return v.Volume.VolumeInstances
+// GetNode returns getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge) GetNode() getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance {
+	return v.Node
 }
 
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) UnmarshalJSON(b []byte) error {
+// getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance includes the requested fields of the GraphQL type VolumeInstance.
+type getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance struct {
+	VolumeId string `json:"volumeId"`
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetVolumeId returns getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance.VolumeId, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance) GetVolumeId() string {
+	return v.VolumeId
+}
 
-	var firstPass struct {
-		*getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume = v
+// getEnvironmentResponse is returned by getEnvironment on success.
+type getEnvironmentResponse struct {
+	// Find a single environment
+	Environment getEnvironmentEnvironment `json:"environment"`
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetEnvironment returns getEnvironmentResponse.Environment, and is useful for accessing the field via an interface.
+func (v *getEnvironmentResponse) GetEnvironment() getEnvironmentEnvironment { return v.Environment }
 
-	err = json.Unmarshal(
-		b, &v.Volume)
-	if err != nil {
-		return err
-	}
-	return nil
+// getEnvironmentServiceIdsEnvironment includes the requested fields of the GraphQL type Environment.
+type getEnvironmentServiceIdsEnvironment struct {
+	ServiceInstances getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection `json:"serviceInstances"`
 }
 
-type __premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume struct {
-	Id string `json:"id"`
+// GetServiceInstances returns getEnvironmentServiceIdsEnvironment.ServiceInstances, and is useful for accessing the field via an interface.
+func (v *getEnvironmentServiceIdsEnvironment) GetServiceInstances() getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection {
+	return v.ServiceInstances
+}
 
-	Name string `json:"name"`
+// getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection includes the requested fields of the GraphQL type EnvironmentServiceInstancesConnection.
+type getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection struct {
+	Edges    []getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge `json:"edges"`
+	PageInfo getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo                                         `json:"pageInfo"`
+}
 
-	VolumeInstances VolumeVolumeInstancesVolumeVolumeInstancesConnection `json:"volumeInstances"`
+// GetEdges returns getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection) GetEdges() []getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge {
+	return v.Edges
 }
 
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetPageInfo returns getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection) GetPageInfo() getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo {
+	return v.PageInfo
 }
 
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) __premarshalJSON() (*__premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume, error) {
-	var retval __premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume
+// getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge includes the requested fields of the GraphQL type EnvironmentServiceInstancesConnectionEdge.
+type getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge struct {
+	Node getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance `json:"node"`
+}
 
-	retval.Id = v.Volume.Id
-	retval.Name = v.Volume.Name
-	retval.VolumeInstances = v.Volume.VolumeInstances
-	return &retval, nil
+// GetNode returns getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge) GetNode() getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance {
+	return v.Node
 }
 
-// getVolumeInstancesResponse is returned by getVolumeInstances on success.
-type getVolumeInstancesResponse struct {
-	// Get a project by ID
-	Project getVolumeInstancesProject `json:"project"`
+// getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
+type getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance struct {
+	ServiceId string `json:"serviceId"`
 }
 
-// GetProject returns getVolumeInstancesResponse.Project, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesResponse) GetProject() getVolumeInstancesProject { return v.Project }
+// GetServiceId returns getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance.ServiceId, and is useful for accessing the field via an interface.
+func (v *getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance) GetServiceId() string {
+	return v.ServiceId
+}
 
-// listCustomDomainsDomainsAllDomains includes the requested fields of the GraphQL type AllDomains.
-type listCustomDomainsDomainsAllDomains struct {
-	CustomDomains []listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain `json:"customDomains"`
+// getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
 }
 
-// GetCustomDomains returns listCustomDomainsDomainsAllDomains.CustomDomains, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomains) GetCustomDomains() []listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain {
-	return v.CustomDomains
+// GetHasNextPage returns getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
 }
 
-// listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain includes the requested fields of the GraphQL type CustomDomain.
-type listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain struct {
-	CustomDomain `json:"-"`
+// GetEndCursor returns getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getEnvironmentServiceIdsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
 }
 
-// GetId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Id, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetId() string {
-	return v.CustomDomain.Id
+// getEnvironmentServiceIdsResponse is returned by getEnvironmentServiceIds on success.
+type getEnvironmentServiceIdsResponse struct {
+	// Find a single environment
+	Environment getEnvironmentServiceIdsEnvironment `json:"environment"`
 }
 
-// GetDomain returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Domain, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetDomain() string {
-	return v.CustomDomain.Domain
+// GetEnvironment returns getEnvironmentServiceIdsResponse.Environment, and is useful for accessing the field via an interface.
+func (v *getEnvironmentServiceIdsResponse) GetEnvironment() getEnvironmentServiceIdsEnvironment {
+	return v.Environment
 }
 
-// GetStatus returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Status, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetStatus() CustomDomainStatus {
-	return v.CustomDomain.Status
+// getEnvironmentStagedChangesEnvironmentStagedChangesEnvironmentPatch includes the requested fields of the GraphQL type EnvironmentPatch.
+type getEnvironmentStagedChangesEnvironmentStagedChangesEnvironmentPatch struct {
+	Id     string                 `json:"id"`
+	Status EnvironmentPatchStatus `json:"status"`
 }
 
-// GetEnvironmentId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetEnvironmentId() string {
-	return v.CustomDomain.EnvironmentId
+// GetId returns getEnvironmentStagedChangesEnvironmentStagedChangesEnvironmentPatch.Id, and is useful for accessing the field via an interface.
+func (v *getEnvironmentStagedChangesEnvironmentStagedChangesEnvironmentPatch) GetId() string {
+	return v.Id
 }
 
-// GetServiceId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.ServiceId, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetServiceId() string {
-	return v.CustomDomain.ServiceId
+// GetStatus returns getEnvironmentStagedChangesEnvironmentStagedChangesEnvironmentPatch.Status, and is useful for accessing the field via an interface.
+func (v *getEnvironmentStagedChangesEnvironmentStagedChangesEnvironmentPatch) GetStatus() EnvironmentPatchStatus {
+	return v.Status
 }
 
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) UnmarshalJSON(b []byte) error {
+// getEnvironmentStagedChangesResponse is returned by getEnvironmentStagedChanges on success.
+type getEnvironmentStagedChangesResponse struct {
+	// Get the latest staged commit for a single environment.
+	EnvironmentStagedChanges getEnvironmentStagedChangesEnvironmentStagedChangesEnvironmentPatch `json:"environmentStagedChanges"`
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetEnvironmentStagedChanges returns getEnvironmentStagedChangesResponse.EnvironmentStagedChanges, and is useful for accessing the field via an interface.
+func (v *getEnvironmentStagedChangesResponse) GetEnvironmentStagedChanges() getEnvironmentStagedChangesEnvironmentStagedChangesEnvironmentPatch {
+	return v.EnvironmentStagedChanges
+}
 
-	var firstPass struct {
-		*listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
-
-	err = json.Unmarshal(
-		b, &v.CustomDomain)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-type __premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain struct {
-	Id string `json:"id"`
-
-	Domain string `json:"domain"`
-
-	Status CustomDomainStatus `json:"status"`
-
-	EnvironmentId string `json:"environmentId"`
-
-	ServiceId string `json:"serviceId"`
+// getPrivateNetworkEndpointPrivateNetworkEndpoint includes the requested fields of the GraphQL type PrivateNetworkEndpoint.
+type getPrivateNetworkEndpointPrivateNetworkEndpoint struct {
+	PublicId          *string   `json:"publicId"`
+	DnsName           *string   `json:"dnsName"`
+	PrivateIps        []*string `json:"privateIps"`
+	ServiceInstanceId *string   `json:"serviceInstanceId"`
+	Tags              []*string `json:"tags"`
 }
 
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+// GetPublicId returns getPrivateNetworkEndpointPrivateNetworkEndpoint.PublicId, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetPublicId() *string { return v.PublicId }
 
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) __premarshalJSON() (*__premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain, error) {
-	var retval __premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain
+// GetDnsName returns getPrivateNetworkEndpointPrivateNetworkEndpoint.DnsName, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetDnsName() *string { return v.DnsName }
 
-	retval.Id = v.CustomDomain.Id
-	retval.Domain = v.CustomDomain.Domain
-	retval.Status = v.CustomDomain.Status
-	retval.EnvironmentId = v.CustomDomain.EnvironmentId
-	retval.ServiceId = v.CustomDomain.ServiceId
-	return &retval, nil
+// GetPrivateIps returns getPrivateNetworkEndpointPrivateNetworkEndpoint.PrivateIps, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetPrivateIps() []*string {
+	return v.PrivateIps
 }
 
-// listCustomDomainsResponse is returned by listCustomDomains on success.
-type listCustomDomainsResponse struct {
-	// All domains for a service instance
-	Domains listCustomDomainsDomainsAllDomains `json:"domains"`
+// GetServiceInstanceId returns getPrivateNetworkEndpointPrivateNetworkEndpoint.ServiceInstanceId, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetServiceInstanceId() *string {
+	return v.ServiceInstanceId
 }
 
-// GetDomains returns listCustomDomainsResponse.Domains, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsResponse) GetDomains() listCustomDomainsDomainsAllDomains { return v.Domains }
-
-// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection includes the requested fields of the GraphQL type QueryDeploymentTriggersConnection.
-type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection struct {
-	Edges []listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge `json:"edges"`
-}
+// GetTags returns getPrivateNetworkEndpointPrivateNetworkEndpoint.Tags, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetTags() []*string { return v.Tags }
 
-// GetEdges returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection.Edges, and is useful for accessing the field via an interface.
-func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection) GetEdges() []listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge {
-	return v.Edges
+// getPrivateNetworkEndpointResponse is returned by getPrivateNetworkEndpoint on success.
+type getPrivateNetworkEndpointResponse struct {
+	// Get a private network endpoint for a service instance.
+	PrivateNetworkEndpoint *getPrivateNetworkEndpointPrivateNetworkEndpoint `json:"privateNetworkEndpoint"`
 }
 
-// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge includes the requested fields of the GraphQL type QueryDeploymentTriggersConnectionEdge.
-type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge struct {
-	Node listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger `json:"node"`
+// GetPrivateNetworkEndpoint returns getPrivateNetworkEndpointResponse.PrivateNetworkEndpoint, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointResponse) GetPrivateNetworkEndpoint() *getPrivateNetworkEndpointPrivateNetworkEndpoint {
+	return v.PrivateNetworkEndpoint
 }
 
-// GetNode returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge.Node, and is useful for accessing the field via an interface.
-func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge) GetNode() listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger {
-	return v.Node
+// getPrivateNetworksPrivateNetworksPrivateNetwork includes the requested fields of the GraphQL type PrivateNetwork.
+type getPrivateNetworksPrivateNetworksPrivateNetwork struct {
+	PublicId      string   `json:"publicId"`
+	Name          string   `json:"name"`
+	DnsName       string   `json:"dnsName"`
+	NetworkId     int64    `json:"networkId"`
+	EnvironmentId string   `json:"environmentId"`
+	ProjectId     string   `json:"projectId"`
+	Tags          []string `json:"tags"`
 }
 
-// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger includes the requested fields of the GraphQL type DeploymentTrigger.
-type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger struct {
-	Id     string `json:"id"`
-	Branch string `json:"branch"`
-}
+// GetPublicId returns getPrivateNetworksPrivateNetworksPrivateNetwork.PublicId, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetPublicId() string { return v.PublicId }
 
-// GetId returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger.Id, and is useful for accessing the field via an interface.
-func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger) GetId() string {
-	return v.Id
-}
+// GetName returns getPrivateNetworksPrivateNetworksPrivateNetwork.Name, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetName() string { return v.Name }
 
-// GetBranch returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger.Branch, and is useful for accessing the field via an interface.
-func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger) GetBranch() string {
-	return v.Branch
-}
+// GetDnsName returns getPrivateNetworksPrivateNetworksPrivateNetwork.DnsName, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetDnsName() string { return v.DnsName }
 
-// listDeploymentTriggersResponse is returned by listDeploymentTriggers on success.
-type listDeploymentTriggersResponse struct {
-	// All deployment triggers.
-	DeploymentTriggers listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection `json:"deploymentTriggers"`
-}
+// GetNetworkId returns getPrivateNetworksPrivateNetworksPrivateNetwork.NetworkId, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetNetworkId() int64 { return v.NetworkId }
 
-// GetDeploymentTriggers returns listDeploymentTriggersResponse.DeploymentTriggers, and is useful for accessing the field via an interface.
-func (v *listDeploymentTriggersResponse) GetDeploymentTriggers() listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection {
-	return v.DeploymentTriggers
+// GetEnvironmentId returns getPrivateNetworksPrivateNetworksPrivateNetwork.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetEnvironmentId() string {
+	return v.EnvironmentId
 }
 
-// listServiceDomainsDomainsAllDomains includes the requested fields of the GraphQL type AllDomains.
-type listServiceDomainsDomainsAllDomains struct {
-	ServiceDomains []listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain `json:"serviceDomains"`
-}
+// GetProjectId returns getPrivateNetworksPrivateNetworksPrivateNetwork.ProjectId, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetProjectId() string { return v.ProjectId }
 
-// GetServiceDomains returns listServiceDomainsDomainsAllDomains.ServiceDomains, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomains) GetServiceDomains() []listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain {
-	return v.ServiceDomains
-}
+// GetTags returns getPrivateNetworksPrivateNetworksPrivateNetwork.Tags, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworksPrivateNetworksPrivateNetwork) GetTags() []string { return v.Tags }
 
-// listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain includes the requested fields of the GraphQL type ServiceDomain.
-type listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain struct {
-	ServiceDomain `json:"-"`
+// getPrivateNetworksResponse is returned by getPrivateNetworks on success.
+type getPrivateNetworksResponse struct {
+	// List private networks for an environment.
+	PrivateNetworks []getPrivateNetworksPrivateNetworksPrivateNetwork `json:"privateNetworks"`
 }
 
-// GetId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Id, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetId() string {
-	return v.ServiceDomain.Id
+// GetPrivateNetworks returns getPrivateNetworksResponse.PrivateNetworks, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworksResponse) GetPrivateNetworks() []getPrivateNetworksPrivateNetworksPrivateNetwork {
+	return v.PrivateNetworks
 }
 
-// GetDomain returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Domain, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetDomain() string {
-	return v.ServiceDomain.Domain
+// getProjectPlanLimitsProject includes the requested fields of the GraphQL type Project.
+type getProjectPlanLimitsProject struct {
+	PlanLimits `json:"-"`
 }
 
-// GetSuffix returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Suffix, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetSuffix() string {
-	return v.ServiceDomain.Suffix
-}
+// GetId returns getProjectPlanLimitsProject.Id, and is useful for accessing the field via an interface.
+func (v *getProjectPlanLimitsProject) GetId() string { return v.PlanLimits.Id }
 
-// GetEnvironmentId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetEnvironmentId() string {
-	return v.ServiceDomain.EnvironmentId
+// GetSubscriptionType returns getProjectPlanLimitsProject.SubscriptionType, and is useful for accessing the field via an interface.
+func (v *getProjectPlanLimitsProject) GetSubscriptionType() SubscriptionPlanType {
+	return v.PlanLimits.SubscriptionType
 }
 
-// GetServiceId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.ServiceId, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetServiceId() string {
-	return v.ServiceDomain.ServiceId
+// GetSubscriptionPlanLimit returns getProjectPlanLimitsProject.SubscriptionPlanLimit, and is useful for accessing the field via an interface.
+func (v *getProjectPlanLimitsProject) GetSubscriptionPlanLimit() map[string]interface{} {
+	return v.PlanLimits.SubscriptionPlanLimit
 }
 
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) UnmarshalJSON(b []byte) error {
+func (v *getProjectPlanLimitsProject) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain
+		*getProjectPlanLimitsProject
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain = v
+	firstPass.getProjectPlanLimitsProject = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3402,26 +5745,22 @@ func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) Unmarsh
 	}
 
 	err = json.Unmarshal(
-		b, &v.ServiceDomain)
+		b, &v.PlanLimits)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain struct {
+type __premarshalgetProjectPlanLimitsProject struct {
 	Id string `json:"id"`
 
-	Domain string `json:"domain"`
-
-	Suffix string `json:"suffix"`
-
-	EnvironmentId string `json:"environmentId"`
+	SubscriptionType SubscriptionPlanType `json:"subscriptionType"`
 
-	ServiceId string `json:"serviceId"`
+	SubscriptionPlanLimit map[string]interface{} `json:"subscriptionPlanLimit"`
 }
 
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) MarshalJSON() ([]byte, error) {
+func (v *getProjectPlanLimitsProject) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3429,91 +5768,66 @@ func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) Marshal
 	return json.Marshal(premarshaled)
 }
 
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) __premarshalJSON() (*__premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain, error) {
-	var retval __premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain
+func (v *getProjectPlanLimitsProject) __premarshalJSON() (*__premarshalgetProjectPlanLimitsProject, error) {
+	var retval __premarshalgetProjectPlanLimitsProject
 
-	retval.Id = v.ServiceDomain.Id
-	retval.Domain = v.ServiceDomain.Domain
-	retval.Suffix = v.ServiceDomain.Suffix
-	retval.EnvironmentId = v.ServiceDomain.EnvironmentId
-	retval.ServiceId = v.ServiceDomain.ServiceId
+	retval.Id = v.PlanLimits.Id
+	retval.SubscriptionType = v.PlanLimits.SubscriptionType
+	retval.SubscriptionPlanLimit = v.PlanLimits.SubscriptionPlanLimit
 	return &retval, nil
 }
 
-// listServiceDomainsResponse is returned by listServiceDomains on success.
-type listServiceDomainsResponse struct {
-	// All domains for a service instance
-	Domains listServiceDomainsDomainsAllDomains `json:"domains"`
-}
-
-// GetDomains returns listServiceDomainsResponse.Domains, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsResponse) GetDomains() listServiceDomainsDomainsAllDomains {
-	return v.Domains
-}
-
-// redeployServiceInstanceResponse is returned by redeployServiceInstance on success.
-type redeployServiceInstanceResponse struct {
-	// Redeploy a service instance
-	ServiceInstanceRedeploy bool `json:"serviceInstanceRedeploy"`
-}
-
-// GetServiceInstanceRedeploy returns redeployServiceInstanceResponse.ServiceInstanceRedeploy, and is useful for accessing the field via an interface.
-func (v *redeployServiceInstanceResponse) GetServiceInstanceRedeploy() bool {
-	return v.ServiceInstanceRedeploy
-}
-
-// redeployServiceInstanceWithEnvResponse is returned by redeployServiceInstanceWithEnv on success.
-type redeployServiceInstanceWithEnvResponse struct {
-	// Redeploy a service instance
-	ServiceInstanceRedeploy bool `json:"serviceInstanceRedeploy"`
+// getProjectPlanLimitsResponse is returned by getProjectPlanLimits on success.
+type getProjectPlanLimitsResponse struct {
+	// Get a project by ID
+	Project getProjectPlanLimitsProject `json:"project"`
 }
 
-// GetServiceInstanceRedeploy returns redeployServiceInstanceWithEnvResponse.ServiceInstanceRedeploy, and is useful for accessing the field via an interface.
-func (v *redeployServiceInstanceWithEnvResponse) GetServiceInstanceRedeploy() bool {
-	return v.ServiceInstanceRedeploy
-}
+// GetProject returns getProjectPlanLimitsResponse.Project, and is useful for accessing the field via an interface.
+func (v *getProjectPlanLimitsResponse) GetProject() getProjectPlanLimitsProject { return v.Project }
 
-// updateProjectProjectUpdateProject includes the requested fields of the GraphQL type Project.
-type updateProjectProjectUpdateProject struct {
+// getProjectProject includes the requested fields of the GraphQL type Project.
+type getProjectProject struct {
 	Project `json:"-"`
 }
 
-// GetId returns updateProjectProjectUpdateProject.Id, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetId() string { return v.Project.Id }
+// GetId returns getProjectProject.Id, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetId() string { return v.Project.Id }
 
-// GetName returns updateProjectProjectUpdateProject.Name, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetName() string { return v.Project.Name }
+// GetName returns getProjectProject.Name, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetName() string { return v.Project.Name }
 
-// GetDescription returns updateProjectProjectUpdateProject.Description, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetDescription() string { return v.Project.Description }
+// GetDescription returns getProjectProject.Description, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetDescription() string { return v.Project.Description }
 
-// GetIsPublic returns updateProjectProjectUpdateProject.IsPublic, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetIsPublic() bool { return v.Project.IsPublic }
+// GetIsPublic returns getProjectProject.IsPublic, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetIsPublic() bool { return v.Project.IsPublic }
 
-// GetPrDeploys returns updateProjectProjectUpdateProject.PrDeploys, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetPrDeploys() bool { return v.Project.PrDeploys }
+// GetPrDeploys returns getProjectProject.PrDeploys, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetPrDeploys() bool { return v.Project.PrDeploys }
 
-// GetWorkspace returns updateProjectProjectUpdateProject.Workspace, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetWorkspace() *ProjectWorkspace {
-	return v.Project.Workspace
-}
+// GetBotPrEnvironments returns getProjectProject.BotPrEnvironments, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetBotPrEnvironments() bool { return v.Project.BotPrEnvironments }
 
-// GetEnvironments returns updateProjectProjectUpdateProject.Environments, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetEnvironments() ProjectEnvironmentsProjectEnvironmentsConnection {
+// GetWorkspace returns getProjectProject.Workspace, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetWorkspace() *ProjectWorkspace { return v.Project.Workspace }
+
+// GetEnvironments returns getProjectProject.Environments, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetEnvironments() ProjectEnvironmentsProjectEnvironmentsConnection {
 	return v.Project.Environments
 }
 
-func (v *updateProjectProjectUpdateProject) UnmarshalJSON(b []byte) error {
+func (v *getProjectProject) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*updateProjectProjectUpdateProject
+		*getProjectProject
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.updateProjectProjectUpdateProject = v
+	firstPass.getProjectProject = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3528,7 +5842,7 @@ func (v *updateProjectProjectUpdateProject) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-type __premarshalupdateProjectProjectUpdateProject struct {
+type __premarshalgetProjectProject struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
@@ -3539,12 +5853,14 @@ type __premarshalupdateProjectProjectUpdateProject struct {
 
 	PrDeploys bool `json:"prDeploys"`
 
+	BotPrEnvironments bool `json:"botPrEnvironments"`
+
 	Workspace *ProjectWorkspace `json:"workspace"`
 
 	Environments ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
 }
 
-func (v *updateProjectProjectUpdateProject) MarshalJSON() ([]byte, error) {
+func (v *getProjectProject) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3552,194 +5868,396 @@ func (v *updateProjectProjectUpdateProject) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *updateProjectProjectUpdateProject) __premarshalJSON() (*__premarshalupdateProjectProjectUpdateProject, error) {
-	var retval __premarshalupdateProjectProjectUpdateProject
+func (v *getProjectProject) __premarshalJSON() (*__premarshalgetProjectProject, error) {
+	var retval __premarshalgetProjectProject
 
 	retval.Id = v.Project.Id
 	retval.Name = v.Project.Name
 	retval.Description = v.Project.Description
 	retval.IsPublic = v.Project.IsPublic
 	retval.PrDeploys = v.Project.PrDeploys
+	retval.BotPrEnvironments = v.Project.BotPrEnvironments
 	retval.Workspace = v.Project.Workspace
 	retval.Environments = v.Project.Environments
 	return &retval, nil
 }
 
-// updateProjectResponse is returned by updateProject on success.
-type updateProjectResponse struct {
-	// Updates a project.
-	ProjectUpdate updateProjectProjectUpdateProject `json:"projectUpdate"`
+// getProjectResponse is returned by getProject on success.
+type getProjectResponse struct {
+	// Get a project by ID
+	Project getProjectProject `json:"project"`
 }
 
-// GetProjectUpdate returns updateProjectResponse.ProjectUpdate, and is useful for accessing the field via an interface.
-func (v *updateProjectResponse) GetProjectUpdate() updateProjectProjectUpdateProject {
-	return v.ProjectUpdate
+// GetProject returns getProjectResponse.Project, and is useful for accessing the field via an interface.
+func (v *getProjectResponse) GetProject() getProjectProject { return v.Project }
+
+// getProjectWorkspaceResponse is returned by getProjectWorkspace on success.
+type getProjectWorkspaceResponse struct {
+	// Get the workspace
+	Workspace getProjectWorkspaceWorkspace `json:"workspace"`
 }
 
-// updateServiceDomainResponse is returned by updateServiceDomain on success.
-type updateServiceDomainResponse struct {
-	// Updates a service domain.
-	ServiceDomainUpdate bool `json:"serviceDomainUpdate"`
+// GetWorkspace returns getProjectWorkspaceResponse.Workspace, and is useful for accessing the field via an interface.
+func (v *getProjectWorkspaceResponse) GetWorkspace() getProjectWorkspaceWorkspace { return v.Workspace }
+
+// getProjectWorkspaceWorkspace includes the requested fields of the GraphQL type Workspace.
+type getProjectWorkspaceWorkspace struct {
+	Name string `json:"name"`
+	Plan Plan   `json:"plan"`
 }
 
-// GetServiceDomainUpdate returns updateServiceDomainResponse.ServiceDomainUpdate, and is useful for accessing the field via an interface.
-func (v *updateServiceDomainResponse) GetServiceDomainUpdate() bool { return v.ServiceDomainUpdate }
+// GetName returns getProjectWorkspaceWorkspace.Name, and is useful for accessing the field via an interface.
+func (v *getProjectWorkspaceWorkspace) GetName() string { return v.Name }
 
-// updateServiceInstanceLimitsResponse is returned by updateServiceInstanceLimits on success.
-type updateServiceInstanceLimitsResponse struct {
-	// Update the resource limits for a service instance
-	ServiceInstanceLimitsUpdate bool `json:"serviceInstanceLimitsUpdate"`
+// GetPlan returns getProjectWorkspaceWorkspace.Plan, and is useful for accessing the field via an interface.
+func (v *getProjectWorkspaceWorkspace) GetPlan() Plan { return v.Plan }
+
+// getResolvedVariablesResponse is returned by getResolvedVariables on success.
+type getResolvedVariablesResponse struct {
+	// All variables by pluginId or serviceId. If neither are provided, all shared variables are returned.
+	Variables map[string]interface{} `json:"variables"`
 }
 
-// GetServiceInstanceLimitsUpdate returns updateServiceInstanceLimitsResponse.ServiceInstanceLimitsUpdate, and is useful for accessing the field via an interface.
-func (v *updateServiceInstanceLimitsResponse) GetServiceInstanceLimitsUpdate() bool {
-	return v.ServiceInstanceLimitsUpdate
+// GetVariables returns getResolvedVariablesResponse.Variables, and is useful for accessing the field via an interface.
+func (v *getResolvedVariablesResponse) GetVariables() map[string]interface{} { return v.Variables }
+
+// getServiceInstanceForResourceResponse is returned by getServiceInstanceForResource on success.
+type getServiceInstanceForResourceResponse struct {
+	// Get a service instance belonging to a service and environment
+	ServiceInstance getServiceInstanceForResourceServiceInstance `json:"serviceInstance"`
 }
 
-// updateServiceInstanceResponse is returned by updateServiceInstance on success.
-type updateServiceInstanceResponse struct {
-	// Update a service instance
-	ServiceInstanceUpdate bool `json:"serviceInstanceUpdate"`
+// GetServiceInstance returns getServiceInstanceForResourceResponse.ServiceInstance, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceResponse) GetServiceInstance() getServiceInstanceForResourceServiceInstance {
+	return v.ServiceInstance
 }
 
-// GetServiceInstanceUpdate returns updateServiceInstanceResponse.ServiceInstanceUpdate, and is useful for accessing the field via an interface.
-func (v *updateServiceInstanceResponse) GetServiceInstanceUpdate() bool {
-	return v.ServiceInstanceUpdate
+// getServiceInstanceForResourceServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
+type getServiceInstanceForResourceServiceInstance struct {
+	Id                      string                                                           `json:"id"`
+	EnvironmentId           string                                                           `json:"environmentId"`
+	ServiceId               string                                                           `json:"serviceId"`
+	Source                  *getServiceInstanceForResourceServiceInstanceSourceServiceSource `json:"source"`
+	Builder                 Builder                                                          `json:"builder"`
+	BuildCommand            *string                                                          `json:"buildCommand"`
+	StartCommand            *string                                                          `json:"startCommand"`
+	PreDeployCommand        map[string]interface{}                                           `json:"preDeployCommand"`
+	HealthcheckPath         *string                                                          `json:"healthcheckPath"`
+	HealthcheckTimeout      *int                                                             `json:"healthcheckTimeout"`
+	RestartPolicyType       RestartPolicyType                                                `json:"restartPolicyType"`
+	RestartPolicyMaxRetries int                                                              `json:"restartPolicyMaxRetries"`
+	SleepApplication        *bool                                                            `json:"sleepApplication"`
+	Region                  *string                                                          `json:"region"`
+	NumReplicas             *int                                                             `json:"numReplicas"`
 }
 
-// updateServiceInstanceWithEnvResponse is returned by updateServiceInstanceWithEnv on success.
-type updateServiceInstanceWithEnvResponse struct {
-	// Update a service instance
-	ServiceInstanceUpdate bool `json:"serviceInstanceUpdate"`
+// GetId returns getServiceInstanceForResourceServiceInstance.Id, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetId() string { return v.Id }
+
+// GetEnvironmentId returns getServiceInstanceForResourceServiceInstance.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetEnvironmentId() string {
+	return v.EnvironmentId
 }
 
-// GetServiceInstanceUpdate returns updateServiceInstanceWithEnvResponse.ServiceInstanceUpdate, and is useful for accessing the field via an interface.
-func (v *updateServiceInstanceWithEnvResponse) GetServiceInstanceUpdate() bool {
-	return v.ServiceInstanceUpdate
+// GetServiceId returns getServiceInstanceForResourceServiceInstance.ServiceId, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetServiceId() string { return v.ServiceId }
+
+// GetSource returns getServiceInstanceForResourceServiceInstance.Source, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetSource() *getServiceInstanceForResourceServiceInstanceSourceServiceSource {
+	return v.Source
 }
 
-// updateServiceResponse is returned by updateService on success.
-type updateServiceResponse struct {
-	// Updates a service.
-	ServiceUpdate updateServiceServiceUpdateService `json:"serviceUpdate"`
+// GetBuilder returns getServiceInstanceForResourceServiceInstance.Builder, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetBuilder() Builder { return v.Builder }
+
+// GetBuildCommand returns getServiceInstanceForResourceServiceInstance.BuildCommand, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetBuildCommand() *string {
+	return v.BuildCommand
 }
 
-// GetServiceUpdate returns updateServiceResponse.ServiceUpdate, and is useful for accessing the field via an interface.
-func (v *updateServiceResponse) GetServiceUpdate() updateServiceServiceUpdateService {
-	return v.ServiceUpdate
+// GetStartCommand returns getServiceInstanceForResourceServiceInstance.StartCommand, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetStartCommand() *string {
+	return v.StartCommand
 }
 
-// updateServiceServiceUpdateService includes the requested fields of the GraphQL type Service.
-type updateServiceServiceUpdateService struct {
-	Service `json:"-"`
+// GetPreDeployCommand returns getServiceInstanceForResourceServiceInstance.PreDeployCommand, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetPreDeployCommand() map[string]interface{} {
+	return v.PreDeployCommand
 }
 
-// GetId returns updateServiceServiceUpdateService.Id, and is useful for accessing the field via an interface.
-func (v *updateServiceServiceUpdateService) GetId() string { return v.Service.Id }
+// GetHealthcheckPath returns getServiceInstanceForResourceServiceInstance.HealthcheckPath, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetHealthcheckPath() *string {
+	return v.HealthcheckPath
+}
 
-// GetName returns updateServiceServiceUpdateService.Name, and is useful for accessing the field via an interface.
-func (v *updateServiceServiceUpdateService) GetName() string { return v.Service.Name }
+// GetHealthcheckTimeout returns getServiceInstanceForResourceServiceInstance.HealthcheckTimeout, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetHealthcheckTimeout() *int {
+	return v.HealthcheckTimeout
+}
 
-// GetProjectId returns updateServiceServiceUpdateService.ProjectId, and is useful for accessing the field via an interface.
-func (v *updateServiceServiceUpdateService) GetProjectId() string { return v.Service.ProjectId }
+// GetRestartPolicyType returns getServiceInstanceForResourceServiceInstance.RestartPolicyType, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetRestartPolicyType() RestartPolicyType {
+	return v.RestartPolicyType
+}
 
-func (v *updateServiceServiceUpdateService) UnmarshalJSON(b []byte) error {
+// GetRestartPolicyMaxRetries returns getServiceInstanceForResourceServiceInstance.RestartPolicyMaxRetries, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetRestartPolicyMaxRetries() int {
+	return v.RestartPolicyMaxRetries
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetSleepApplication returns getServiceInstanceForResourceServiceInstance.SleepApplication, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetSleepApplication() *bool {
+	return v.SleepApplication
+}
 
-	var firstPass struct {
-		*updateServiceServiceUpdateService
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.updateServiceServiceUpdateService = v
+// GetRegion returns getServiceInstanceForResourceServiceInstance.Region, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetRegion() *string { return v.Region }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetNumReplicas returns getServiceInstanceForResourceServiceInstance.NumReplicas, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstance) GetNumReplicas() *int { return v.NumReplicas }
 
-	err = json.Unmarshal(
-		b, &v.Service)
-	if err != nil {
-		return err
-	}
-	return nil
+// getServiceInstanceForResourceServiceInstanceSourceServiceSource includes the requested fields of the GraphQL type ServiceSource.
+type getServiceInstanceForResourceServiceInstanceSourceServiceSource struct {
+	Image *string `json:"image"`
+	Repo  *string `json:"repo"`
 }
 
-type __premarshalupdateServiceServiceUpdateService struct {
-	Id string `json:"id"`
-
-	Name string `json:"name"`
-
-	ProjectId string `json:"projectId"`
+// GetImage returns getServiceInstanceForResourceServiceInstanceSourceServiceSource.Image, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstanceSourceServiceSource) GetImage() *string {
+	return v.Image
 }
 
-func (v *updateServiceServiceUpdateService) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetRepo returns getServiceInstanceForResourceServiceInstanceSourceServiceSource.Repo, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceForResourceServiceInstanceSourceServiceSource) GetRepo() *string {
+	return v.Repo
 }
 
-func (v *updateServiceServiceUpdateService) __premarshalJSON() (*__premarshalupdateServiceServiceUpdateService, error) {
-	var retval __premarshalupdateServiceServiceUpdateService
+// getServiceInstanceLimitOverrideResponse is returned by getServiceInstanceLimitOverride on success.
+type getServiceInstanceLimitOverrideResponse struct {
+	// Get the service instance resource limit overrides (null if no overrides set)
+	ServiceInstanceLimitOverride map[string]interface{} `json:"serviceInstanceLimitOverride"`
+}
 
-	retval.Id = v.Service.Id
-	retval.Name = v.Service.Name
-	retval.ProjectId = v.Service.ProjectId
-	return &retval, nil
+// GetServiceInstanceLimitOverride returns getServiceInstanceLimitOverrideResponse.ServiceInstanceLimitOverride, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceLimitOverrideResponse) GetServiceInstanceLimitOverride() map[string]interface{} {
+	return v.ServiceInstanceLimitOverride
 }
 
-// updateVolumeInstanceResponse is returned by updateVolumeInstance on success.
-type updateVolumeInstanceResponse struct {
-	// Update a volume instance. If no environmentId is provided, all volume instances for the volume will be updated.
-	VolumeInstanceUpdate bool `json:"volumeInstanceUpdate"`
+// getServiceInstanceResponse is returned by getServiceInstance on success.
+type getServiceInstanceResponse struct {
+	// Get a service instance belonging to a service and environment
+	ServiceInstance getServiceInstanceServiceInstance `json:"serviceInstance"`
 }
 
-// GetVolumeInstanceUpdate returns updateVolumeInstanceResponse.VolumeInstanceUpdate, and is useful for accessing the field via an interface.
-func (v *updateVolumeInstanceResponse) GetVolumeInstanceUpdate() bool { return v.VolumeInstanceUpdate }
+// GetServiceInstance returns getServiceInstanceResponse.ServiceInstance, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceResponse) GetServiceInstance() getServiceInstanceServiceInstance {
+	return v.ServiceInstance
+}
 
-// updateVolumeResponse is returned by updateVolume on success.
-type updateVolumeResponse struct {
-	// Update a persistent volume in a project
-	VolumeUpdate updateVolumeVolumeUpdateVolume `json:"volumeUpdate"`
+// getServiceInstanceServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
+type getServiceInstanceServiceInstance struct {
+	Source            *getServiceInstanceServiceInstanceSourceServiceSource `json:"source"`
+	RootDirectory     *string                                               `json:"rootDirectory"`
+	RailwayConfigFile *string                                               `json:"railwayConfigFile"`
+	CronSchedule      *string                                               `json:"cronSchedule"`
+	LatestDeployment  getServiceInstanceServiceInstanceLatestDeployment     `json:"latestDeployment"`
 }
 
-// GetVolumeUpdate returns updateVolumeResponse.VolumeUpdate, and is useful for accessing the field via an interface.
-func (v *updateVolumeResponse) GetVolumeUpdate() updateVolumeVolumeUpdateVolume {
-	return v.VolumeUpdate
+// GetSource returns getServiceInstanceServiceInstance.Source, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstance) GetSource() *getServiceInstanceServiceInstanceSourceServiceSource {
+	return v.Source
 }
 
-// updateVolumeVolumeUpdateVolume includes the requested fields of the GraphQL type Volume.
-type updateVolumeVolumeUpdateVolume struct {
-	Volume `json:"-"`
+// GetRootDirectory returns getServiceInstanceServiceInstance.RootDirectory, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstance) GetRootDirectory() *string { return v.RootDirectory }
+
+// GetRailwayConfigFile returns getServiceInstanceServiceInstance.RailwayConfigFile, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstance) GetRailwayConfigFile() *string {
+	return v.RailwayConfigFile
 }
 
-// GetId returns updateVolumeVolumeUpdateVolume.Id, and is useful for accessing the field via an interface.
-func (v *updateVolumeVolumeUpdateVolume) GetId() string { return v.Volume.Id }
+// GetCronSchedule returns getServiceInstanceServiceInstance.CronSchedule, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstance) GetCronSchedule() *string { return v.CronSchedule }
 
-// GetName returns updateVolumeVolumeUpdateVolume.Name, and is useful for accessing the field via an interface.
-func (v *updateVolumeVolumeUpdateVolume) GetName() string { return v.Volume.Name }
+// GetLatestDeployment returns getServiceInstanceServiceInstance.LatestDeployment, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstance) GetLatestDeployment() getServiceInstanceServiceInstanceLatestDeployment {
+	return v.LatestDeployment
+}
 
-// GetVolumeInstances returns updateVolumeVolumeUpdateVolume.VolumeInstances, and is useful for accessing the field via an interface.
-func (v *updateVolumeVolumeUpdateVolume) GetVolumeInstances() VolumeVolumeInstancesVolumeVolumeInstancesConnection {
-	return v.Volume.VolumeInstances
+// getServiceInstanceServiceInstanceLatestDeployment includes the requested fields of the GraphQL type Deployment.
+type getServiceInstanceServiceInstanceLatestDeployment struct {
+	Meta map[string]interface{} `json:"meta"`
 }
 
-func (v *updateVolumeVolumeUpdateVolume) UnmarshalJSON(b []byte) error {
+// GetMeta returns getServiceInstanceServiceInstanceLatestDeployment.Meta, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstanceLatestDeployment) GetMeta() map[string]interface{} {
+	return v.Meta
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// getServiceInstanceServiceInstanceSourceServiceSource includes the requested fields of the GraphQL type ServiceSource.
+type getServiceInstanceServiceInstanceSourceServiceSource struct {
+	Image *string `json:"image"`
+	Repo  *string `json:"repo"`
+}
 
-	var firstPass struct {
-		*updateVolumeVolumeUpdateVolume
+// GetImage returns getServiceInstanceServiceInstanceSourceServiceSource.Image, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstanceSourceServiceSource) GetImage() *string { return v.Image }
+
+// GetRepo returns getServiceInstanceServiceInstanceSourceServiceSource.Repo, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstanceSourceServiceSource) GetRepo() *string { return v.Repo }
+
+// getServiceInstancesResponse is returned by getServiceInstances on success.
+type getServiceInstancesResponse struct {
+	// Get a service by ID
+	Service getServiceInstancesService `json:"service"`
+}
+
+// GetService returns getServiceInstancesResponse.Service, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesResponse) GetService() getServiceInstancesService { return v.Service }
+
+// getServiceInstancesService includes the requested fields of the GraphQL type Service.
+type getServiceInstancesService struct {
+	ServiceInstances getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection `json:"serviceInstances"`
+}
+
+// GetServiceInstances returns getServiceInstancesService.ServiceInstances, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesService) GetServiceInstances() getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection {
+	return v.ServiceInstances
+}
+
+// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection includes the requested fields of the GraphQL type ServiceServiceInstancesConnection.
+type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection struct {
+	Edges []getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection) GetEdges() []getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge {
+	return v.Edges
+}
+
+// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge includes the requested fields of the GraphQL type ServiceServiceInstancesConnectionEdge.
+type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge struct {
+	Node getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance `json:"node"`
+}
+
+// GetNode returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge) GetNode() getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance {
+	return v.Node
+}
+
+// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
+type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance struct {
+	Id            string `json:"id"`
+	EnvironmentId string `json:"environmentId"`
+}
+
+// GetId returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance.Id, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance) GetId() string {
+	return v.Id
+}
+
+// GetEnvironmentId returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance) GetEnvironmentId() string {
+	return v.EnvironmentId
+}
+
+// getServiceMetricsMetricsMetricsResult includes the requested fields of the GraphQL type MetricsResult.
+// The GraphQL type's documentation follows.
+//
+// The result of a metrics query.
+type getServiceMetricsMetricsMetricsResult struct {
+	// The measurement of the metric.
+	Measurement MetricMeasurement `json:"measurement"`
+	// The samples of the metric.
+	Values []getServiceMetricsMetricsMetricsResultValuesMetric `json:"values"`
+}
+
+// GetMeasurement returns getServiceMetricsMetricsMetricsResult.Measurement, and is useful for accessing the field via an interface.
+func (v *getServiceMetricsMetricsMetricsResult) GetMeasurement() MetricMeasurement {
+	return v.Measurement
+}
+
+// GetValues returns getServiceMetricsMetricsMetricsResult.Values, and is useful for accessing the field via an interface.
+func (v *getServiceMetricsMetricsMetricsResult) GetValues() []getServiceMetricsMetricsMetricsResultValuesMetric {
+	return v.Values
+}
+
+// getServiceMetricsMetricsMetricsResultValuesMetric includes the requested fields of the GraphQL type Metric.
+// The GraphQL type's documentation follows.
+//
+// A single sample of a metric.
+type getServiceMetricsMetricsMetricsResultValuesMetric struct {
+	// The timestamp of the sample. Represented has number of seconds since the Unix epoch.
+	Ts int `json:"ts"`
+	// The value of the sample.
+	Value float64 `json:"value"`
+}
+
+// GetTs returns getServiceMetricsMetricsMetricsResultValuesMetric.Ts, and is useful for accessing the field via an interface.
+func (v *getServiceMetricsMetricsMetricsResultValuesMetric) GetTs() int { return v.Ts }
+
+// GetValue returns getServiceMetricsMetricsMetricsResultValuesMetric.Value, and is useful for accessing the field via an interface.
+func (v *getServiceMetricsMetricsMetricsResultValuesMetric) GetValue() float64 { return v.Value }
+
+// getServiceMetricsResponse is returned by getServiceMetrics on success.
+type getServiceMetricsResponse struct {
+	// Get metrics for a project, environment, and service
+	Metrics []getServiceMetricsMetricsMetricsResult `json:"metrics"`
+}
+
+// GetMetrics returns getServiceMetricsResponse.Metrics, and is useful for accessing the field via an interface.
+func (v *getServiceMetricsResponse) GetMetrics() []getServiceMetricsMetricsMetricsResult {
+	return v.Metrics
+}
+
+// getServicePlanLimitsResponse is returned by getServicePlanLimits on success.
+type getServicePlanLimitsResponse struct {
+	// Get a service by ID
+	Service getServicePlanLimitsService `json:"service"`
+}
+
+// GetService returns getServicePlanLimitsResponse.Service, and is useful for accessing the field via an interface.
+func (v *getServicePlanLimitsResponse) GetService() getServicePlanLimitsService { return v.Service }
+
+// getServicePlanLimitsService includes the requested fields of the GraphQL type Service.
+type getServicePlanLimitsService struct {
+	Project getServicePlanLimitsServiceProject `json:"project"`
+}
+
+// GetProject returns getServicePlanLimitsService.Project, and is useful for accessing the field via an interface.
+func (v *getServicePlanLimitsService) GetProject() getServicePlanLimitsServiceProject {
+	return v.Project
+}
+
+// getServicePlanLimitsServiceProject includes the requested fields of the GraphQL type Project.
+type getServicePlanLimitsServiceProject struct {
+	PlanLimits `json:"-"`
+}
+
+// GetId returns getServicePlanLimitsServiceProject.Id, and is useful for accessing the field via an interface.
+func (v *getServicePlanLimitsServiceProject) GetId() string { return v.PlanLimits.Id }
+
+// GetSubscriptionType returns getServicePlanLimitsServiceProject.SubscriptionType, and is useful for accessing the field via an interface.
+func (v *getServicePlanLimitsServiceProject) GetSubscriptionType() SubscriptionPlanType {
+	return v.PlanLimits.SubscriptionType
+}
+
+// GetSubscriptionPlanLimit returns getServicePlanLimitsServiceProject.SubscriptionPlanLimit, and is useful for accessing the field via an interface.
+func (v *getServicePlanLimitsServiceProject) GetSubscriptionPlanLimit() map[string]interface{} {
+	return v.PlanLimits.SubscriptionPlanLimit
+}
+
+func (v *getServicePlanLimitsServiceProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getServicePlanLimitsServiceProject
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.updateVolumeVolumeUpdateVolume = v
+	firstPass.getServicePlanLimitsServiceProject = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3747,86 +6265,4969 @@ func (v *updateVolumeVolumeUpdateVolume) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Volume)
+		b, &v.PlanLimits)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetServicePlanLimitsServiceProject struct {
+	Id string `json:"id"`
+
+	SubscriptionType SubscriptionPlanType `json:"subscriptionType"`
+
+	SubscriptionPlanLimit map[string]interface{} `json:"subscriptionPlanLimit"`
+}
+
+func (v *getServicePlanLimitsServiceProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getServicePlanLimitsServiceProject) __premarshalJSON() (*__premarshalgetServicePlanLimitsServiceProject, error) {
+	var retval __premarshalgetServicePlanLimitsServiceProject
+
+	retval.Id = v.PlanLimits.Id
+	retval.SubscriptionType = v.PlanLimits.SubscriptionType
+	retval.SubscriptionPlanLimit = v.PlanLimits.SubscriptionPlanLimit
+	return &retval, nil
+}
+
+// getServiceRepoTriggersResponse is returned by getServiceRepoTriggers on success.
+type getServiceRepoTriggersResponse struct {
+	// Get a service by ID
+	Service getServiceRepoTriggersService `json:"service"`
+}
+
+// GetService returns getServiceRepoTriggersResponse.Service, and is useful for accessing the field via an interface.
+func (v *getServiceRepoTriggersResponse) GetService() getServiceRepoTriggersService { return v.Service }
+
+// getServiceRepoTriggersService includes the requested fields of the GraphQL type Service.
+type getServiceRepoTriggersService struct {
+	Id           string                                                                 `json:"id"`
+	RepoTriggers getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnection `json:"repoTriggers"`
+}
+
+// GetId returns getServiceRepoTriggersService.Id, and is useful for accessing the field via an interface.
+func (v *getServiceRepoTriggersService) GetId() string { return v.Id }
+
+// GetRepoTriggers returns getServiceRepoTriggersService.RepoTriggers, and is useful for accessing the field via an interface.
+func (v *getServiceRepoTriggersService) GetRepoTriggers() getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnection {
+	return v.RepoTriggers
+}
+
+// getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnection includes the requested fields of the GraphQL type ServiceRepoTriggersConnection.
+type getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnection struct {
+	Edges []getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnection) GetEdges() []getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdge {
+	return v.Edges
+}
+
+// getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdge includes the requested fields of the GraphQL type ServiceRepoTriggersConnectionEdge.
+type getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdge struct {
+	Node getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdgeNodeDeploymentTrigger `json:"node"`
+}
+
+// GetNode returns getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdge) GetNode() getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdgeNodeDeploymentTrigger {
+	return v.Node
+}
+
+// getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdgeNodeDeploymentTrigger includes the requested fields of the GraphQL type DeploymentTrigger.
+type getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdgeNodeDeploymentTrigger struct {
+	Repository string `json:"repository"`
+	Branch     string `json:"branch"`
+}
+
+// GetRepository returns getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdgeNodeDeploymentTrigger.Repository, and is useful for accessing the field via an interface.
+func (v *getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdgeNodeDeploymentTrigger) GetRepository() string {
+	return v.Repository
+}
+
+// GetBranch returns getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdgeNodeDeploymentTrigger.Branch, and is useful for accessing the field via an interface.
+func (v *getServiceRepoTriggersServiceRepoTriggersServiceRepoTriggersConnectionEdgesServiceRepoTriggersConnectionEdgeNodeDeploymentTrigger) GetBranch() string {
+	return v.Branch
+}
+
+// getServiceResponse is returned by getService on success.
+type getServiceResponse struct {
+	// Get a service by ID
+	Service getServiceService `json:"service"`
+}
+
+// GetService returns getServiceResponse.Service, and is useful for accessing the field via an interface.
+func (v *getServiceResponse) GetService() getServiceService { return v.Service }
+
+// getServiceService includes the requested fields of the GraphQL type Service.
+type getServiceService struct {
+	Service   `json:"-"`
+	Icon      *string   `json:"icon"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetIcon returns getServiceService.Icon, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetIcon() *string { return v.Icon }
+
+// GetCreatedAt returns getServiceService.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetId returns getServiceService.Id, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetId() string { return v.Service.Id }
+
+// GetName returns getServiceService.Name, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetName() string { return v.Service.Name }
+
+// GetProjectId returns getServiceService.ProjectId, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetProjectId() string { return v.Service.ProjectId }
+
+func (v *getServiceService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getServiceService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getServiceService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Service)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalupdateVolumeVolumeUpdateVolume struct {
-	Id string `json:"id"`
+type __premarshalgetServiceService struct {
+	Icon *string `json:"icon"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	ProjectId string `json:"projectId"`
+}
+
+func (v *getServiceService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getServiceService) __premarshalJSON() (*__premarshalgetServiceService, error) {
+	var retval __premarshalgetServiceService
+
+	retval.Icon = v.Icon
+	retval.CreatedAt = v.CreatedAt
+	retval.Id = v.Service.Id
+	retval.Name = v.Service.Name
+	retval.ProjectId = v.Service.ProjectId
+	return &retval, nil
+}
+
+// getServiceUsageResponse is returned by getServiceUsage on success.
+type getServiceUsageResponse struct {
+	// Get the usage for a single project or all projects for a user/workspace. If no
+	// `projectId` or `workspaceId` is provided, the usage for the current user is
+	// returned. If no `startDate` is provided, the usage for the current billing
+	// period of the project owner is returned.
+	Usage []getServiceUsageUsageAggregatedUsage `json:"usage"`
+}
+
+// GetUsage returns getServiceUsageResponse.Usage, and is useful for accessing the field via an interface.
+func (v *getServiceUsageResponse) GetUsage() []getServiceUsageUsageAggregatedUsage { return v.Usage }
+
+// getServiceUsageUsageAggregatedUsage includes the requested fields of the GraphQL type AggregatedUsage.
+// The GraphQL type's documentation follows.
+//
+// The aggregated usage of a single measurement.
+type getServiceUsageUsageAggregatedUsage struct {
+	// The measurement that was aggregated.
+	Measurement MetricMeasurement `json:"measurement"`
+	// The tags that were used to group the metric. Only the tags that were used in the `groupBy` will be present.
+	Tags getServiceUsageUsageAggregatedUsageTagsMetricTags `json:"tags"`
+	// The aggregated value.
+	Value float64 `json:"value"`
+}
+
+// GetMeasurement returns getServiceUsageUsageAggregatedUsage.Measurement, and is useful for accessing the field via an interface.
+func (v *getServiceUsageUsageAggregatedUsage) GetMeasurement() MetricMeasurement {
+	return v.Measurement
+}
+
+// GetTags returns getServiceUsageUsageAggregatedUsage.Tags, and is useful for accessing the field via an interface.
+func (v *getServiceUsageUsageAggregatedUsage) GetTags() getServiceUsageUsageAggregatedUsageTagsMetricTags {
+	return v.Tags
+}
+
+// GetValue returns getServiceUsageUsageAggregatedUsage.Value, and is useful for accessing the field via an interface.
+func (v *getServiceUsageUsageAggregatedUsage) GetValue() float64 { return v.Value }
+
+// getServiceUsageUsageAggregatedUsageTagsMetricTags includes the requested fields of the GraphQL type MetricTags.
+// The GraphQL type's documentation follows.
+//
+// The tags that were used to group the metric.
+type getServiceUsageUsageAggregatedUsageTagsMetricTags struct {
+	ProjectId *string `json:"projectId"`
+	ServiceId *string `json:"serviceId"`
+}
+
+// GetProjectId returns getServiceUsageUsageAggregatedUsageTagsMetricTags.ProjectId, and is useful for accessing the field via an interface.
+func (v *getServiceUsageUsageAggregatedUsageTagsMetricTags) GetProjectId() *string {
+	return v.ProjectId
+}
+
+// GetServiceId returns getServiceUsageUsageAggregatedUsageTagsMetricTags.ServiceId, and is useful for accessing the field via an interface.
+func (v *getServiceUsageUsageAggregatedUsageTagsMetricTags) GetServiceId() *string {
+	return v.ServiceId
+}
+
+// getSharedVariablesResponse is returned by getSharedVariables on success.
+type getSharedVariablesResponse struct {
+	// All variables by pluginId or serviceId. If neither are provided, all shared variables are returned.
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// GetVariables returns getSharedVariablesResponse.Variables, and is useful for accessing the field via an interface.
+func (v *getSharedVariablesResponse) GetVariables() map[string]interface{} { return v.Variables }
+
+// getTcpProxyResponse is returned by getTcpProxy on success.
+type getTcpProxyResponse struct {
+	// All TCP proxies for a service instance
+	TcpProxies []getTcpProxyTcpProxiesTCPProxy `json:"tcpProxies"`
+}
+
+// GetTcpProxies returns getTcpProxyResponse.TcpProxies, and is useful for accessing the field via an interface.
+func (v *getTcpProxyResponse) GetTcpProxies() []getTcpProxyTcpProxiesTCPProxy { return v.TcpProxies }
+
+// getTcpProxyTcpProxiesTCPProxy includes the requested fields of the GraphQL type TCPProxy.
+type getTcpProxyTcpProxiesTCPProxy struct {
+	TCPProxy `json:"-"`
+}
+
+// GetId returns getTcpProxyTcpProxiesTCPProxy.Id, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetId() string { return v.TCPProxy.Id }
+
+// GetApplicationPort returns getTcpProxyTcpProxiesTCPProxy.ApplicationPort, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetApplicationPort() int { return v.TCPProxy.ApplicationPort }
+
+// GetProxyPort returns getTcpProxyTcpProxiesTCPProxy.ProxyPort, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetProxyPort() int { return v.TCPProxy.ProxyPort }
+
+// GetDomain returns getTcpProxyTcpProxiesTCPProxy.Domain, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetDomain() string { return v.TCPProxy.Domain }
+
+// GetEnvironmentId returns getTcpProxyTcpProxiesTCPProxy.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetEnvironmentId() string { return v.TCPProxy.EnvironmentId }
+
+// GetServiceId returns getTcpProxyTcpProxiesTCPProxy.ServiceId, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetServiceId() string { return v.TCPProxy.ServiceId }
+
+func (v *getTcpProxyTcpProxiesTCPProxy) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getTcpProxyTcpProxiesTCPProxy
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getTcpProxyTcpProxiesTCPProxy = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TCPProxy)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetTcpProxyTcpProxiesTCPProxy struct {
+	Id string `json:"id"`
+
+	ApplicationPort int `json:"applicationPort"`
+
+	ProxyPort int `json:"proxyPort"`
+
+	Domain string `json:"domain"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
+}
+
+func (v *getTcpProxyTcpProxiesTCPProxy) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getTcpProxyTcpProxiesTCPProxy) __premarshalJSON() (*__premarshalgetTcpProxyTcpProxiesTCPProxy, error) {
+	var retval __premarshalgetTcpProxyTcpProxiesTCPProxy
+
+	retval.Id = v.TCPProxy.Id
+	retval.ApplicationPort = v.TCPProxy.ApplicationPort
+	retval.ProxyPort = v.TCPProxy.ProxyPort
+	retval.Domain = v.TCPProxy.Domain
+	retval.EnvironmentId = v.TCPProxy.EnvironmentId
+	retval.ServiceId = v.TCPProxy.ServiceId
+	return &retval, nil
+}
+
+// getTemplateResponse is returned by getTemplate on success.
+type getTemplateResponse struct {
+	// Get a template by code or ID or GitHub owner and repo.
+	Template getTemplateTemplate `json:"template"`
+}
+
+// GetTemplate returns getTemplateResponse.Template, and is useful for accessing the field via an interface.
+func (v *getTemplateResponse) GetTemplate() getTemplateTemplate { return v.Template }
+
+// getTemplateTemplate includes the requested fields of the GraphQL type Template.
+type getTemplateTemplate struct {
+	Id               string                 `json:"id"`
+	SerializedConfig map[string]interface{} `json:"serializedConfig"`
+}
+
+// GetId returns getTemplateTemplate.Id, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetId() string { return v.Id }
+
+// GetSerializedConfig returns getTemplateTemplate.SerializedConfig, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetSerializedConfig() map[string]interface{} { return v.SerializedConfig }
+
+// getUserWorkspacesMeUser includes the requested fields of the GraphQL type User.
+type getUserWorkspacesMeUser struct {
+	// Workspaces user is member of
+	Workspaces []getUserWorkspacesMeUserWorkspacesWorkspace `json:"workspaces"`
+}
+
+// GetWorkspaces returns getUserWorkspacesMeUser.Workspaces, and is useful for accessing the field via an interface.
+func (v *getUserWorkspacesMeUser) GetWorkspaces() []getUserWorkspacesMeUserWorkspacesWorkspace {
+	return v.Workspaces
+}
+
+// getUserWorkspacesMeUserWorkspacesWorkspace includes the requested fields of the GraphQL type Workspace.
+type getUserWorkspacesMeUserWorkspacesWorkspace struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getUserWorkspacesMeUserWorkspacesWorkspace.Id, and is useful for accessing the field via an interface.
+func (v *getUserWorkspacesMeUserWorkspacesWorkspace) GetId() string { return v.Id }
+
+// getUserWorkspacesResponse is returned by getUserWorkspaces on success.
+type getUserWorkspacesResponse struct {
+	// Gets the authenticated user.
+	Me getUserWorkspacesMeUser `json:"me"`
+}
+
+// GetMe returns getUserWorkspacesResponse.Me, and is useful for accessing the field via an interface.
+func (v *getUserWorkspacesResponse) GetMe() getUserWorkspacesMeUser { return v.Me }
+
+// getVariablesResponse is returned by getVariables on success.
+type getVariablesResponse struct {
+	// All variables by pluginId or serviceId. If neither are provided, all shared variables are returned.
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// GetVariables returns getVariablesResponse.Variables, and is useful for accessing the field via an interface.
+func (v *getVariablesResponse) GetVariables() map[string]interface{} { return v.Variables }
+
+// getVolumeInstanceStateResponse is returned by getVolumeInstanceState on success.
+type getVolumeInstanceStateResponse struct {
+	// Get a single volume instance by id
+	VolumeInstance getVolumeInstanceStateVolumeInstance `json:"volumeInstance"`
+}
+
+// GetVolumeInstance returns getVolumeInstanceStateResponse.VolumeInstance, and is useful for accessing the field via an interface.
+func (v *getVolumeInstanceStateResponse) GetVolumeInstance() getVolumeInstanceStateVolumeInstance {
+	return v.VolumeInstance
+}
+
+// getVolumeInstanceStateVolumeInstance includes the requested fields of the GraphQL type VolumeInstance.
+type getVolumeInstanceStateVolumeInstance struct {
+	Id    string       `json:"id"`
+	State *VolumeState `json:"state"`
+}
+
+// GetId returns getVolumeInstanceStateVolumeInstance.Id, and is useful for accessing the field via an interface.
+func (v *getVolumeInstanceStateVolumeInstance) GetId() string { return v.Id }
+
+// GetState returns getVolumeInstanceStateVolumeInstance.State, and is useful for accessing the field via an interface.
+func (v *getVolumeInstanceStateVolumeInstance) GetState() *VolumeState { return v.State }
+
+// getVolumeInstancesProject includes the requested fields of the GraphQL type Project.
+type getVolumeInstancesProject struct {
+	Volumes getVolumeInstancesProjectVolumesProjectVolumesConnection `json:"volumes"`
+}
+
+// GetVolumes returns getVolumeInstancesProject.Volumes, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProject) GetVolumes() getVolumeInstancesProjectVolumesProjectVolumesConnection {
+	return v.Volumes
+}
+
+// getVolumeInstancesProjectVolumesProjectVolumesConnection includes the requested fields of the GraphQL type ProjectVolumesConnection.
+type getVolumeInstancesProjectVolumesProjectVolumesConnection struct {
+	Edges []getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns getVolumeInstancesProjectVolumesProjectVolumesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnection) GetEdges() []getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge {
+	return v.Edges
+}
+
+// getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge includes the requested fields of the GraphQL type ProjectVolumesConnectionEdge.
+type getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge struct {
+	Node getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume `json:"node"`
+}
+
+// GetNode returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge) GetNode() getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume {
+	return v.Node
+}
+
+// getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume includes the requested fields of the GraphQL type Volume.
+type getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume struct {
+	Volume `json:"-"`
+}
+
+// GetId returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.Id, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetId() string {
+	return v.Volume.Id
+}
+
+// GetName returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.Name, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetName() string {
+	return v.Volume.Name
+}
+
+// GetVolumeInstances returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.VolumeInstances, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetVolumeInstances() VolumeVolumeInstancesVolumeVolumeInstancesConnection {
+	return v.Volume.VolumeInstances
+}
+
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Volume)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	VolumeInstances VolumeVolumeInstancesVolumeVolumeInstancesConnection `json:"volumeInstances"`
+}
+
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) __premarshalJSON() (*__premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume, error) {
+	var retval __premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume
+
+	retval.Id = v.Volume.Id
+	retval.Name = v.Volume.Name
+	retval.VolumeInstances = v.Volume.VolumeInstances
+	return &retval, nil
+}
+
+// getVolumeInstancesResponse is returned by getVolumeInstances on success.
+type getVolumeInstancesResponse struct {
+	// Get a project by ID
+	Project getVolumeInstancesProject `json:"project"`
+}
+
+// GetProject returns getVolumeInstancesResponse.Project, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesResponse) GetProject() getVolumeInstancesProject { return v.Project }
+
+// getWorkflowStatusResponse is returned by getWorkflowStatus on success.
+type getWorkflowStatusResponse struct {
+	// Gets the status of a workflow
+	WorkflowStatus getWorkflowStatusWorkflowStatusWorkflowResult `json:"workflowStatus"`
+}
+
+// GetWorkflowStatus returns getWorkflowStatusResponse.WorkflowStatus, and is useful for accessing the field via an interface.
+func (v *getWorkflowStatusResponse) GetWorkflowStatus() getWorkflowStatusWorkflowStatusWorkflowResult {
+	return v.WorkflowStatus
+}
+
+// getWorkflowStatusWorkflowStatusWorkflowResult includes the requested fields of the GraphQL type WorkflowResult.
+type getWorkflowStatusWorkflowStatusWorkflowResult struct {
+	Status WorkflowStatus `json:"status"`
+	Error  *string        `json:"error"`
+}
+
+// GetStatus returns getWorkflowStatusWorkflowStatusWorkflowResult.Status, and is useful for accessing the field via an interface.
+func (v *getWorkflowStatusWorkflowStatusWorkflowResult) GetStatus() WorkflowStatus { return v.Status }
+
+// GetError returns getWorkflowStatusWorkflowStatusWorkflowResult.Error, and is useful for accessing the field via an interface.
+func (v *getWorkflowStatusWorkflowStatusWorkflowResult) GetError() *string { return v.Error }
+
+// getWorkspaceUsageLimitResponse is returned by getWorkspaceUsageLimit on success.
+type getWorkspaceUsageLimitResponse struct {
+	// Get the workspace
+	Workspace getWorkspaceUsageLimitWorkspace `json:"workspace"`
+}
+
+// GetWorkspace returns getWorkspaceUsageLimitResponse.Workspace, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitResponse) GetWorkspace() getWorkspaceUsageLimitWorkspace {
+	return v.Workspace
+}
+
+// getWorkspaceUsageLimitWorkspace includes the requested fields of the GraphQL type Workspace.
+type getWorkspaceUsageLimitWorkspace struct {
+	Customer getWorkspaceUsageLimitWorkspaceCustomer `json:"customer"`
+}
+
+// GetCustomer returns getWorkspaceUsageLimitWorkspace.Customer, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitWorkspace) GetCustomer() getWorkspaceUsageLimitWorkspaceCustomer {
+	return v.Customer
+}
+
+// getWorkspaceUsageLimitWorkspaceCustomer includes the requested fields of the GraphQL type Customer.
+type getWorkspaceUsageLimitWorkspaceCustomer struct {
+	Id         string                                             `json:"id"`
+	UsageLimit *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit `json:"usageLimit"`
+}
+
+// GetId returns getWorkspaceUsageLimitWorkspaceCustomer.Id, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitWorkspaceCustomer) GetId() string { return v.Id }
+
+// GetUsageLimit returns getWorkspaceUsageLimitWorkspaceCustomer.UsageLimit, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitWorkspaceCustomer) GetUsageLimit() *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit {
+	return v.UsageLimit
+}
+
+// getWorkspaceUsageLimitWorkspaceCustomerUsageLimit includes the requested fields of the GraphQL type UsageLimit.
+type getWorkspaceUsageLimitWorkspaceCustomerUsageLimit struct {
+	UsageLimit `json:"-"`
+}
+
+// GetId returns getWorkspaceUsageLimitWorkspaceCustomerUsageLimit.Id, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit) GetId() string { return v.UsageLimit.Id }
+
+// GetHardLimit returns getWorkspaceUsageLimitWorkspaceCustomerUsageLimit.HardLimit, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit) GetHardLimit() *int {
+	return v.UsageLimit.HardLimit
+}
+
+// GetSoftLimit returns getWorkspaceUsageLimitWorkspaceCustomerUsageLimit.SoftLimit, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit) GetSoftLimit() int {
+	return v.UsageLimit.SoftLimit
+}
+
+func (v *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getWorkspaceUsageLimitWorkspaceCustomerUsageLimit
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getWorkspaceUsageLimitWorkspaceCustomerUsageLimit = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.UsageLimit)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetWorkspaceUsageLimitWorkspaceCustomerUsageLimit struct {
+	Id string `json:"id"`
+
+	HardLimit *int `json:"hardLimit"`
+
+	SoftLimit int `json:"softLimit"`
+}
+
+func (v *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit) __premarshalJSON() (*__premarshalgetWorkspaceUsageLimitWorkspaceCustomerUsageLimit, error) {
+	var retval __premarshalgetWorkspaceUsageLimitWorkspaceCustomerUsageLimit
+
+	retval.Id = v.UsageLimit.Id
+	retval.HardLimit = v.UsageLimit.HardLimit
+	retval.SoftLimit = v.UsageLimit.SoftLimit
+	return &retval, nil
+}
+
+// listCustomDomainRecordsDomainsAllDomains includes the requested fields of the GraphQL type AllDomains.
+type listCustomDomainRecordsDomainsAllDomains struct {
+	CustomDomains []listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain `json:"customDomains"`
+}
+
+// GetCustomDomains returns listCustomDomainRecordsDomainsAllDomains.CustomDomains, and is useful for accessing the field via an interface.
+func (v *listCustomDomainRecordsDomainsAllDomains) GetCustomDomains() []listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain {
+	return v.CustomDomains
+}
+
+// listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain includes the requested fields of the GraphQL type CustomDomain.
+type listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain struct {
+	CustomDomainRecords `json:"-"`
+}
+
+// GetId returns listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain.Id, and is useful for accessing the field via an interface.
+func (v *listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain) GetId() string {
+	return v.CustomDomainRecords.Id
+}
+
+// GetDomain returns listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain.Domain, and is useful for accessing the field via an interface.
+func (v *listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain) GetDomain() string {
+	return v.CustomDomainRecords.Domain
+}
+
+// GetEnvironmentId returns listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain) GetEnvironmentId() string {
+	return v.CustomDomainRecords.EnvironmentId
+}
+
+// GetServiceId returns listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain.ServiceId, and is useful for accessing the field via an interface.
+func (v *listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain) GetServiceId() string {
+	return v.CustomDomainRecords.ServiceId
+}
+
+// GetProjectId returns listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain.ProjectId, and is useful for accessing the field via an interface.
+func (v *listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain) GetProjectId() string {
+	return v.CustomDomainRecords.ProjectId
+}
+
+// GetStatus returns listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain.Status, and is useful for accessing the field via an interface.
+func (v *listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain) GetStatus() CustomDomainRecordsStatusCustomDomainStatus {
+	return v.CustomDomainRecords.Status
+}
+
+func (v *listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomDomainRecords)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain struct {
+	Id string `json:"id"`
+
+	Domain string `json:"domain"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
+
+	ProjectId string `json:"projectId"`
+
+	Status CustomDomainRecordsStatusCustomDomainStatus `json:"status"`
+}
+
+func (v *listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain) __premarshalJSON() (*__premarshallistCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain, error) {
+	var retval __premarshallistCustomDomainRecordsDomainsAllDomainsCustomDomainsCustomDomain
+
+	retval.Id = v.CustomDomainRecords.Id
+	retval.Domain = v.CustomDomainRecords.Domain
+	retval.EnvironmentId = v.CustomDomainRecords.EnvironmentId
+	retval.ServiceId = v.CustomDomainRecords.ServiceId
+	retval.ProjectId = v.CustomDomainRecords.ProjectId
+	retval.Status = v.CustomDomainRecords.Status
+	return &retval, nil
+}
+
+// listCustomDomainRecordsResponse is returned by listCustomDomainRecords on success.
+type listCustomDomainRecordsResponse struct {
+	// All domains for a service instance
+	Domains listCustomDomainRecordsDomainsAllDomains `json:"domains"`
+}
+
+// GetDomains returns listCustomDomainRecordsResponse.Domains, and is useful for accessing the field via an interface.
+func (v *listCustomDomainRecordsResponse) GetDomains() listCustomDomainRecordsDomainsAllDomains {
+	return v.Domains
+}
+
+// listCustomDomainsDomainsAllDomains includes the requested fields of the GraphQL type AllDomains.
+type listCustomDomainsDomainsAllDomains struct {
+	CustomDomains []listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain `json:"customDomains"`
+}
+
+// GetCustomDomains returns listCustomDomainsDomainsAllDomains.CustomDomains, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomains) GetCustomDomains() []listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain {
+	return v.CustomDomains
+}
+
+// listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain includes the requested fields of the GraphQL type CustomDomain.
+type listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain struct {
+	CustomDomain `json:"-"`
+}
+
+// GetId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Id, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetId() string {
+	return v.CustomDomain.Id
+}
+
+// GetDomain returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Domain, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetDomain() string {
+	return v.CustomDomain.Domain
+}
+
+// GetStatus returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Status, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetStatus() CustomDomainStatus {
+	return v.CustomDomain.Status
+}
+
+// GetEnvironmentId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetEnvironmentId() string {
+	return v.CustomDomain.EnvironmentId
+}
+
+// GetServiceId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.ServiceId, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetServiceId() string {
+	return v.CustomDomain.ServiceId
+}
+
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomDomain)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain struct {
+	Id string `json:"id"`
+
+	Domain string `json:"domain"`
+
+	Status CustomDomainStatus `json:"status"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
+}
+
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) __premarshalJSON() (*__premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain, error) {
+	var retval __premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain
+
+	retval.Id = v.CustomDomain.Id
+	retval.Domain = v.CustomDomain.Domain
+	retval.Status = v.CustomDomain.Status
+	retval.EnvironmentId = v.CustomDomain.EnvironmentId
+	retval.ServiceId = v.CustomDomain.ServiceId
+	return &retval, nil
+}
+
+// listCustomDomainsResponse is returned by listCustomDomains on success.
+type listCustomDomainsResponse struct {
+	// All domains for a service instance
+	Domains listCustomDomainsDomainsAllDomains `json:"domains"`
+}
+
+// GetDomains returns listCustomDomainsResponse.Domains, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsResponse) GetDomains() listCustomDomainsDomainsAllDomains { return v.Domains }
+
+// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection includes the requested fields of the GraphQL type QueryDeploymentTriggersConnection.
+type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection struct {
+	Edges []listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection) GetEdges() []listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge {
+	return v.Edges
+}
+
+// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge includes the requested fields of the GraphQL type QueryDeploymentTriggersConnectionEdge.
+type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge struct {
+	Node listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger `json:"node"`
+}
+
+// GetNode returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge) GetNode() listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger {
+	return v.Node
+}
+
+// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger includes the requested fields of the GraphQL type DeploymentTrigger.
+type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger struct {
+	Id     string `json:"id"`
+	Branch string `json:"branch"`
+}
+
+// GetId returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger.Id, and is useful for accessing the field via an interface.
+func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger) GetId() string {
+	return v.Id
+}
+
+// GetBranch returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger.Branch, and is useful for accessing the field via an interface.
+func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger) GetBranch() string {
+	return v.Branch
+}
+
+// listDeploymentTriggersResponse is returned by listDeploymentTriggers on success.
+type listDeploymentTriggersResponse struct {
+	// All deployment triggers.
+	DeploymentTriggers listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection `json:"deploymentTriggers"`
+}
+
+// GetDeploymentTriggers returns listDeploymentTriggersResponse.DeploymentTriggers, and is useful for accessing the field via an interface.
+func (v *listDeploymentTriggersResponse) GetDeploymentTriggers() listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection {
+	return v.DeploymentTriggers
+}
+
+// listDeploymentsDeploymentsQueryDeploymentsConnection includes the requested fields of the GraphQL type QueryDeploymentsConnection.
+type listDeploymentsDeploymentsQueryDeploymentsConnection struct {
+	Edges    []listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge `json:"edges"`
+	PageInfo listDeploymentsDeploymentsQueryDeploymentsConnectionPageInfo                              `json:"pageInfo"`
+}
+
+// GetEdges returns listDeploymentsDeploymentsQueryDeploymentsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnection) GetEdges() []listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns listDeploymentsDeploymentsQueryDeploymentsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnection) GetPageInfo() listDeploymentsDeploymentsQueryDeploymentsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge includes the requested fields of the GraphQL type QueryDeploymentsConnectionEdge.
+type listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge struct {
+	Node listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment `json:"node"`
+}
+
+// GetNode returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge) GetNode() listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment {
+	return v.Node
+}
+
+// listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment includes the requested fields of the GraphQL type Deployment.
+type listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment struct {
+	Id        string                 `json:"id"`
+	Status    DeploymentStatus       `json:"status"`
+	CreatedAt time.Time              `json:"createdAt"`
+	Url       string                 `json:"url"`
+	Meta      map[string]interface{} `json:"meta"`
+}
+
+// GetId returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.Id, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetId() string {
+	return v.Id
+}
+
+// GetStatus returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.Status, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetStatus() DeploymentStatus {
+	return v.Status
+}
+
+// GetCreatedAt returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUrl returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.Url, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetUrl() string {
+	return v.Url
+}
+
+// GetMeta returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.Meta, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetMeta() map[string]interface{} {
+	return v.Meta
+}
+
+// listDeploymentsDeploymentsQueryDeploymentsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listDeploymentsDeploymentsQueryDeploymentsConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listDeploymentsDeploymentsQueryDeploymentsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listDeploymentsDeploymentsQueryDeploymentsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listDeploymentsResponse is returned by listDeployments on success.
+type listDeploymentsResponse struct {
+	// Get all deployments
+	Deployments listDeploymentsDeploymentsQueryDeploymentsConnection `json:"deployments"`
+}
+
+// GetDeployments returns listDeploymentsResponse.Deployments, and is useful for accessing the field via an interface.
+func (v *listDeploymentsResponse) GetDeployments() listDeploymentsDeploymentsQueryDeploymentsConnection {
+	return v.Deployments
+}
+
+// listDomainsDomainsAllDomains includes the requested fields of the GraphQL type AllDomains.
+type listDomainsDomainsAllDomains struct {
+	ServiceDomains []listDomainsDomainsAllDomainsServiceDomainsServiceDomain `json:"serviceDomains"`
+	CustomDomains  []listDomainsDomainsAllDomainsCustomDomainsCustomDomain   `json:"customDomains"`
+}
+
+// GetServiceDomains returns listDomainsDomainsAllDomains.ServiceDomains, and is useful for accessing the field via an interface.
+func (v *listDomainsDomainsAllDomains) GetServiceDomains() []listDomainsDomainsAllDomainsServiceDomainsServiceDomain {
+	return v.ServiceDomains
+}
+
+// GetCustomDomains returns listDomainsDomainsAllDomains.CustomDomains, and is useful for accessing the field via an interface.
+func (v *listDomainsDomainsAllDomains) GetCustomDomains() []listDomainsDomainsAllDomainsCustomDomainsCustomDomain {
+	return v.CustomDomains
+}
+
+// listDomainsDomainsAllDomainsCustomDomainsCustomDomain includes the requested fields of the GraphQL type CustomDomain.
+type listDomainsDomainsAllDomainsCustomDomainsCustomDomain struct {
+	Id         string `json:"id"`
+	Domain     string `json:"domain"`
+	TargetPort *int   `json:"targetPort"`
+}
+
+// GetId returns listDomainsDomainsAllDomainsCustomDomainsCustomDomain.Id, and is useful for accessing the field via an interface.
+func (v *listDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetId() string { return v.Id }
+
+// GetDomain returns listDomainsDomainsAllDomainsCustomDomainsCustomDomain.Domain, and is useful for accessing the field via an interface.
+func (v *listDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetDomain() string { return v.Domain }
+
+// GetTargetPort returns listDomainsDomainsAllDomainsCustomDomainsCustomDomain.TargetPort, and is useful for accessing the field via an interface.
+func (v *listDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetTargetPort() *int {
+	return v.TargetPort
+}
+
+// listDomainsDomainsAllDomainsServiceDomainsServiceDomain includes the requested fields of the GraphQL type ServiceDomain.
+type listDomainsDomainsAllDomainsServiceDomainsServiceDomain struct {
+	Id         string `json:"id"`
+	Domain     string `json:"domain"`
+	TargetPort *int   `json:"targetPort"`
+}
+
+// GetId returns listDomainsDomainsAllDomainsServiceDomainsServiceDomain.Id, and is useful for accessing the field via an interface.
+func (v *listDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetId() string { return v.Id }
+
+// GetDomain returns listDomainsDomainsAllDomainsServiceDomainsServiceDomain.Domain, and is useful for accessing the field via an interface.
+func (v *listDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetDomain() string { return v.Domain }
+
+// GetTargetPort returns listDomainsDomainsAllDomainsServiceDomainsServiceDomain.TargetPort, and is useful for accessing the field via an interface.
+func (v *listDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetTargetPort() *int {
+	return v.TargetPort
+}
+
+// listDomainsResponse is returned by listDomains on success.
+type listDomainsResponse struct {
+	// All domains for a service instance
+	Domains listDomainsDomainsAllDomains `json:"domains"`
+}
+
+// GetDomains returns listDomainsResponse.Domains, and is useful for accessing the field via an interface.
+func (v *listDomainsResponse) GetDomains() listDomainsDomainsAllDomains { return v.Domains }
+
+// listEnvironmentCustomDomainRecordsEnvironment includes the requested fields of the GraphQL type Environment.
+type listEnvironmentCustomDomainRecordsEnvironment struct {
+	ServiceInstances listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection `json:"serviceInstances"`
+}
+
+// GetServiceInstances returns listEnvironmentCustomDomainRecordsEnvironment.ServiceInstances, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironment) GetServiceInstances() listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection {
+	return v.ServiceInstances
+}
+
+// listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection includes the requested fields of the GraphQL type EnvironmentServiceInstancesConnection.
+type listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection struct {
+	Edges    []listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge `json:"edges"`
+	PageInfo listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo                                         `json:"pageInfo"`
+}
+
+// GetEdges returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection) GetEdges() []listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnection) GetPageInfo() listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge includes the requested fields of the GraphQL type EnvironmentServiceInstancesConnectionEdge.
+type listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge struct {
+	Node listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance `json:"node"`
+}
+
+// GetNode returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdge) GetNode() listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance {
+	return v.Node
+}
+
+// listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
+type listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance struct {
+	Domains listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomains `json:"domains"`
+}
+
+// GetDomains returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance.Domains, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstance) GetDomains() listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomains {
+	return v.Domains
+}
+
+// listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomains includes the requested fields of the GraphQL type AllDomains.
+type listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomains struct {
+	CustomDomains []listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain `json:"customDomains"`
+}
+
+// GetCustomDomains returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomains.CustomDomains, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomains) GetCustomDomains() []listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain {
+	return v.CustomDomains
+}
+
+// listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain includes the requested fields of the GraphQL type CustomDomain.
+type listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain struct {
+	CustomDomainRecords `json:"-"`
+}
+
+// GetId returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain.Id, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain) GetId() string {
+	return v.CustomDomainRecords.Id
+}
+
+// GetDomain returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain.Domain, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain) GetDomain() string {
+	return v.CustomDomainRecords.Domain
+}
+
+// GetEnvironmentId returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain) GetEnvironmentId() string {
+	return v.CustomDomainRecords.EnvironmentId
+}
+
+// GetServiceId returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain.ServiceId, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain) GetServiceId() string {
+	return v.CustomDomainRecords.ServiceId
+}
+
+// GetProjectId returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain.ProjectId, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain) GetProjectId() string {
+	return v.CustomDomainRecords.ProjectId
+}
+
+// GetStatus returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain.Status, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain) GetStatus() CustomDomainRecordsStatusCustomDomainStatus {
+	return v.CustomDomainRecords.Status
+}
+
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomDomainRecords)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain struct {
+	Id string `json:"id"`
+
+	Domain string `json:"domain"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
+
+	ProjectId string `json:"projectId"`
+
+	Status CustomDomainRecordsStatusCustomDomainStatus `json:"status"`
+}
+
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain) __premarshalJSON() (*__premarshallistEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain, error) {
+	var retval __premarshallistEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionEdgesEnvironmentServiceInstancesConnectionEdgeNodeServiceInstanceDomainsAllDomainsCustomDomainsCustomDomain
+
+	retval.Id = v.CustomDomainRecords.Id
+	retval.Domain = v.CustomDomainRecords.Domain
+	retval.EnvironmentId = v.CustomDomainRecords.EnvironmentId
+	retval.ServiceId = v.CustomDomainRecords.ServiceId
+	retval.ProjectId = v.CustomDomainRecords.ProjectId
+	retval.Status = v.CustomDomainRecords.Status
+	return &retval, nil
+}
+
+// listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsEnvironmentServiceInstancesEnvironmentServiceInstancesConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listEnvironmentCustomDomainRecordsResponse is returned by listEnvironmentCustomDomainRecords on success.
+type listEnvironmentCustomDomainRecordsResponse struct {
+	// Find a single environment
+	Environment listEnvironmentCustomDomainRecordsEnvironment `json:"environment"`
+}
+
+// GetEnvironment returns listEnvironmentCustomDomainRecordsResponse.Environment, and is useful for accessing the field via an interface.
+func (v *listEnvironmentCustomDomainRecordsResponse) GetEnvironment() listEnvironmentCustomDomainRecordsEnvironment {
+	return v.Environment
+}
+
+// listEnvironmentVolumeInstancesEnvironment includes the requested fields of the GraphQL type Environment.
+type listEnvironmentVolumeInstancesEnvironment struct {
+	VolumeInstances listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection `json:"volumeInstances"`
+}
+
+// GetVolumeInstances returns listEnvironmentVolumeInstancesEnvironment.VolumeInstances, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironment) GetVolumeInstances() listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection {
+	return v.VolumeInstances
+}
+
+// listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection includes the requested fields of the GraphQL type EnvironmentVolumeInstancesConnection.
+type listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection struct {
+	Edges    []listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge `json:"edges"`
+	PageInfo listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionPageInfo                                        `json:"pageInfo"`
+}
+
+// GetEdges returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection) GetEdges() []listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnection) GetPageInfo() listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge includes the requested fields of the GraphQL type EnvironmentVolumeInstancesConnectionEdge.
+type listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge struct {
+	Node listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance `json:"node"`
+}
+
+// GetNode returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdge) GetNode() listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance {
+	return v.Node
+}
+
+// listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance includes the requested fields of the GraphQL type VolumeInstance.
+type listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance struct {
+	VolumeInstance `json:"-"`
+}
+
+// GetId returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance.Id, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance) GetId() string {
+	return v.VolumeInstance.Id
+}
+
+// GetVolumeId returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance.VolumeId, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance) GetVolumeId() string {
+	return v.VolumeInstance.VolumeId
+}
+
+// GetEnvironmentId returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance) GetEnvironmentId() string {
+	return v.VolumeInstance.EnvironmentId
+}
+
+// GetServiceId returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance.ServiceId, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance) GetServiceId() *string {
+	return v.VolumeInstance.ServiceId
+}
+
+// GetMountPath returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance.MountPath, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance) GetMountPath() string {
+	return v.VolumeInstance.MountPath
+}
+
+// GetSizeMB returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance.SizeMB, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance) GetSizeMB() int {
+	return v.VolumeInstance.SizeMB
+}
+
+// GetRegion returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance.Region, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance) GetRegion() *string {
+	return v.VolumeInstance.Region
+}
+
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.VolumeInstance)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance struct {
+	Id string `json:"id"`
+
+	VolumeId string `json:"volumeId"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId *string `json:"serviceId"`
+
+	MountPath string `json:"mountPath"`
+
+	SizeMB int `json:"sizeMB"`
+
+	Region *string `json:"region"`
+}
+
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance) __premarshalJSON() (*__premarshallistEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance, error) {
+	var retval __premarshallistEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionEdgesEnvironmentVolumeInstancesConnectionEdgeNodeVolumeInstance
+
+	retval.Id = v.VolumeInstance.Id
+	retval.VolumeId = v.VolumeInstance.VolumeId
+	retval.EnvironmentId = v.VolumeInstance.EnvironmentId
+	retval.ServiceId = v.VolumeInstance.ServiceId
+	retval.MountPath = v.VolumeInstance.MountPath
+	retval.SizeMB = v.VolumeInstance.SizeMB
+	retval.Region = v.VolumeInstance.Region
+	return &retval, nil
+}
+
+// listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesEnvironmentVolumeInstancesEnvironmentVolumeInstancesConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listEnvironmentVolumeInstancesResponse is returned by listEnvironmentVolumeInstances on success.
+type listEnvironmentVolumeInstancesResponse struct {
+	// Find a single environment
+	Environment listEnvironmentVolumeInstancesEnvironment `json:"environment"`
+}
+
+// GetEnvironment returns listEnvironmentVolumeInstancesResponse.Environment, and is useful for accessing the field via an interface.
+func (v *listEnvironmentVolumeInstancesResponse) GetEnvironment() listEnvironmentVolumeInstancesEnvironment {
+	return v.Environment
+}
+
+// listEnvironmentsEnvironmentsQueryEnvironmentsConnection includes the requested fields of the GraphQL type QueryEnvironmentsConnection.
+type listEnvironmentsEnvironmentsQueryEnvironmentsConnection struct {
+	Edges    []listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge `json:"edges"`
+	PageInfo listEnvironmentsEnvironmentsQueryEnvironmentsConnectionPageInfo                               `json:"pageInfo"`
+}
+
+// GetEdges returns listEnvironmentsEnvironmentsQueryEnvironmentsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listEnvironmentsEnvironmentsQueryEnvironmentsConnection) GetEdges() []listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns listEnvironmentsEnvironmentsQueryEnvironmentsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listEnvironmentsEnvironmentsQueryEnvironmentsConnection) GetPageInfo() listEnvironmentsEnvironmentsQueryEnvironmentsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge includes the requested fields of the GraphQL type QueryEnvironmentsConnectionEdge.
+type listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge struct {
+	Node listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment `json:"node"`
+}
+
+// GetNode returns listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge) GetNode() listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment {
+	return v.Node
+}
+
+// listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment includes the requested fields of the GraphQL type Environment.
+type listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment struct {
+	Id          string    `json:"id"`
+	Name        string    `json:"name"`
+	IsEphemeral bool      `json:"isEphemeral"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// GetId returns listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetId() string {
+	return v.Id
+}
+
+// GetName returns listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetName() string {
+	return v.Name
+}
+
+// GetIsEphemeral returns listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.IsEphemeral, and is useful for accessing the field via an interface.
+func (v *listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetIsEphemeral() bool {
+	return v.IsEphemeral
+}
+
+// GetCreatedAt returns listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// listEnvironmentsEnvironmentsQueryEnvironmentsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listEnvironmentsEnvironmentsQueryEnvironmentsConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listEnvironmentsEnvironmentsQueryEnvironmentsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listEnvironmentsEnvironmentsQueryEnvironmentsConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listEnvironmentsEnvironmentsQueryEnvironmentsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listEnvironmentsEnvironmentsQueryEnvironmentsConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listEnvironmentsResponse is returned by listEnvironments on success.
+type listEnvironmentsResponse struct {
+	// Gets all environments for a project.
+	Environments listEnvironmentsEnvironmentsQueryEnvironmentsConnection `json:"environments"`
+}
+
+// GetEnvironments returns listEnvironmentsResponse.Environments, and is useful for accessing the field via an interface.
+func (v *listEnvironmentsResponse) GetEnvironments() listEnvironmentsEnvironmentsQueryEnvironmentsConnection {
+	return v.Environments
+}
+
+// listEventsEventsQueryEventsConnection includes the requested fields of the GraphQL type QueryEventsConnection.
+type listEventsEventsQueryEventsConnection struct {
+	Edges    []listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdge `json:"edges"`
+	PageInfo listEventsEventsQueryEventsConnectionPageInfo                         `json:"pageInfo"`
+}
+
+// GetEdges returns listEventsEventsQueryEventsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listEventsEventsQueryEventsConnection) GetEdges() []listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns listEventsEventsQueryEventsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listEventsEventsQueryEventsConnection) GetPageInfo() listEventsEventsQueryEventsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdge includes the requested fields of the GraphQL type QueryEventsConnectionEdge.
+type listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdge struct {
+	Node listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent `json:"node"`
+}
+
+// GetNode returns listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdge) GetNode() listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent {
+	return v.Node
+}
+
+// listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent includes the requested fields of the GraphQL type Event.
+type listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent struct {
+	Id            string                 `json:"id"`
+	Action        string                 `json:"action"`
+	Object        string                 `json:"object"`
+	EnvironmentId *string                `json:"environmentId"`
+	CreatedAt     time.Time              `json:"createdAt"`
+	Payload       map[string]interface{} `json:"payload"`
+}
+
+// GetId returns listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent.Id, and is useful for accessing the field via an interface.
+func (v *listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent) GetId() string {
+	return v.Id
+}
+
+// GetAction returns listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent.Action, and is useful for accessing the field via an interface.
+func (v *listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent) GetAction() string {
+	return v.Action
+}
+
+// GetObject returns listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent.Object, and is useful for accessing the field via an interface.
+func (v *listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent) GetObject() string {
+	return v.Object
+}
+
+// GetEnvironmentId returns listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent) GetEnvironmentId() *string {
+	return v.EnvironmentId
+}
+
+// GetCreatedAt returns listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent.CreatedAt, and is useful for accessing the field via an interface.
+func (v *listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetPayload returns listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent.Payload, and is useful for accessing the field via an interface.
+func (v *listEventsEventsQueryEventsConnectionEdgesQueryEventsConnectionEdgeNodeEvent) GetPayload() map[string]interface{} {
+	return v.Payload
+}
+
+// listEventsEventsQueryEventsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listEventsEventsQueryEventsConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listEventsEventsQueryEventsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listEventsEventsQueryEventsConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns listEventsEventsQueryEventsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listEventsEventsQueryEventsConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// listEventsResponse is returned by listEvents on success.
+type listEventsResponse struct {
+	// Gets the events for a project.
+	Events listEventsEventsQueryEventsConnection `json:"events"`
+}
+
+// GetEvents returns listEventsResponse.Events, and is useful for accessing the field via an interface.
+func (v *listEventsResponse) GetEvents() listEventsEventsQueryEventsConnection { return v.Events }
+
+// listProjectInvitationsProjectInvitationsProjectInvitation includes the requested fields of the GraphQL type ProjectInvitation.
+type listProjectInvitationsProjectInvitationsProjectInvitation struct {
+	Id        string `json:"id"`
+	Email     string `json:"email"`
+	IsExpired bool   `json:"isExpired"`
+}
+
+// GetId returns listProjectInvitationsProjectInvitationsProjectInvitation.Id, and is useful for accessing the field via an interface.
+func (v *listProjectInvitationsProjectInvitationsProjectInvitation) GetId() string { return v.Id }
+
+// GetEmail returns listProjectInvitationsProjectInvitationsProjectInvitation.Email, and is useful for accessing the field via an interface.
+func (v *listProjectInvitationsProjectInvitationsProjectInvitation) GetEmail() string { return v.Email }
+
+// GetIsExpired returns listProjectInvitationsProjectInvitationsProjectInvitation.IsExpired, and is useful for accessing the field via an interface.
+func (v *listProjectInvitationsProjectInvitationsProjectInvitation) GetIsExpired() bool {
+	return v.IsExpired
+}
+
+// listProjectInvitationsResponse is returned by listProjectInvitations on success.
+type listProjectInvitationsResponse struct {
+	// Get invitations for a project
+	ProjectInvitations []listProjectInvitationsProjectInvitationsProjectInvitation `json:"projectInvitations"`
+}
+
+// GetProjectInvitations returns listProjectInvitationsResponse.ProjectInvitations, and is useful for accessing the field via an interface.
+func (v *listProjectInvitationsResponse) GetProjectInvitations() []listProjectInvitationsProjectInvitationsProjectInvitation {
+	return v.ProjectInvitations
+}
+
+// listProjectMembersProjectMembersProjectMember includes the requested fields of the GraphQL type ProjectMember.
+type listProjectMembersProjectMembersProjectMember struct {
+	ProjectMember `json:"-"`
+}
+
+// GetId returns listProjectMembersProjectMembersProjectMember.Id, and is useful for accessing the field via an interface.
+func (v *listProjectMembersProjectMembersProjectMember) GetId() string { return v.ProjectMember.Id }
+
+// GetEmail returns listProjectMembersProjectMembersProjectMember.Email, and is useful for accessing the field via an interface.
+func (v *listProjectMembersProjectMembersProjectMember) GetEmail() string {
+	return v.ProjectMember.Email
+}
+
+// GetRole returns listProjectMembersProjectMembersProjectMember.Role, and is useful for accessing the field via an interface.
+func (v *listProjectMembersProjectMembersProjectMember) GetRole() ProjectRole {
+	return v.ProjectMember.Role
+}
+
+func (v *listProjectMembersProjectMembersProjectMember) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listProjectMembersProjectMembersProjectMember
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listProjectMembersProjectMembersProjectMember = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectMember)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistProjectMembersProjectMembersProjectMember struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Role ProjectRole `json:"role"`
+}
+
+func (v *listProjectMembersProjectMembersProjectMember) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listProjectMembersProjectMembersProjectMember) __premarshalJSON() (*__premarshallistProjectMembersProjectMembersProjectMember, error) {
+	var retval __premarshallistProjectMembersProjectMembersProjectMember
+
+	retval.Id = v.ProjectMember.Id
+	retval.Email = v.ProjectMember.Email
+	retval.Role = v.ProjectMember.Role
+	return &retval, nil
+}
+
+// listProjectMembersResponse is returned by listProjectMembers on success.
+type listProjectMembersResponse struct {
+	// Gets users who belong to a project along with their role
+	ProjectMembers []listProjectMembersProjectMembersProjectMember `json:"projectMembers"`
+}
+
+// GetProjectMembers returns listProjectMembersResponse.ProjectMembers, and is useful for accessing the field via an interface.
+func (v *listProjectMembersResponse) GetProjectMembers() []listProjectMembersProjectMembersProjectMember {
+	return v.ProjectMembers
+}
+
+// listProjectServicesProject includes the requested fields of the GraphQL type Project.
+type listProjectServicesProject struct {
+	Services listProjectServicesProjectServicesProjectServicesConnection `json:"services"`
+}
+
+// GetServices returns listProjectServicesProject.Services, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProject) GetServices() listProjectServicesProjectServicesProjectServicesConnection {
+	return v.Services
+}
+
+// listProjectServicesProjectServicesProjectServicesConnection includes the requested fields of the GraphQL type ProjectServicesConnection.
+type listProjectServicesProjectServicesProjectServicesConnection struct {
+	Edges    []listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge `json:"edges"`
+	PageInfo listProjectServicesProjectServicesProjectServicesConnectionPageInfo                             `json:"pageInfo"`
+}
+
+// GetEdges returns listProjectServicesProjectServicesProjectServicesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnection) GetEdges() []listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns listProjectServicesProjectServicesProjectServicesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnection) GetPageInfo() listProjectServicesProjectServicesProjectServicesConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge includes the requested fields of the GraphQL type ProjectServicesConnectionEdge.
+type listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge struct {
+	Node listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService `json:"node"`
+}
+
+// GetNode returns listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge) GetNode() listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService {
+	return v.Node
+}
+
+// listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService includes the requested fields of the GraphQL type Service.
+type listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService struct {
+	Id                string    `json:"id"`
+	Name              string    `json:"name"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+	TemplateServiceId *string   `json:"templateServiceId"`
+}
+
+// GetId returns listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService.Id, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService) GetId() string {
+	return v.Id
+}
+
+// GetName returns listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService.Name, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService) GetName() string {
+	return v.Name
+}
+
+// GetCreatedAt returns listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService.CreatedAt, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetTemplateServiceId returns listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService.TemplateServiceId, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService) GetTemplateServiceId() *string {
+	return v.TemplateServiceId
+}
+
+// listProjectServicesProjectServicesProjectServicesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listProjectServicesProjectServicesProjectServicesConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listProjectServicesProjectServicesProjectServicesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listProjectServicesProjectServicesProjectServicesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listProjectServicesResponse is returned by listProjectServices on success.
+type listProjectServicesResponse struct {
+	// Get a project by ID
+	Project listProjectServicesProject `json:"project"`
+}
+
+// GetProject returns listProjectServicesResponse.Project, and is useful for accessing the field via an interface.
+func (v *listProjectServicesResponse) GetProject() listProjectServicesProject { return v.Project }
+
+// listProjectTokensProjectTokensQueryProjectTokensConnection includes the requested fields of the GraphQL type QueryProjectTokensConnection.
+type listProjectTokensProjectTokensQueryProjectTokensConnection struct {
+	Edges    []listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdge `json:"edges"`
+	PageInfo listProjectTokensProjectTokensQueryProjectTokensConnectionPageInfo                                `json:"pageInfo"`
+}
+
+// GetEdges returns listProjectTokensProjectTokensQueryProjectTokensConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listProjectTokensProjectTokensQueryProjectTokensConnection) GetEdges() []listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns listProjectTokensProjectTokensQueryProjectTokensConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listProjectTokensProjectTokensQueryProjectTokensConnection) GetPageInfo() listProjectTokensProjectTokensQueryProjectTokensConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdge includes the requested fields of the GraphQL type QueryProjectTokensConnectionEdge.
+type listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdge struct {
+	Node listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken `json:"node"`
+}
+
+// GetNode returns listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdge) GetNode() listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken {
+	return v.Node
+}
+
+// listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken includes the requested fields of the GraphQL type ProjectToken.
+type listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken struct {
+	Id            string    `json:"id"`
+	Name          string    `json:"name"`
+	EnvironmentId string    `json:"environmentId"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// GetId returns listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken.Id, and is useful for accessing the field via an interface.
+func (v *listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken) GetId() string {
+	return v.Id
+}
+
+// GetName returns listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken.Name, and is useful for accessing the field via an interface.
+func (v *listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken) GetName() string {
+	return v.Name
+}
+
+// GetEnvironmentId returns listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken) GetEnvironmentId() string {
+	return v.EnvironmentId
+}
+
+// GetCreatedAt returns listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken.CreatedAt, and is useful for accessing the field via an interface.
+func (v *listProjectTokensProjectTokensQueryProjectTokensConnectionEdgesQueryProjectTokensConnectionEdgeNodeProjectToken) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// listProjectTokensProjectTokensQueryProjectTokensConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listProjectTokensProjectTokensQueryProjectTokensConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listProjectTokensProjectTokensQueryProjectTokensConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listProjectTokensProjectTokensQueryProjectTokensConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listProjectTokensProjectTokensQueryProjectTokensConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listProjectTokensProjectTokensQueryProjectTokensConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listProjectTokensResponse is returned by listProjectTokens on success.
+type listProjectTokensResponse struct {
+	// Get all project tokens for a project
+	ProjectTokens listProjectTokensProjectTokensQueryProjectTokensConnection `json:"projectTokens"`
+}
+
+// GetProjectTokens returns listProjectTokensResponse.ProjectTokens, and is useful for accessing the field via an interface.
+func (v *listProjectTokensResponse) GetProjectTokens() listProjectTokensProjectTokensQueryProjectTokensConnection {
+	return v.ProjectTokens
+}
+
+// listProjectVolumesProject includes the requested fields of the GraphQL type Project.
+type listProjectVolumesProject struct {
+	Volumes listProjectVolumesProjectVolumesProjectVolumesConnection `json:"volumes"`
+}
+
+// GetVolumes returns listProjectVolumesProject.Volumes, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProject) GetVolumes() listProjectVolumesProjectVolumesProjectVolumesConnection {
+	return v.Volumes
+}
+
+// listProjectVolumesProjectVolumesProjectVolumesConnection includes the requested fields of the GraphQL type ProjectVolumesConnection.
+type listProjectVolumesProjectVolumesProjectVolumesConnection struct {
+	Edges    []listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge `json:"edges"`
+	PageInfo listProjectVolumesProjectVolumesProjectVolumesConnectionPageInfo                            `json:"pageInfo"`
+}
+
+// GetEdges returns listProjectVolumesProjectVolumesProjectVolumesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnection) GetEdges() []listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns listProjectVolumesProjectVolumesProjectVolumesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnection) GetPageInfo() listProjectVolumesProjectVolumesProjectVolumesConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge includes the requested fields of the GraphQL type ProjectVolumesConnectionEdge.
+type listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge struct {
+	Node listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume `json:"node"`
+}
+
+// GetNode returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge) GetNode() listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume {
+	return v.Node
+}
+
+// listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume includes the requested fields of the GraphQL type Volume.
+type listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume struct {
+	Id              string                                                                                                                                            `json:"id"`
+	Name            string                                                                                                                                            `json:"name"`
+	VolumeInstances listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnection `json:"volumeInstances"`
+}
+
+// GetId returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.Id, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetId() string {
+	return v.Id
+}
+
+// GetName returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.Name, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetName() string {
+	return v.Name
+}
+
+// GetVolumeInstances returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.VolumeInstances, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetVolumeInstances() listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnection {
+	return v.VolumeInstances
+}
+
+// listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnection includes the requested fields of the GraphQL type VolumeVolumeInstancesConnection.
+type listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnection struct {
+	Edges []listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnection) GetEdges() []listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdge {
+	return v.Edges
+}
+
+// listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdge includes the requested fields of the GraphQL type VolumeVolumeInstancesConnectionEdge.
+type listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdge struct {
+	Node listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance `json:"node"`
+}
+
+// GetNode returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdge) GetNode() listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance {
+	return v.Node
+}
+
+// listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance includes the requested fields of the GraphQL type VolumeInstance.
+type listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance struct {
+	Id            string  `json:"id"`
+	EnvironmentId string  `json:"environmentId"`
+	ServiceId     *string `json:"serviceId"`
+	MountPath     string  `json:"mountPath"`
+	SizeMB        int     `json:"sizeMB"`
+	Region        *string `json:"region"`
+}
+
+// GetId returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance.Id, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance) GetId() string {
+	return v.Id
+}
+
+// GetEnvironmentId returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance) GetEnvironmentId() string {
+	return v.EnvironmentId
+}
+
+// GetServiceId returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance.ServiceId, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance) GetServiceId() *string {
+	return v.ServiceId
+}
+
+// GetMountPath returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance.MountPath, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance) GetMountPath() string {
+	return v.MountPath
+}
+
+// GetSizeMB returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance.SizeMB, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance) GetSizeMB() int {
+	return v.SizeMB
+}
+
+// GetRegion returns listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance.Region, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeInstancesConnectionEdgeNodeVolumeInstance) GetRegion() *string {
+	return v.Region
+}
+
+// listProjectVolumesProjectVolumesProjectVolumesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listProjectVolumesProjectVolumesProjectVolumesConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listProjectVolumesProjectVolumesProjectVolumesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listProjectVolumesProjectVolumesProjectVolumesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesProjectVolumesProjectVolumesConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listProjectVolumesResponse is returned by listProjectVolumes on success.
+type listProjectVolumesResponse struct {
+	// Get a project by ID
+	Project listProjectVolumesProject `json:"project"`
+}
+
+// GetProject returns listProjectVolumesResponse.Project, and is useful for accessing the field via an interface.
+func (v *listProjectVolumesResponse) GetProject() listProjectVolumesProject { return v.Project }
+
+// listServiceDomainsDomainsAllDomains includes the requested fields of the GraphQL type AllDomains.
+type listServiceDomainsDomainsAllDomains struct {
+	ServiceDomains []listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain `json:"serviceDomains"`
+}
+
+// GetServiceDomains returns listServiceDomainsDomainsAllDomains.ServiceDomains, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomains) GetServiceDomains() []listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain {
+	return v.ServiceDomains
+}
+
+// listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain includes the requested fields of the GraphQL type ServiceDomain.
+type listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain struct {
+	ServiceDomain `json:"-"`
+}
+
+// GetId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Id, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetId() string {
+	return v.ServiceDomain.Id
+}
+
+// GetDomain returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Domain, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetDomain() string {
+	return v.ServiceDomain.Domain
+}
+
+// GetSuffix returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Suffix, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetSuffix() string {
+	return v.ServiceDomain.Suffix
+}
+
+// GetEnvironmentId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetEnvironmentId() string {
+	return v.ServiceDomain.EnvironmentId
+}
+
+// GetServiceId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.ServiceId, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetServiceId() string {
+	return v.ServiceDomain.ServiceId
+}
+
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ServiceDomain)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain struct {
+	Id string `json:"id"`
+
+	Domain string `json:"domain"`
+
+	Suffix string `json:"suffix"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
+}
+
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) __premarshalJSON() (*__premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain, error) {
+	var retval __premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain
+
+	retval.Id = v.ServiceDomain.Id
+	retval.Domain = v.ServiceDomain.Domain
+	retval.Suffix = v.ServiceDomain.Suffix
+	retval.EnvironmentId = v.ServiceDomain.EnvironmentId
+	retval.ServiceId = v.ServiceDomain.ServiceId
+	return &retval, nil
+}
+
+// listServiceDomainsResponse is returned by listServiceDomains on success.
+type listServiceDomainsResponse struct {
+	// All domains for a service instance
+	Domains listServiceDomainsDomainsAllDomains `json:"domains"`
+}
+
+// GetDomains returns listServiceDomainsResponse.Domains, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsResponse) GetDomains() listServiceDomainsDomainsAllDomains {
+	return v.Domains
+}
+
+// listTcpProxyProjectsProjectsQueryProjectsConnection includes the requested fields of the GraphQL type QueryProjectsConnection.
+type listTcpProxyProjectsProjectsQueryProjectsConnection struct {
+	Edges    []listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdge `json:"edges"`
+	PageInfo listTcpProxyProjectsProjectsQueryProjectsConnectionPageInfo                           `json:"pageInfo"`
+}
+
+// GetEdges returns listTcpProxyProjectsProjectsQueryProjectsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listTcpProxyProjectsProjectsQueryProjectsConnection) GetEdges() []listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns listTcpProxyProjectsProjectsQueryProjectsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *listTcpProxyProjectsProjectsQueryProjectsConnection) GetPageInfo() listTcpProxyProjectsProjectsQueryProjectsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdge includes the requested fields of the GraphQL type QueryProjectsConnectionEdge.
+type listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdge struct {
+	Node listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdgeNodeProject `json:"node"`
+}
+
+// GetNode returns listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdge) GetNode() listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdgeNodeProject {
+	return v.Node
+}
+
+// listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdgeNodeProject includes the requested fields of the GraphQL type Project.
+type listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdgeNodeProject struct {
+	Id string `json:"id"`
+}
+
+// GetId returns listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdgeNodeProject.Id, and is useful for accessing the field via an interface.
+func (v *listTcpProxyProjectsProjectsQueryProjectsConnectionEdgesQueryProjectsConnectionEdgeNodeProject) GetId() string {
+	return v.Id
+}
+
+// listTcpProxyProjectsProjectsQueryProjectsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type listTcpProxyProjectsProjectsQueryProjectsConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// GetHasNextPage returns listTcpProxyProjectsProjectsQueryProjectsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *listTcpProxyProjectsProjectsQueryProjectsConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns listTcpProxyProjectsProjectsQueryProjectsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *listTcpProxyProjectsProjectsQueryProjectsConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// listTcpProxyProjectsResponse is returned by listTcpProxyProjects on success.
+type listTcpProxyProjectsResponse struct {
+	// Gets all projects for a user or workspace.
+	Projects listTcpProxyProjectsProjectsQueryProjectsConnection `json:"projects"`
+}
+
+// GetProjects returns listTcpProxyProjectsResponse.Projects, and is useful for accessing the field via an interface.
+func (v *listTcpProxyProjectsResponse) GetProjects() listTcpProxyProjectsProjectsQueryProjectsConnection {
+	return v.Projects
+}
+
+// listVolumeInstanceBackupsResponse is returned by listVolumeInstanceBackups on success.
+type listVolumeInstanceBackupsResponse struct {
+	// List backups of a volume instance
+	VolumeInstanceBackupList []listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup `json:"volumeInstanceBackupList"`
+}
+
+// GetVolumeInstanceBackupList returns listVolumeInstanceBackupsResponse.VolumeInstanceBackupList, and is useful for accessing the field via an interface.
+func (v *listVolumeInstanceBackupsResponse) GetVolumeInstanceBackupList() []listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup {
+	return v.VolumeInstanceBackupList
+}
+
+// listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup includes the requested fields of the GraphQL type VolumeInstanceBackup.
+type listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup struct {
+	VolumeInstanceBackup `json:"-"`
+}
+
+// GetId returns listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup.Id, and is useful for accessing the field via an interface.
+func (v *listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup) GetId() string {
+	return v.VolumeInstanceBackup.Id
+}
+
+// GetName returns listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup.Name, and is useful for accessing the field via an interface.
+func (v *listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup) GetName() *string {
+	return v.VolumeInstanceBackup.Name
+}
+
+// GetCreatedAt returns listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup.CreatedAt, and is useful for accessing the field via an interface.
+func (v *listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup) GetCreatedAt() time.Time {
+	return v.VolumeInstanceBackup.CreatedAt
+}
+
+// GetExpiresAt returns listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup) GetExpiresAt() *time.Time {
+	return v.VolumeInstanceBackup.ExpiresAt
+}
+
+// GetUsedMB returns listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup.UsedMB, and is useful for accessing the field via an interface.
+func (v *listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup) GetUsedMB() *int {
+	return v.VolumeInstanceBackup.UsedMB
+}
+
+func (v *listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.VolumeInstanceBackup)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup struct {
+	Id string `json:"id"`
+
+	Name *string `json:"name"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	UsedMB *int `json:"usedMB"`
+}
+
+func (v *listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup) __premarshalJSON() (*__premarshallistVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup, error) {
+	var retval __premarshallistVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup
+
+	retval.Id = v.VolumeInstanceBackup.Id
+	retval.Name = v.VolumeInstanceBackup.Name
+	retval.CreatedAt = v.VolumeInstanceBackup.CreatedAt
+	retval.ExpiresAt = v.VolumeInstanceBackup.ExpiresAt
+	retval.UsedMB = v.VolumeInstanceBackup.UsedMB
+	return &retval, nil
+}
+
+// redeployDeploymentDeploymentRedeployDeployment includes the requested fields of the GraphQL type Deployment.
+type redeployDeploymentDeploymentRedeployDeployment struct {
+	Id     string           `json:"id"`
+	Status DeploymentStatus `json:"status"`
+}
+
+// GetId returns redeployDeploymentDeploymentRedeployDeployment.Id, and is useful for accessing the field via an interface.
+func (v *redeployDeploymentDeploymentRedeployDeployment) GetId() string { return v.Id }
+
+// GetStatus returns redeployDeploymentDeploymentRedeployDeployment.Status, and is useful for accessing the field via an interface.
+func (v *redeployDeploymentDeploymentRedeployDeployment) GetStatus() DeploymentStatus {
+	return v.Status
+}
+
+// redeployDeploymentResponse is returned by redeployDeployment on success.
+type redeployDeploymentResponse struct {
+	// Redeploys a deployment.
+	DeploymentRedeploy redeployDeploymentDeploymentRedeployDeployment `json:"deploymentRedeploy"`
+}
+
+// GetDeploymentRedeploy returns redeployDeploymentResponse.DeploymentRedeploy, and is useful for accessing the field via an interface.
+func (v *redeployDeploymentResponse) GetDeploymentRedeploy() redeployDeploymentDeploymentRedeployDeployment {
+	return v.DeploymentRedeploy
+}
+
+// redeployServiceInstanceResponse is returned by redeployServiceInstance on success.
+type redeployServiceInstanceResponse struct {
+	// Redeploy a service instance
+	ServiceInstanceRedeploy bool `json:"serviceInstanceRedeploy"`
+}
+
+// GetServiceInstanceRedeploy returns redeployServiceInstanceResponse.ServiceInstanceRedeploy, and is useful for accessing the field via an interface.
+func (v *redeployServiceInstanceResponse) GetServiceInstanceRedeploy() bool {
+	return v.ServiceInstanceRedeploy
+}
+
+// redeployServiceInstanceWithEnvResponse is returned by redeployServiceInstanceWithEnv on success.
+type redeployServiceInstanceWithEnvResponse struct {
+	// Redeploy a service instance
+	ServiceInstanceRedeploy bool `json:"serviceInstanceRedeploy"`
+}
+
+// GetServiceInstanceRedeploy returns redeployServiceInstanceWithEnvResponse.ServiceInstanceRedeploy, and is useful for accessing the field via an interface.
+func (v *redeployServiceInstanceWithEnvResponse) GetServiceInstanceRedeploy() bool {
+	return v.ServiceInstanceRedeploy
+}
+
+// removeProjectMemberProjectMemberRemoveProjectMember includes the requested fields of the GraphQL type ProjectMember.
+type removeProjectMemberProjectMemberRemoveProjectMember struct {
+	Id string `json:"id"`
+}
+
+// GetId returns removeProjectMemberProjectMemberRemoveProjectMember.Id, and is useful for accessing the field via an interface.
+func (v *removeProjectMemberProjectMemberRemoveProjectMember) GetId() string { return v.Id }
+
+// removeProjectMemberResponse is returned by removeProjectMember on success.
+type removeProjectMemberResponse struct {
+	// Remove user from a project
+	ProjectMemberRemove []removeProjectMemberProjectMemberRemoveProjectMember `json:"projectMemberRemove"`
+}
+
+// GetProjectMemberRemove returns removeProjectMemberResponse.ProjectMemberRemove, and is useful for accessing the field via an interface.
+func (v *removeProjectMemberResponse) GetProjectMemberRemove() []removeProjectMemberProjectMemberRemoveProjectMember {
+	return v.ProjectMemberRemove
+}
+
+// removeUsageLimitResponse is returned by removeUsageLimit on success.
+type removeUsageLimitResponse struct {
+	// Remove the usage limit for a customer
+	UsageLimitRemove bool `json:"usageLimitRemove"`
+}
+
+// GetUsageLimitRemove returns removeUsageLimitResponse.UsageLimitRemove, and is useful for accessing the field via an interface.
+func (v *removeUsageLimitResponse) GetUsageLimitRemove() bool { return v.UsageLimitRemove }
+
+// restartDeploymentResponse is returned by restartDeployment on success.
+type restartDeploymentResponse struct {
+	// Restarts a deployment.
+	DeploymentRestart bool `json:"deploymentRestart"`
+}
+
+// GetDeploymentRestart returns restartDeploymentResponse.DeploymentRestart, and is useful for accessing the field via an interface.
+func (v *restartDeploymentResponse) GetDeploymentRestart() bool { return v.DeploymentRestart }
+
+// restoreVolumeInstanceBackupResponse is returned by restoreVolumeInstanceBackup on success.
+type restoreVolumeInstanceBackupResponse struct {
+	// Restore a volume instance from a backup
+	VolumeInstanceBackupRestore restoreVolumeInstanceBackupVolumeInstanceBackupRestoreWorkflowId `json:"volumeInstanceBackupRestore"`
+}
+
+// GetVolumeInstanceBackupRestore returns restoreVolumeInstanceBackupResponse.VolumeInstanceBackupRestore, and is useful for accessing the field via an interface.
+func (v *restoreVolumeInstanceBackupResponse) GetVolumeInstanceBackupRestore() restoreVolumeInstanceBackupVolumeInstanceBackupRestoreWorkflowId {
+	return v.VolumeInstanceBackupRestore
+}
+
+// restoreVolumeInstanceBackupVolumeInstanceBackupRestoreWorkflowId includes the requested fields of the GraphQL type WorkflowId.
+type restoreVolumeInstanceBackupVolumeInstanceBackupRestoreWorkflowId struct {
+	WorkflowId *string `json:"workflowId"`
+}
+
+// GetWorkflowId returns restoreVolumeInstanceBackupVolumeInstanceBackupRestoreWorkflowId.WorkflowId, and is useful for accessing the field via an interface.
+func (v *restoreVolumeInstanceBackupVolumeInstanceBackupRestoreWorkflowId) GetWorkflowId() *string {
+	return v.WorkflowId
+}
+
+// rollbackDeploymentResponse is returned by rollbackDeployment on success.
+type rollbackDeploymentResponse struct {
+	// Rolls back to a deployment.
+	DeploymentRollback bool `json:"deploymentRollback"`
+}
+
+// GetDeploymentRollback returns rollbackDeploymentResponse.DeploymentRollback, and is useful for accessing the field via an interface.
+func (v *rollbackDeploymentResponse) GetDeploymentRollback() bool { return v.DeploymentRollback }
+
+// setUsageLimitResponse is returned by setUsageLimit on success.
+type setUsageLimitResponse struct {
+	// Set the usage limit for a customer
+	UsageLimitSet bool `json:"usageLimitSet"`
+}
+
+// GetUsageLimitSet returns setUsageLimitResponse.UsageLimitSet, and is useful for accessing the field via an interface.
+func (v *setUsageLimitResponse) GetUsageLimitSet() bool { return v.UsageLimitSet }
+
+// transferProjectResponse is returned by transferProject on success.
+type transferProjectResponse struct {
+	// Transfer a project to a workspace
+	ProjectTransfer bool `json:"projectTransfer"`
+}
+
+// GetProjectTransfer returns transferProjectResponse.ProjectTransfer, and is useful for accessing the field via an interface.
+func (v *transferProjectResponse) GetProjectTransfer() bool { return v.ProjectTransfer }
+
+// updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger includes the requested fields of the GraphQL type DeploymentTrigger.
+type updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger struct {
+	DeploymentTrigger `json:"-"`
+}
+
+// GetId returns updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger.Id, and is useful for accessing the field via an interface.
+func (v *updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger) GetId() string {
+	return v.DeploymentTrigger.Id
+}
+
+// GetProjectId returns updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger.ProjectId, and is useful for accessing the field via an interface.
+func (v *updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger) GetProjectId() string {
+	return v.DeploymentTrigger.ProjectId
+}
+
+// GetEnvironmentId returns updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger) GetEnvironmentId() string {
+	return v.DeploymentTrigger.EnvironmentId
+}
+
+// GetServiceId returns updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger.ServiceId, and is useful for accessing the field via an interface.
+func (v *updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger) GetServiceId() *string {
+	return v.DeploymentTrigger.ServiceId
+}
+
+// GetRepository returns updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger.Repository, and is useful for accessing the field via an interface.
+func (v *updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger) GetRepository() string {
+	return v.DeploymentTrigger.Repository
+}
+
+// GetBranch returns updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger.Branch, and is useful for accessing the field via an interface.
+func (v *updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger) GetBranch() string {
+	return v.DeploymentTrigger.Branch
+}
+
+// GetCheckSuites returns updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger.CheckSuites, and is useful for accessing the field via an interface.
+func (v *updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger) GetCheckSuites() bool {
+	return v.DeploymentTrigger.CheckSuites
+}
+
+func (v *updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DeploymentTrigger)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger struct {
+	Id string `json:"id"`
+
+	ProjectId string `json:"projectId"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId *string `json:"serviceId"`
+
+	Repository string `json:"repository"`
+
+	Branch string `json:"branch"`
+
+	CheckSuites bool `json:"checkSuites"`
+}
+
+func (v *updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger) __premarshalJSON() (*__premarshalupdateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger, error) {
+	var retval __premarshalupdateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger
+
+	retval.Id = v.DeploymentTrigger.Id
+	retval.ProjectId = v.DeploymentTrigger.ProjectId
+	retval.EnvironmentId = v.DeploymentTrigger.EnvironmentId
+	retval.ServiceId = v.DeploymentTrigger.ServiceId
+	retval.Repository = v.DeploymentTrigger.Repository
+	retval.Branch = v.DeploymentTrigger.Branch
+	retval.CheckSuites = v.DeploymentTrigger.CheckSuites
+	return &retval, nil
+}
+
+// updateDeploymentTriggerResponse is returned by updateDeploymentTrigger on success.
+type updateDeploymentTriggerResponse struct {
+	// Updates a deployment trigger.
+	DeploymentTriggerUpdate updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger `json:"deploymentTriggerUpdate"`
+}
+
+// GetDeploymentTriggerUpdate returns updateDeploymentTriggerResponse.DeploymentTriggerUpdate, and is useful for accessing the field via an interface.
+func (v *updateDeploymentTriggerResponse) GetDeploymentTriggerUpdate() updateDeploymentTriggerDeploymentTriggerUpdateDeploymentTrigger {
+	return v.DeploymentTriggerUpdate
+}
+
+// updateEnvironmentVolumeInstanceResponse is returned by updateEnvironmentVolumeInstance on success.
+type updateEnvironmentVolumeInstanceResponse struct {
+	// Update a volume instance. If no environmentId is provided, all volume instances for the volume will be updated.
+	VolumeInstanceUpdate bool `json:"volumeInstanceUpdate"`
+}
+
+// GetVolumeInstanceUpdate returns updateEnvironmentVolumeInstanceResponse.VolumeInstanceUpdate, and is useful for accessing the field via an interface.
+func (v *updateEnvironmentVolumeInstanceResponse) GetVolumeInstanceUpdate() bool {
+	return v.VolumeInstanceUpdate
+}
+
+// updateProjectMemberProjectMemberUpdateProjectMember includes the requested fields of the GraphQL type ProjectMember.
+type updateProjectMemberProjectMemberUpdateProjectMember struct {
+	ProjectMember `json:"-"`
+}
+
+// GetId returns updateProjectMemberProjectMemberUpdateProjectMember.Id, and is useful for accessing the field via an interface.
+func (v *updateProjectMemberProjectMemberUpdateProjectMember) GetId() string {
+	return v.ProjectMember.Id
+}
+
+// GetEmail returns updateProjectMemberProjectMemberUpdateProjectMember.Email, and is useful for accessing the field via an interface.
+func (v *updateProjectMemberProjectMemberUpdateProjectMember) GetEmail() string {
+	return v.ProjectMember.Email
+}
+
+// GetRole returns updateProjectMemberProjectMemberUpdateProjectMember.Role, and is useful for accessing the field via an interface.
+func (v *updateProjectMemberProjectMemberUpdateProjectMember) GetRole() ProjectRole {
+	return v.ProjectMember.Role
+}
+
+func (v *updateProjectMemberProjectMemberUpdateProjectMember) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateProjectMemberProjectMemberUpdateProjectMember
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateProjectMemberProjectMemberUpdateProjectMember = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectMember)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateProjectMemberProjectMemberUpdateProjectMember struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Role ProjectRole `json:"role"`
+}
+
+func (v *updateProjectMemberProjectMemberUpdateProjectMember) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateProjectMemberProjectMemberUpdateProjectMember) __premarshalJSON() (*__premarshalupdateProjectMemberProjectMemberUpdateProjectMember, error) {
+	var retval __premarshalupdateProjectMemberProjectMemberUpdateProjectMember
+
+	retval.Id = v.ProjectMember.Id
+	retval.Email = v.ProjectMember.Email
+	retval.Role = v.ProjectMember.Role
+	return &retval, nil
+}
+
+// updateProjectMemberResponse is returned by updateProjectMember on success.
+type updateProjectMemberResponse struct {
+	// Change the role for a user within a project
+	ProjectMemberUpdate updateProjectMemberProjectMemberUpdateProjectMember `json:"projectMemberUpdate"`
+}
+
+// GetProjectMemberUpdate returns updateProjectMemberResponse.ProjectMemberUpdate, and is useful for accessing the field via an interface.
+func (v *updateProjectMemberResponse) GetProjectMemberUpdate() updateProjectMemberProjectMemberUpdateProjectMember {
+	return v.ProjectMemberUpdate
+}
+
+// updateProjectProjectUpdateProject includes the requested fields of the GraphQL type Project.
+type updateProjectProjectUpdateProject struct {
+	Project `json:"-"`
+}
+
+// GetId returns updateProjectProjectUpdateProject.Id, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetId() string { return v.Project.Id }
+
+// GetName returns updateProjectProjectUpdateProject.Name, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetName() string { return v.Project.Name }
+
+// GetDescription returns updateProjectProjectUpdateProject.Description, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetDescription() string { return v.Project.Description }
+
+// GetIsPublic returns updateProjectProjectUpdateProject.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetIsPublic() bool { return v.Project.IsPublic }
+
+// GetPrDeploys returns updateProjectProjectUpdateProject.PrDeploys, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetPrDeploys() bool { return v.Project.PrDeploys }
+
+// GetBotPrEnvironments returns updateProjectProjectUpdateProject.BotPrEnvironments, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetBotPrEnvironments() bool {
+	return v.Project.BotPrEnvironments
+}
+
+// GetWorkspace returns updateProjectProjectUpdateProject.Workspace, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetWorkspace() *ProjectWorkspace {
+	return v.Project.Workspace
+}
+
+// GetEnvironments returns updateProjectProjectUpdateProject.Environments, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetEnvironments() ProjectEnvironmentsProjectEnvironmentsConnection {
+	return v.Project.Environments
+}
+
+func (v *updateProjectProjectUpdateProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateProjectProjectUpdateProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateProjectProjectUpdateProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Project)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateProjectProjectUpdateProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	IsPublic bool `json:"isPublic"`
+
+	PrDeploys bool `json:"prDeploys"`
+
+	BotPrEnvironments bool `json:"botPrEnvironments"`
+
+	Workspace *ProjectWorkspace `json:"workspace"`
+
+	Environments ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
+}
+
+func (v *updateProjectProjectUpdateProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateProjectProjectUpdateProject) __premarshalJSON() (*__premarshalupdateProjectProjectUpdateProject, error) {
+	var retval __premarshalupdateProjectProjectUpdateProject
+
+	retval.Id = v.Project.Id
+	retval.Name = v.Project.Name
+	retval.Description = v.Project.Description
+	retval.IsPublic = v.Project.IsPublic
+	retval.PrDeploys = v.Project.PrDeploys
+	retval.BotPrEnvironments = v.Project.BotPrEnvironments
+	retval.Workspace = v.Project.Workspace
+	retval.Environments = v.Project.Environments
+	return &retval, nil
+}
+
+// updateProjectResponse is returned by updateProject on success.
+type updateProjectResponse struct {
+	// Updates a project.
+	ProjectUpdate updateProjectProjectUpdateProject `json:"projectUpdate"`
+}
+
+// GetProjectUpdate returns updateProjectResponse.ProjectUpdate, and is useful for accessing the field via an interface.
+func (v *updateProjectResponse) GetProjectUpdate() updateProjectProjectUpdateProject {
+	return v.ProjectUpdate
+}
+
+// updateServiceDomainResponse is returned by updateServiceDomain on success.
+type updateServiceDomainResponse struct {
+	// Updates a service domain.
+	ServiceDomainUpdate bool `json:"serviceDomainUpdate"`
+}
+
+// GetServiceDomainUpdate returns updateServiceDomainResponse.ServiceDomainUpdate, and is useful for accessing the field via an interface.
+func (v *updateServiceDomainResponse) GetServiceDomainUpdate() bool { return v.ServiceDomainUpdate }
+
+// updateServiceInstanceLimitsResponse is returned by updateServiceInstanceLimits on success.
+type updateServiceInstanceLimitsResponse struct {
+	// Update the resource limits for a service instance
+	ServiceInstanceLimitsUpdate bool `json:"serviceInstanceLimitsUpdate"`
+}
+
+// GetServiceInstanceLimitsUpdate returns updateServiceInstanceLimitsResponse.ServiceInstanceLimitsUpdate, and is useful for accessing the field via an interface.
+func (v *updateServiceInstanceLimitsResponse) GetServiceInstanceLimitsUpdate() bool {
+	return v.ServiceInstanceLimitsUpdate
+}
+
+// updateServiceInstanceResponse is returned by updateServiceInstance on success.
+type updateServiceInstanceResponse struct {
+	// Update a service instance
+	ServiceInstanceUpdate bool `json:"serviceInstanceUpdate"`
+}
+
+// GetServiceInstanceUpdate returns updateServiceInstanceResponse.ServiceInstanceUpdate, and is useful for accessing the field via an interface.
+func (v *updateServiceInstanceResponse) GetServiceInstanceUpdate() bool {
+	return v.ServiceInstanceUpdate
+}
+
+// updateServiceInstanceWithEnvResponse is returned by updateServiceInstanceWithEnv on success.
+type updateServiceInstanceWithEnvResponse struct {
+	// Update a service instance
+	ServiceInstanceUpdate bool `json:"serviceInstanceUpdate"`
+}
+
+// GetServiceInstanceUpdate returns updateServiceInstanceWithEnvResponse.ServiceInstanceUpdate, and is useful for accessing the field via an interface.
+func (v *updateServiceInstanceWithEnvResponse) GetServiceInstanceUpdate() bool {
+	return v.ServiceInstanceUpdate
+}
+
+// updateServiceResponse is returned by updateService on success.
+type updateServiceResponse struct {
+	// Updates a service.
+	ServiceUpdate updateServiceServiceUpdateService `json:"serviceUpdate"`
+}
+
+// GetServiceUpdate returns updateServiceResponse.ServiceUpdate, and is useful for accessing the field via an interface.
+func (v *updateServiceResponse) GetServiceUpdate() updateServiceServiceUpdateService {
+	return v.ServiceUpdate
+}
+
+// updateServiceServiceUpdateService includes the requested fields of the GraphQL type Service.
+type updateServiceServiceUpdateService struct {
+	Service `json:"-"`
+}
+
+// GetId returns updateServiceServiceUpdateService.Id, and is useful for accessing the field via an interface.
+func (v *updateServiceServiceUpdateService) GetId() string { return v.Service.Id }
+
+// GetName returns updateServiceServiceUpdateService.Name, and is useful for accessing the field via an interface.
+func (v *updateServiceServiceUpdateService) GetName() string { return v.Service.Name }
+
+// GetProjectId returns updateServiceServiceUpdateService.ProjectId, and is useful for accessing the field via an interface.
+func (v *updateServiceServiceUpdateService) GetProjectId() string { return v.Service.ProjectId }
+
+func (v *updateServiceServiceUpdateService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateServiceServiceUpdateService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateServiceServiceUpdateService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Service)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateServiceServiceUpdateService struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	ProjectId string `json:"projectId"`
+}
+
+func (v *updateServiceServiceUpdateService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateServiceServiceUpdateService) __premarshalJSON() (*__premarshalupdateServiceServiceUpdateService, error) {
+	var retval __premarshalupdateServiceServiceUpdateService
+
+	retval.Id = v.Service.Id
+	retval.Name = v.Service.Name
+	retval.ProjectId = v.Service.ProjectId
+	return &retval, nil
+}
+
+// updateVolumeInstanceResponse is returned by updateVolumeInstance on success.
+type updateVolumeInstanceResponse struct {
+	// Update a volume instance. If no environmentId is provided, all volume instances for the volume will be updated.
+	VolumeInstanceUpdate bool `json:"volumeInstanceUpdate"`
+}
+
+// GetVolumeInstanceUpdate returns updateVolumeInstanceResponse.VolumeInstanceUpdate, and is useful for accessing the field via an interface.
+func (v *updateVolumeInstanceResponse) GetVolumeInstanceUpdate() bool { return v.VolumeInstanceUpdate }
+
+// updateVolumeResponse is returned by updateVolume on success.
+type updateVolumeResponse struct {
+	// Update a persistent volume in a project
+	VolumeUpdate updateVolumeVolumeUpdateVolume `json:"volumeUpdate"`
+}
+
+// GetVolumeUpdate returns updateVolumeResponse.VolumeUpdate, and is useful for accessing the field via an interface.
+func (v *updateVolumeResponse) GetVolumeUpdate() updateVolumeVolumeUpdateVolume {
+	return v.VolumeUpdate
+}
+
+// updateVolumeVolumeUpdateVolume includes the requested fields of the GraphQL type Volume.
+type updateVolumeVolumeUpdateVolume struct {
+	Volume `json:"-"`
+}
+
+// GetId returns updateVolumeVolumeUpdateVolume.Id, and is useful for accessing the field via an interface.
+func (v *updateVolumeVolumeUpdateVolume) GetId() string { return v.Volume.Id }
+
+// GetName returns updateVolumeVolumeUpdateVolume.Name, and is useful for accessing the field via an interface.
+func (v *updateVolumeVolumeUpdateVolume) GetName() string { return v.Volume.Name }
+
+// GetVolumeInstances returns updateVolumeVolumeUpdateVolume.VolumeInstances, and is useful for accessing the field via an interface.
+func (v *updateVolumeVolumeUpdateVolume) GetVolumeInstances() VolumeVolumeInstancesVolumeVolumeInstancesConnection {
+	return v.Volume.VolumeInstances
+}
+
+func (v *updateVolumeVolumeUpdateVolume) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVolumeVolumeUpdateVolume
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVolumeVolumeUpdateVolume = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Volume)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVolumeVolumeUpdateVolume struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	VolumeInstances VolumeVolumeInstancesVolumeVolumeInstancesConnection `json:"volumeInstances"`
+}
+
+func (v *updateVolumeVolumeUpdateVolume) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVolumeVolumeUpdateVolume) __premarshalJSON() (*__premarshalupdateVolumeVolumeUpdateVolume, error) {
+	var retval __premarshalupdateVolumeVolumeUpdateVolume
+
+	retval.Id = v.Volume.Id
+	retval.Name = v.Volume.Name
+	retval.VolumeInstances = v.Volume.VolumeInstances
+	return &retval, nil
+}
+
+// upsertVariableCollectionResponse is returned by upsertVariableCollection on success.
+type upsertVariableCollectionResponse struct {
+	// Upserts a collection of variables.
+	VariableCollectionUpsert bool `json:"variableCollectionUpsert"`
+}
+
+// GetVariableCollectionUpsert returns upsertVariableCollectionResponse.VariableCollectionUpsert, and is useful for accessing the field via an interface.
+func (v *upsertVariableCollectionResponse) GetVariableCollectionUpsert() bool {
+	return v.VariableCollectionUpsert
+}
+
+// upsertVariableResponse is returned by upsertVariable on success.
+type upsertVariableResponse struct {
+	// Upserts a variable.
+	VariableUpsert bool `json:"variableUpsert"`
+}
+
+// GetVariableUpsert returns upsertVariableResponse.VariableUpsert, and is useful for accessing the field via an interface.
+func (v *upsertVariableResponse) GetVariableUpsert() bool { return v.VariableUpsert }
+
+func commitEnvironmentPatch(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+	patch map[string]interface{},
+) (*commitEnvironmentPatchResponse, error) {
+	req := &graphql.Request{
+		OpName: "commitEnvironmentPatch",
+		Query: `
+mutation commitEnvironmentPatch ($environmentId: String!, $patch: EnvironmentConfig!) {
+	environmentPatchCommit(environmentId: $environmentId, patch: $patch)
+}
+`,
+		Variables: &__commitEnvironmentPatchInput{
+			EnvironmentId: environmentId,
+			Patch:         patch,
+		},
+	}
+	var err error
+
+	var data commitEnvironmentPatchResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// Without a patch the changes staged on the environment are committed
+func commitEnvironmentStagedChanges(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+	commitMessage *string,
+) (*commitEnvironmentStagedChangesResponse, error) {
+	req := &graphql.Request{
+		OpName: "commitEnvironmentStagedChanges",
+		Query: `
+mutation commitEnvironmentStagedChanges ($environmentId: String!, $commitMessage: String) {
+	environmentPatchCommit(environmentId: $environmentId, commitMessage: $commitMessage)
+}
+`,
+		Variables: &__commitEnvironmentStagedChangesInput{
+			EnvironmentId: environmentId,
+			CommitMessage: commitMessage,
+		},
+	}
+	var err error
+
+	var data commitEnvironmentStagedChangesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func connectService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input ServiceConnectInput,
+) (*connectServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "connectService",
+		Query: `
+mutation connectService ($id: String!, $input: ServiceConnectInput!) {
+	serviceConnect(id: $id, input: $input) {
+		... Service
+	}
+}
+fragment Service on Service {
+	id
+	name
+	projectId
+}
+`,
+		Variables: &__connectServiceInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data connectServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createCustomDomain(
+	ctx context.Context,
+	client graphql.Client,
+	input CustomDomainCreateInput,
+) (*createCustomDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "createCustomDomain",
+		Query: `
+mutation createCustomDomain ($input: CustomDomainCreateInput!) {
+	customDomainCreate(input: $input) {
+		... CustomDomain
+	}
+}
+fragment CustomDomain on CustomDomain {
+	id
+	domain
+	status {
+		dnsRecords {
+			hostlabel
+			requiredValue
+			zone
+		}
+	}
+	environmentId
+	serviceId
+}
+`,
+		Variables: &__createCustomDomainInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createCustomDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createDeploymentTrigger(
+	ctx context.Context,
+	client graphql.Client,
+	input DeploymentTriggerCreateInput,
+) (*createDeploymentTriggerResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDeploymentTrigger",
+		Query: `
+mutation createDeploymentTrigger ($input: DeploymentTriggerCreateInput!) {
+	deploymentTriggerCreate(input: $input) {
+		... DeploymentTrigger
+	}
+}
+fragment DeploymentTrigger on DeploymentTrigger {
+	id
+	projectId
+	environmentId
+	serviceId
+	repository
+	branch
+	checkSuites
+}
+`,
+		Variables: &__createDeploymentTriggerInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createDeploymentTriggerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createEnvironment(
+	ctx context.Context,
+	client graphql.Client,
+	input EnvironmentCreateInput,
+) (*createEnvironmentResponse, error) {
+	req := &graphql.Request{
+		OpName: "createEnvironment",
+		Query: `
+mutation createEnvironment ($input: EnvironmentCreateInput!) {
+	environmentCreate(input: $input) {
+		... Environment
+	}
+}
+fragment Environment on Environment {
+	id
+	name
+	projectId
+	isEphemeral
+}
+`,
+		Variables: &__createEnvironmentInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createEnvironmentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// Create or get a private network
+func createOrGetPrivateNetwork(
+	ctx context.Context,
+	client graphql.Client,
+	input PrivateNetworkCreateOrGetInput,
+) (*createOrGetPrivateNetworkResponse, error) {
+	req := &graphql.Request{
+		OpName: "createOrGetPrivateNetwork",
+		Query: `
+mutation createOrGetPrivateNetwork ($input: PrivateNetworkCreateOrGetInput!) {
+	privateNetworkCreateOrGet(input: $input) {
+		publicId
+		name
+		dnsName
+		networkId
+		environmentId
+		projectId
+		tags
+	}
+}
+`,
+		Variables: &__createOrGetPrivateNetworkInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createOrGetPrivateNetworkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// Create or get a private network endpoint
+func createOrGetPrivateNetworkEndpoint(
+	ctx context.Context,
+	client graphql.Client,
+	input PrivateNetworkEndpointCreateOrGetInput,
+) (*createOrGetPrivateNetworkEndpointResponse, error) {
+	req := &graphql.Request{
+		OpName: "createOrGetPrivateNetworkEndpoint",
+		Query: `
+mutation createOrGetPrivateNetworkEndpoint ($input: PrivateNetworkEndpointCreateOrGetInput!) {
+	privateNetworkEndpointCreateOrGet(input: $input) {
+		publicId
+		dnsName
+		privateIps
+		serviceInstanceId
+		tags
+	}
+}
+`,
+		Variables: &__createOrGetPrivateNetworkEndpointInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createOrGetPrivateNetworkEndpointResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createProject(
+	ctx context.Context,
+	client graphql.Client,
+	input ProjectCreateInput,
+) (*createProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "createProject",
+		Query: `
+mutation createProject ($input: ProjectCreateInput!) {
+	projectCreate(input: $input) {
+		... Project
+	}
+}
+fragment Project on Project {
+	id
+	name
+	description
+	isPublic
+	prDeploys
+	botPrEnvironments
+	workspace {
+		id
+	}
+	environments {
+		edges {
+			node {
+				id
+				name
+				createdAt
+				isEphemeral
+			}
+		}
+	}
+}
+`,
+		Variables: &__createProjectInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createProjectInvitation(
+	ctx context.Context,
+	client graphql.Client,
+	projectId string,
+	input ProjectInvitee,
+) (*createProjectInvitationResponse, error) {
+	req := &graphql.Request{
+		OpName: "createProjectInvitation",
+		Query: `
+mutation createProjectInvitation ($projectId: String!, $input: ProjectInvitee!) {
+	projectInvitationCreate(id: $projectId, input: $input) {
+		id
+		email
+	}
+}
+`,
+		Variables: &__createProjectInvitationInput{
+			ProjectId: projectId,
+			Input:     input,
+		},
+	}
+	var err error
+
+	var data createProjectInvitationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createService(
+	ctx context.Context,
+	client graphql.Client,
+	input ServiceCreateInput,
+) (*createServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "createService",
+		Query: `
+mutation createService ($input: ServiceCreateInput!) {
+	serviceCreate(input: $input) {
+		... Service
+	}
+}
+fragment Service on Service {
+	id
+	name
+	projectId
+}
+`,
+		Variables: &__createServiceInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createServiceDomain(
+	ctx context.Context,
+	client graphql.Client,
+	input ServiceDomainCreateInput,
+) (*createServiceDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "createServiceDomain",
+		Query: `
+mutation createServiceDomain ($input: ServiceDomainCreateInput!) {
+	serviceDomainCreate(input: $input) {
+		... ServiceDomain
+	}
+}
+fragment ServiceDomain on ServiceDomain {
+	id
+	domain
+	suffix
+	environmentId
+	serviceId
+}
+`,
+		Variables: &__createServiceDomainInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createServiceDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createTcpProxy(
+	ctx context.Context,
+	client graphql.Client,
+	input TCPProxyCreateInput,
+) (*createTcpProxyResponse, error) {
+	req := &graphql.Request{
+		OpName: "createTcpProxy",
+		Query: `
+mutation createTcpProxy ($input: TCPProxyCreateInput!) {
+	tcpProxyCreate(input: $input) {
+		... TCPProxy
+	}
+}
+fragment TCPProxy on TCPProxy {
+	id
+	applicationPort
+	proxyPort
+	domain
+	environmentId
+	serviceId
+}
+`,
+		Variables: &__createTcpProxyInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createTcpProxyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createVolume(
+	ctx context.Context,
+	client graphql.Client,
+	input VolumeCreateInput,
+) (*createVolumeResponse, error) {
+	req := &graphql.Request{
+		OpName: "createVolume",
+		Query: `
+mutation createVolume ($input: VolumeCreateInput!) {
+	volumeCreate(input: $input) {
+		... Volume
+	}
+}
+fragment Volume on Volume {
+	id
+	name
+	volumeInstances {
+		edges {
+			node {
+				id
+				environmentId
+				serviceId
+				mountPath
+				sizeMB
+				region
+			}
+		}
+	}
+}
+`,
+		Variables: &__createVolumeInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createVolumeResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createVolumeInstanceBackup(
+	ctx context.Context,
+	client graphql.Client,
+	volumeInstanceId string,
+) (*createVolumeInstanceBackupResponse, error) {
+	req := &graphql.Request{
+		OpName: "createVolumeInstanceBackup",
+		Query: `
+mutation createVolumeInstanceBackup ($volumeInstanceId: String!) {
+	volumeInstanceBackupCreate(volumeInstanceId: $volumeInstanceId) {
+		workflowId
+	}
+}
+`,
+		Variables: &__createVolumeInstanceBackupInput{
+			VolumeInstanceId: volumeInstanceId,
+		},
+	}
+	var err error
+
+	var data createVolumeInstanceBackupResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteCustomDomain(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteCustomDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteCustomDomain",
+		Query: `
+mutation deleteCustomDomain ($id: String!) {
+	customDomainDelete(id: $id)
+}
+`,
+		Variables: &__deleteCustomDomainInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteCustomDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteDeploymentTrigger(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteDeploymentTriggerResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteDeploymentTrigger",
+		Query: `
+mutation deleteDeploymentTrigger ($id: String!) {
+	deploymentTriggerDelete(id: $id)
+}
+`,
+		Variables: &__deleteDeploymentTriggerInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteDeploymentTriggerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteEnvironment(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteEnvironmentResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteEnvironment",
+		Query: `
+mutation deleteEnvironment ($id: String!) {
+	environmentDelete(id: $id)
+}
+`,
+		Variables: &__deleteEnvironmentInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteEnvironmentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// Delete a private network endpoint
+func deletePrivateNetworkEndpoint(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deletePrivateNetworkEndpointResponse, error) {
+	req := &graphql.Request{
+		OpName: "deletePrivateNetworkEndpoint",
+		Query: `
+mutation deletePrivateNetworkEndpoint ($id: String!) {
+	privateNetworkEndpointDelete(id: $id)
+}
+`,
+		Variables: &__deletePrivateNetworkEndpointInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deletePrivateNetworkEndpointResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// Delete all private networks for an environment
+func deletePrivateNetworksForEnvironment(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+) (*deletePrivateNetworksForEnvironmentResponse, error) {
+	req := &graphql.Request{
+		OpName: "deletePrivateNetworksForEnvironment",
+		Query: `
+mutation deletePrivateNetworksForEnvironment ($environmentId: String!) {
+	privateNetworksForEnvironmentDelete(environmentId: $environmentId)
+}
+`,
+		Variables: &__deletePrivateNetworksForEnvironmentInput{
+			EnvironmentId: environmentId,
+		},
+	}
+	var err error
+
+	var data deletePrivateNetworksForEnvironmentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteProject",
+		Query: `
+mutation deleteProject ($id: String!) {
+	projectDelete(id: $id)
+}
+`,
+		Variables: &__deleteProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteProjectInvitation(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteProjectInvitationResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteProjectInvitation",
+		Query: `
+mutation deleteProjectInvitation ($id: String!) {
+	projectInvitationDelete(id: $id)
+}
+`,
+		Variables: &__deleteProjectInvitationInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteProjectInvitationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteService",
+		Query: `
+mutation deleteService ($id: String!) {
+	serviceDelete(id: $id)
+}
+`,
+		Variables: &__deleteServiceInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteServiceDomain(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteServiceDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteServiceDomain",
+		Query: `
+mutation deleteServiceDomain ($id: String!) {
+	serviceDomainDelete(id: $id)
+}
+`,
+		Variables: &__deleteServiceDomainInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteServiceDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteTcpProxy(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteTcpProxyResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteTcpProxy",
+		Query: `
+mutation deleteTcpProxy ($id: String!) {
+	tcpProxyDelete(id: $id)
+}
+`,
+		Variables: &__deleteTcpProxyInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteTcpProxyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteVariable(
+	ctx context.Context,
+	client graphql.Client,
+	input VariableDeleteInput,
+) (*deleteVariableResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteVariable",
+		Query: `
+mutation deleteVariable ($input: VariableDeleteInput!) {
+	variableDelete(input: $input)
+}
+`,
+		Variables: &__deleteVariableInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data deleteVariableResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteVolume(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteVolumeResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteVolume",
+		Query: `
+mutation deleteVolume ($id: String!) {
+	volumeDelete(volumeId: $id)
+}
+`,
+		Variables: &__deleteVolumeInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteVolumeResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteVolumeInstanceBackup(
+	ctx context.Context,
+	client graphql.Client,
+	volumeInstanceId string,
+	volumeInstanceBackupId string,
+) (*deleteVolumeInstanceBackupResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteVolumeInstanceBackup",
+		Query: `
+mutation deleteVolumeInstanceBackup ($volumeInstanceId: String!, $volumeInstanceBackupId: String!) {
+	volumeInstanceBackupDelete(volumeInstanceId: $volumeInstanceId, volumeInstanceBackupId: $volumeInstanceBackupId) {
+		workflowId
+	}
+}
+`,
+		Variables: &__deleteVolumeInstanceBackupInput{
+			VolumeInstanceId:       volumeInstanceId,
+			VolumeInstanceBackupId: volumeInstanceBackupId,
+		},
+	}
+	var err error
+
+	var data deleteVolumeInstanceBackupResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deployTemplate(
+	ctx context.Context,
+	client graphql.Client,
+	input TemplateDeployV2Input,
+) (*deployTemplateResponse, error) {
+	req := &graphql.Request{
+		OpName: "deployTemplate",
+		Query: `
+mutation deployTemplate ($input: TemplateDeployV2Input!) {
+	templateDeployV2(input: $input) {
+		projectId
+		workflowId
+	}
+}
+`,
+		Variables: &__deployTemplateInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data deployTemplateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func disconnectService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*disconnectServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "disconnectService",
+		Query: `
+mutation disconnectService ($id: String!) {
+	serviceDisconnect(id: $id) {
+		id
+	}
+}
+`,
+		Variables: &__disconnectServiceInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data disconnectServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getBuildLogs(
+	ctx context.Context,
+	client graphql.Client,
+	deploymentId string,
+	limit int,
+) (*getBuildLogsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getBuildLogs",
+		Query: `
+query getBuildLogs ($deploymentId: String!, $limit: Int!) {
+	buildLogs(deploymentId: $deploymentId, limit: $limit) {
+		... LogEntry
+	}
+}
+fragment LogEntry on Log {
+	timestamp
+	message
+	severity
+	attributes {
+		key
+		value
+	}
+}
+`,
+		Variables: &__getBuildLogsInput{
+			DeploymentId: deploymentId,
+			Limit:        limit,
+		},
+	}
+	var err error
+
+	var data getBuildLogsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getCustomDomainRecords(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	projectId string,
+) (*getCustomDomainRecordsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCustomDomainRecords",
+		Query: `
+query getCustomDomainRecords ($id: String!, $projectId: String!) {
+	customDomain(id: $id, projectId: $projectId) {
+		... CustomDomainRecords
+	}
+}
+fragment CustomDomainRecords on CustomDomain {
+	id
+	domain
+	environmentId
+	serviceId
+	projectId
+	status {
+		certificateStatus
+		dnsRecords {
+			recordType
+			fqdn
+			requiredValue
+			currentValue
+			status
+		}
+	}
+}
+`,
+		Variables: &__getCustomDomainRecordsInput{
+			Id:        id,
+			ProjectId: projectId,
+		},
+	}
+	var err error
+
+	var data getCustomDomainRecordsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getDeployment(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getDeploymentResponse, error) {
+	req := &graphql.Request{
+		OpName: "getDeployment",
+		Query: `
+query getDeployment ($id: String!) {
+	deployment(id: $id) {
+		id
+		status
+		serviceId
+		environmentId
+		createdAt
+		url
+		meta
+	}
+}
+`,
+		Variables: &__getDeploymentInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getDeploymentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getDeploymentRollbackTarget(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getDeploymentRollbackTargetResponse, error) {
+	req := &graphql.Request{
+		OpName: "getDeploymentRollbackTarget",
+		Query: `
+query getDeploymentRollbackTarget ($id: String!) {
+	deployment(id: $id) {
+		id
+		serviceId
+		environmentId
+		canRollback
+		meta
+	}
+}
+`,
+		Variables: &__getDeploymentRollbackTargetInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getDeploymentRollbackTargetResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// Triggers can only be looked up by id through the node interface
+func getDeploymentTrigger(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getDeploymentTriggerResponse, error) {
+	req := &graphql.Request{
+		OpName: "getDeploymentTrigger",
+		Query: `
+query getDeploymentTrigger ($id: ID!) {
+	node(id: $id) {
+		__typename
+		... on DeploymentTrigger {
+			... DeploymentTrigger
+		}
+	}
+}
+fragment DeploymentTrigger on DeploymentTrigger {
+	id
+	projectId
+	environmentId
+	serviceId
+	repository
+	branch
+	checkSuites
+}
+`,
+		Variables: &__getDeploymentTriggerInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getDeploymentTriggerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getEnvironment(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getEnvironmentResponse, error) {
+	req := &graphql.Request{
+		OpName: "getEnvironment",
+		Query: `
+query getEnvironment ($id: String!) {
+	environment(id: $id) {
+		... Environment
+		createdAt
+		serviceInstances {
+			edges {
+				node {
+					serviceId
+					serviceName
+				}
+			}
+		}
+		volumeInstances {
+			edges {
+				node {
+					volumeId
+				}
+			}
+		}
+	}
+}
+fragment Environment on Environment {
+	id
+	name
+	projectId
+	isEphemeral
+}
+`,
+		Variables: &__getEnvironmentInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getEnvironmentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// List the services of an environment, endpoints can only be looked up per service
+func getEnvironmentServiceIds(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+	after *string,
+) (*getEnvironmentServiceIdsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getEnvironmentServiceIds",
+		Query: `
+query getEnvironmentServiceIds ($environmentId: String!, $after: String) {
+	environment(id: $environmentId) {
+		serviceInstances(first: 100, after: $after) {
+			edges {
+				node {
+					serviceId
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+`,
+		Variables: &__getEnvironmentServiceIdsInput{
+			EnvironmentId: environmentId,
+			After:         after,
+		},
+	}
+	var err error
+
+	var data getEnvironmentServiceIdsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getEnvironmentStagedChanges(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+) (*getEnvironmentStagedChangesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getEnvironmentStagedChanges",
+		Query: `
+query getEnvironmentStagedChanges ($environmentId: String!) {
+	environmentStagedChanges(environmentId: $environmentId) {
+		id
+		status
+	}
+}
+`,
+		Variables: &__getEnvironmentStagedChangesInput{
+			EnvironmentId: environmentId,
+		},
+	}
+	var err error
+
+	var data getEnvironmentStagedChangesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// Get a private network endpoint for a service instance
+func getPrivateNetworkEndpoint(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId *string,
+	privateNetworkId *string,
+	serviceId *string,
+) (*getPrivateNetworkEndpointResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPrivateNetworkEndpoint",
+		Query: `
+query getPrivateNetworkEndpoint ($environmentId: String!, $privateNetworkId: String!, $serviceId: String!) {
+	privateNetworkEndpoint(environmentId: $environmentId, privateNetworkId: $privateNetworkId, serviceId: $serviceId) {
+		publicId
+		dnsName
+		privateIps
+		serviceInstanceId
+		tags
+	}
+}
+`,
+		Variables: &__getPrivateNetworkEndpointInput{
+			EnvironmentId:    environmentId,
+			PrivateNetworkId: privateNetworkId,
+			ServiceId:        serviceId,
+		},
+	}
+	var err error
+
+	var data getPrivateNetworkEndpointResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// List private networks for an environment
+func getPrivateNetworks(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+) (*getPrivateNetworksResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPrivateNetworks",
+		Query: `
+query getPrivateNetworks ($environmentId: String!) {
+	privateNetworks(environmentId: $environmentId) {
+		publicId
+		name
+		dnsName
+		networkId
+		environmentId
+		projectId
+		tags
+	}
+}
+`,
+		Variables: &__getPrivateNetworksInput{
+			EnvironmentId: environmentId,
+		},
+	}
+	var err error
+
+	var data getPrivateNetworksResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProject",
+		Query: `
+query getProject ($id: String!) {
+	project(id: $id) {
+		... Project
+	}
+}
+fragment Project on Project {
+	id
+	name
+	description
+	isPublic
+	prDeploys
+	botPrEnvironments
+	workspace {
+		id
+	}
+	environments {
+		edges {
+			node {
+				id
+				name
+				createdAt
+				isEphemeral
+			}
+		}
+	}
+}
+`,
+		Variables: &__getProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProjectPlanLimits(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getProjectPlanLimitsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProjectPlanLimits",
+		Query: `
+query getProjectPlanLimits ($id: String!) {
+	project(id: $id) {
+		... PlanLimits
+	}
+}
+fragment PlanLimits on Project {
+	id
+	subscriptionType
+	subscriptionPlanLimit
+}
+`,
+		Variables: &__getProjectPlanLimitsInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getProjectPlanLimitsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// Kept out of getProject so tokens that can't read the workspace can still read the project
+func getProjectWorkspace(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+) (*getProjectWorkspaceResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProjectWorkspace",
+		Query: `
+query getProjectWorkspace ($workspaceId: String!) {
+	workspace(workspaceId: $workspaceId) {
+		name
+		plan
+	}
+}
+`,
+		Variables: &__getProjectWorkspaceInput{
+			WorkspaceId: workspaceId,
+		},
+	}
+	var err error
+
+	var data getProjectWorkspaceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getResolvedVariables(
+	ctx context.Context,
+	client graphql.Client,
+	projectId string,
+	environmentId string,
+	serviceId string,
+) (*getResolvedVariablesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getResolvedVariables",
+		Query: `
+query getResolvedVariables ($projectId: String!, $environmentId: String!, $serviceId: String!) {
+	variables(environmentId: $environmentId, projectId: $projectId, serviceId: $serviceId)
+}
+`,
+		Variables: &__getResolvedVariablesInput{
+			ProjectId:     projectId,
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+		},
+	}
+	var err error
+
+	var data getResolvedVariablesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "getService",
+		Query: `
+query getService ($id: String!) {
+	service(id: $id) {
+		... Service
+		icon
+		createdAt
+	}
+}
+fragment Service on Service {
+	id
+	name
+	projectId
+}
+`,
+		Variables: &__getServiceInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getServiceInstance(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+	serviceId string,
+) (*getServiceInstanceResponse, error) {
+	req := &graphql.Request{
+		OpName: "getServiceInstance",
+		Query: `
+query getServiceInstance ($environmentId: String!, $serviceId: String!) {
+	serviceInstance(environmentId: $environmentId, serviceId: $serviceId) {
+		source {
+			image
+			repo
+		}
+		rootDirectory
+		railwayConfigFile
+		cronSchedule
+		latestDeployment {
+			meta
+		}
+	}
+}
+`,
+		Variables: &__getServiceInstanceInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+		},
+	}
+	var err error
+
+	var data getServiceInstanceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getServiceInstanceForResource(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+	serviceId string,
+) (*getServiceInstanceForResourceResponse, error) {
+	req := &graphql.Request{
+		OpName: "getServiceInstanceForResource",
+		Query: `
+query getServiceInstanceForResource ($environmentId: String!, $serviceId: String!) {
+	serviceInstance(environmentId: $environmentId, serviceId: $serviceId) {
+		id
+		environmentId
+		serviceId
+		source {
+			image
+			repo
+		}
+		builder
+		buildCommand
+		startCommand
+		preDeployCommand
+		healthcheckPath
+		healthcheckTimeout
+		restartPolicyType
+		restartPolicyMaxRetries
+		sleepApplication
+		region
+		numReplicas
+	}
+}
+`,
+		Variables: &__getServiceInstanceForResourceInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+		},
+	}
+	var err error
+
+	var data getServiceInstanceForResourceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getServiceInstanceLimitOverride(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+	serviceId string,
+) (*getServiceInstanceLimitOverrideResponse, error) {
+	req := &graphql.Request{
+		OpName: "getServiceInstanceLimitOverride",
+		Query: `
+query getServiceInstanceLimitOverride ($environmentId: String!, $serviceId: String!) {
+	serviceInstanceLimitOverride(environmentId: $environmentId, serviceId: $serviceId)
+}
+`,
+		Variables: &__getServiceInstanceLimitOverrideInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+		},
+	}
+	var err error
+
+	var data getServiceInstanceLimitOverrideResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getServiceInstances(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+) (*getServiceInstancesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getServiceInstances",
+		Query: `
+query getServiceInstances ($serviceId: String!) {
+	service(id: $serviceId) {
+		serviceInstances {
+			edges {
+				node {
+					id
+					environmentId
+				}
+			}
+		}
+	}
+}
+`,
+		Variables: &__getServiceInstancesInput{
+			ServiceId: serviceId,
+		},
+	}
+	var err error
+
+	var data getServiceInstancesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getServiceMetrics(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	environmentId string,
+	measurement MetricMeasurement,
+	startDate time.Time,
+	endDate time.Time,
+	sampleRateSeconds int,
+) (*getServiceMetricsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getServiceMetrics",
+		Query: `
+query getServiceMetrics ($serviceId: String!, $environmentId: String!, $measurement: MetricMeasurement!, $startDate: DateTime!, $endDate: DateTime!, $sampleRateSeconds: Int!) {
+	metrics(serviceId: $serviceId, environmentId: $environmentId, measurements: [$measurement], startDate: $startDate, endDate: $endDate, sampleRateSeconds: $sampleRateSeconds) {
+		measurement
+		values {
+			ts
+			value
+		}
+	}
+}
+`,
+		Variables: &__getServiceMetricsInput{
+			ServiceId:         serviceId,
+			EnvironmentId:     environmentId,
+			Measurement:       measurement,
+			StartDate:         startDate,
+			EndDate:           endDate,
+			SampleRateSeconds: sampleRateSeconds,
+		},
+	}
+	var err error
+
+	var data getServiceMetricsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getServicePlanLimits(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getServicePlanLimitsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getServicePlanLimits",
+		Query: `
+query getServicePlanLimits ($id: String!) {
+	service(id: $id) {
+		project {
+			... PlanLimits
+		}
+	}
+}
+fragment PlanLimits on Project {
+	id
+	subscriptionType
+	subscriptionPlanLimit
+}
+`,
+		Variables: &__getServicePlanLimitsInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getServicePlanLimitsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// Railway keeps the connected repository as the deployment triggers of the service
+func getServiceRepoTriggers(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+) (*getServiceRepoTriggersResponse, error) {
+	req := &graphql.Request{
+		OpName: "getServiceRepoTriggers",
+		Query: `
+query getServiceRepoTriggers ($serviceId: String!) {
+	service(id: $serviceId) {
+		id
+		repoTriggers {
+			edges {
+				node {
+					repository
+					branch
+				}
+			}
+		}
+	}
+}
+`,
+		Variables: &__getServiceRepoTriggersInput{
+			ServiceId: serviceId,
+		},
+	}
+	var err error
+
+	var data getServiceRepoTriggersResponse
+	resp := &graphql.Response{Data: &data}
 
-	Name string `json:"name"`
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-	VolumeInstances VolumeVolumeInstancesVolumeVolumeInstancesConnection `json:"volumeInstances"`
+	return &data, err
 }
 
-func (v *updateVolumeVolumeUpdateVolume) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
+func getServiceUsage(
+	ctx context.Context,
+	client graphql.Client,
+	projectId *string,
+	workspaceId *string,
+) (*getServiceUsageResponse, error) {
+	req := &graphql.Request{
+		OpName: "getServiceUsage",
+		Query: `
+query getServiceUsage ($projectId: String, $workspaceId: String) {
+	usage(projectId: $projectId, workspaceId: $workspaceId, measurements: [CPU_USAGE,MEMORY_USAGE_GB,NETWORK_TX_GB], groupBy: [PROJECT_ID,SERVICE_ID]) {
+		measurement
+		tags {
+			projectId
+			serviceId
+		}
+		value
 	}
-	return json.Marshal(premarshaled)
-}
-
-func (v *updateVolumeVolumeUpdateVolume) __premarshalJSON() (*__premarshalupdateVolumeVolumeUpdateVolume, error) {
-	var retval __premarshalupdateVolumeVolumeUpdateVolume
-
-	retval.Id = v.Volume.Id
-	retval.Name = v.Volume.Name
-	retval.VolumeInstances = v.Volume.VolumeInstances
-	return &retval, nil
 }
+`,
+		Variables: &__getServiceUsageInput{
+			ProjectId:   projectId,
+			WorkspaceId: workspaceId,
+		},
+	}
+	var err error
 
-// upsertVariableCollectionResponse is returned by upsertVariableCollection on success.
-type upsertVariableCollectionResponse struct {
-	// Upserts a collection of variables.
-	VariableCollectionUpsert bool `json:"variableCollectionUpsert"`
-}
+	var data getServiceUsageResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetVariableCollectionUpsert returns upsertVariableCollectionResponse.VariableCollectionUpsert, and is useful for accessing the field via an interface.
-func (v *upsertVariableCollectionResponse) GetVariableCollectionUpsert() bool {
-	return v.VariableCollectionUpsert
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// upsertVariableResponse is returned by upsertVariable on success.
-type upsertVariableResponse struct {
-	// Upserts a variable.
-	VariableUpsert bool `json:"variableUpsert"`
+	return &data, err
 }
 
-// GetVariableUpsert returns upsertVariableResponse.VariableUpsert, and is useful for accessing the field via an interface.
-func (v *upsertVariableResponse) GetVariableUpsert() bool { return v.VariableUpsert }
-
-func connectService(
+func getSharedVariables(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-	input ServiceConnectInput,
-) (*connectServiceResponse, error) {
+	projectId string,
+	environmentId string,
+) (*getSharedVariablesResponse, error) {
 	req := &graphql.Request{
-		OpName: "connectService",
+		OpName: "getSharedVariables",
 		Query: `
-mutation connectService ($id: String!, $input: ServiceConnectInput!) {
-	serviceConnect(id: $id, input: $input) {
-		... Service
-	}
-}
-fragment Service on Service {
-	id
-	name
-	projectId
+query getSharedVariables ($projectId: String!, $environmentId: String!) {
+	variables(environmentId: $environmentId, projectId: $projectId, unrendered: true)
 }
 `,
-		Variables: &__connectServiceInput{
-			Id:    id,
-			Input: input,
+		Variables: &__getSharedVariablesInput{
+			ProjectId:     projectId,
+			EnvironmentId: environmentId,
 		},
 	}
 	var err error
 
-	var data connectServiceResponse
+	var data getSharedVariablesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3838,40 +11239,37 @@ fragment Service on Service {
 	return &data, err
 }
 
-func createCustomDomain(
+func getTcpProxy(
 	ctx context.Context,
 	client graphql.Client,
-	input CustomDomainCreateInput,
-) (*createCustomDomainResponse, error) {
+	environmentId string,
+	serviceId string,
+) (*getTcpProxyResponse, error) {
 	req := &graphql.Request{
-		OpName: "createCustomDomain",
+		OpName: "getTcpProxy",
 		Query: `
-mutation createCustomDomain ($input: CustomDomainCreateInput!) {
-	customDomainCreate(input: $input) {
-		... CustomDomain
+query getTcpProxy ($environmentId: String!, $serviceId: String!) {
+	tcpProxies(environmentId: $environmentId, serviceId: $serviceId) {
+		... TCPProxy
 	}
 }
-fragment CustomDomain on CustomDomain {
+fragment TCPProxy on TCPProxy {
 	id
+	applicationPort
+	proxyPort
 	domain
-	status {
-		dnsRecords {
-			hostlabel
-			requiredValue
-			zone
-		}
-	}
 	environmentId
 	serviceId
 }
 `,
-		Variables: &__createCustomDomainInput{
-			Input: input,
+		Variables: &__getTcpProxyInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
 		},
 	}
 	var err error
 
-	var data createCustomDomainResponse
+	var data getTcpProxyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3883,32 +11281,28 @@ fragment CustomDomain on CustomDomain {
 	return &data, err
 }
 
-func createEnvironment(
+func getTemplate(
 	ctx context.Context,
 	client graphql.Client,
-	input EnvironmentCreateInput,
-) (*createEnvironmentResponse, error) {
+	code string,
+) (*getTemplateResponse, error) {
 	req := &graphql.Request{
-		OpName: "createEnvironment",
+		OpName: "getTemplate",
 		Query: `
-mutation createEnvironment ($input: EnvironmentCreateInput!) {
-	environmentCreate(input: $input) {
-		... Environment
+query getTemplate ($code: String!) {
+	template(code: $code) {
+		id
+		serializedConfig
 	}
 }
-fragment Environment on Environment {
-	id
-	name
-	projectId
-}
 `,
-		Variables: &__createEnvironmentInput{
-			Input: input,
+		Variables: &__getTemplateInput{
+			Code: code,
 		},
 	}
 	var err error
 
-	var data createEnvironmentResponse
+	var data getTemplateResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3920,34 +11314,25 @@ fragment Environment on Environment {
 	return &data, err
 }
 
-// Create or get a private network
-func createOrGetPrivateNetwork(
+func getUserWorkspaces(
 	ctx context.Context,
 	client graphql.Client,
-	input PrivateNetworkCreateOrGetInput,
-) (*createOrGetPrivateNetworkResponse, error) {
+) (*getUserWorkspacesResponse, error) {
 	req := &graphql.Request{
-		OpName: "createOrGetPrivateNetwork",
+		OpName: "getUserWorkspaces",
 		Query: `
-mutation createOrGetPrivateNetwork ($input: PrivateNetworkCreateOrGetInput!) {
-	privateNetworkCreateOrGet(input: $input) {
-		publicId
-		name
-		dnsName
-		networkId
-		environmentId
-		projectId
-		tags
+query getUserWorkspaces {
+	me {
+		workspaces {
+			id
+		}
 	}
 }
 `,
-		Variables: &__createOrGetPrivateNetworkInput{
-			Input: input,
-		},
 	}
 	var err error
 
-	var data createOrGetPrivateNetworkResponse
+	var data getUserWorkspacesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3959,32 +11344,29 @@ mutation createOrGetPrivateNetwork ($input: PrivateNetworkCreateOrGetInput!) {
 	return &data, err
 }
 
-// Create or get a private network endpoint
-func createOrGetPrivateNetworkEndpoint(
+func getVariables(
 	ctx context.Context,
 	client graphql.Client,
-	input PrivateNetworkEndpointCreateOrGetInput,
-) (*createOrGetPrivateNetworkEndpointResponse, error) {
+	projectId string,
+	environmentId string,
+	serviceId string,
+) (*getVariablesResponse, error) {
 	req := &graphql.Request{
-		OpName: "createOrGetPrivateNetworkEndpoint",
+		OpName: "getVariables",
 		Query: `
-mutation createOrGetPrivateNetworkEndpoint ($input: PrivateNetworkEndpointCreateOrGetInput!) {
-	privateNetworkEndpointCreateOrGet(input: $input) {
-		publicId
-		dnsName
-		privateIps
-		serviceInstanceId
-		tags
-	}
+query getVariables ($projectId: String!, $environmentId: String!, $serviceId: String!) {
+	variables(environmentId: $environmentId, projectId: $projectId, serviceId: $serviceId, unrendered: true)
 }
 `,
-		Variables: &__createOrGetPrivateNetworkEndpointInput{
-			Input: input,
+		Variables: &__getVariablesInput{
+			ProjectId:     projectId,
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
 		},
 	}
 	var err error
 
-	var data createOrGetPrivateNetworkEndpointResponse
+	var data getVariablesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3996,46 +11378,28 @@ mutation createOrGetPrivateNetworkEndpoint ($input: PrivateNetworkEndpointCreate
 	return &data, err
 }
 
-func createProject(
+func getVolumeInstanceState(
 	ctx context.Context,
 	client graphql.Client,
-	input ProjectCreateInput,
-) (*createProjectResponse, error) {
+	id string,
+) (*getVolumeInstanceStateResponse, error) {
 	req := &graphql.Request{
-		OpName: "createProject",
+		OpName: "getVolumeInstanceState",
 		Query: `
-mutation createProject ($input: ProjectCreateInput!) {
-	projectCreate(input: $input) {
-		... Project
-	}
-}
-fragment Project on Project {
-	id
-	name
-	description
-	isPublic
-	prDeploys
-	workspace {
+query getVolumeInstanceState ($id: String!) {
+	volumeInstance(id: $id) {
 		id
-	}
-	environments {
-		edges {
-			node {
-				id
-				name
-				createdAt
-			}
-		}
+		state
 	}
 }
 `,
-		Variables: &__createProjectInput{
-			Input: input,
+		Variables: &__getVolumeInstanceStateInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createProjectResponse
+	var data getVolumeInstanceStateResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4047,32 +11411,49 @@ fragment Project on Project {
 	return &data, err
 }
 
-func createService(
+func getVolumeInstances(
 	ctx context.Context,
 	client graphql.Client,
-	input ServiceCreateInput,
-) (*createServiceResponse, error) {
+	id string,
+) (*getVolumeInstancesResponse, error) {
 	req := &graphql.Request{
-		OpName: "createService",
+		OpName: "getVolumeInstances",
 		Query: `
-mutation createService ($input: ServiceCreateInput!) {
-	serviceCreate(input: $input) {
-		... Service
+query getVolumeInstances ($id: String!) {
+	project(id: $id) {
+		volumes {
+			edges {
+				node {
+					... Volume
+				}
+			}
+		}
 	}
 }
-fragment Service on Service {
+fragment Volume on Volume {
 	id
 	name
-	projectId
+	volumeInstances {
+		edges {
+			node {
+				id
+				environmentId
+				serviceId
+				mountPath
+				sizeMB
+				region
+			}
+		}
+	}
 }
 `,
-		Variables: &__createServiceInput{
-			Input: input,
+		Variables: &__getVolumeInstancesInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createServiceResponse
+	var data getVolumeInstancesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4084,34 +11465,28 @@ fragment Service on Service {
 	return &data, err
 }
 
-func createServiceDomain(
+func getWorkflowStatus(
 	ctx context.Context,
 	client graphql.Client,
-	input ServiceDomainCreateInput,
-) (*createServiceDomainResponse, error) {
+	workflowId string,
+) (*getWorkflowStatusResponse, error) {
 	req := &graphql.Request{
-		OpName: "createServiceDomain",
+		OpName: "getWorkflowStatus",
 		Query: `
-mutation createServiceDomain ($input: ServiceDomainCreateInput!) {
-	serviceDomainCreate(input: $input) {
-		... ServiceDomain
+query getWorkflowStatus ($workflowId: String!) {
+	workflowStatus(workflowId: $workflowId) {
+		status
+		error
 	}
 }
-fragment ServiceDomain on ServiceDomain {
-	id
-	domain
-	suffix
-	environmentId
-	serviceId
-}
 `,
-		Variables: &__createServiceDomainInput{
-			Input: input,
+		Variables: &__getWorkflowStatusInput{
+			WorkflowId: workflowId,
 		},
 	}
 	var err error
 
-	var data createServiceDomainResponse
+	var data getWorkflowStatusResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4123,35 +11498,38 @@ fragment ServiceDomain on ServiceDomain {
 	return &data, err
 }
 
-func createTcpProxy(
+// Usage limits belong to the billing customer of the workspace
+func getWorkspaceUsageLimit(
 	ctx context.Context,
 	client graphql.Client,
-	input TCPProxyCreateInput,
-) (*createTcpProxyResponse, error) {
+	workspaceId string,
+) (*getWorkspaceUsageLimitResponse, error) {
 	req := &graphql.Request{
-		OpName: "createTcpProxy",
+		OpName: "getWorkspaceUsageLimit",
 		Query: `
-mutation createTcpProxy ($input: TCPProxyCreateInput!) {
-	tcpProxyCreate(input: $input) {
-		... TCPProxy
+query getWorkspaceUsageLimit ($workspaceId: String!) {
+	workspace(workspaceId: $workspaceId) {
+		customer {
+			id
+			usageLimit {
+				... UsageLimit
+			}
+		}
 	}
 }
-fragment TCPProxy on TCPProxy {
+fragment UsageLimit on UsageLimit {
 	id
-	applicationPort
-	proxyPort
-	domain
-	environmentId
-	serviceId
+	hardLimit
+	softLimit
 }
 `,
-		Variables: &__createTcpProxyInput{
-			Input: input,
+		Variables: &__getWorkspaceUsageLimitInput{
+			WorkspaceId: workspaceId,
 		},
 	}
 	var err error
 
-	var data createTcpProxyResponse
+	var data getWorkspaceUsageLimitResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4163,42 +11541,50 @@ fragment TCPProxy on TCPProxy {
 	return &data, err
 }
 
-func createVolume(
+func listCustomDomainRecords(
 	ctx context.Context,
 	client graphql.Client,
-	input VolumeCreateInput,
-) (*createVolumeResponse, error) {
+	environmentId string,
+	serviceId string,
+	projectId string,
+) (*listCustomDomainRecordsResponse, error) {
 	req := &graphql.Request{
-		OpName: "createVolume",
+		OpName: "listCustomDomainRecords",
 		Query: `
-mutation createVolume ($input: VolumeCreateInput!) {
-	volumeCreate(input: $input) {
-		... Volume
+query listCustomDomainRecords ($environmentId: String!, $serviceId: String!, $projectId: String!) {
+	domains(environmentId: $environmentId, serviceId: $serviceId, projectId: $projectId) {
+		customDomains {
+			... CustomDomainRecords
+		}
 	}
 }
-fragment Volume on Volume {
+fragment CustomDomainRecords on CustomDomain {
 	id
-	name
-	volumeInstances {
-		edges {
-			node {
-				id
-				environmentId
-				serviceId
-				mountPath
-				sizeMB
-			}
+	domain
+	environmentId
+	serviceId
+	projectId
+	status {
+		certificateStatus
+		dnsRecords {
+			recordType
+			fqdn
+			requiredValue
+			currentValue
+			status
 		}
 	}
 }
 `,
-		Variables: &__createVolumeInput{
-			Input: input,
+		Variables: &__listCustomDomainRecordsInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+			ProjectId:     projectId,
 		},
 	}
 	var err error
 
-	var data createVolumeResponse
+	var data listCustomDomainRecordsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4210,25 +11596,46 @@ fragment Volume on Volume {
 	return &data, err
 }
 
-func deleteCustomDomain(
+func listCustomDomains(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteCustomDomainResponse, error) {
+	environmentId string,
+	serviceId string,
+	projectId string,
+) (*listCustomDomainsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteCustomDomain",
+		OpName: "listCustomDomains",
 		Query: `
-mutation deleteCustomDomain ($id: String!) {
-	customDomainDelete(id: $id)
+query listCustomDomains ($environmentId: String!, $serviceId: String!, $projectId: String!) {
+	domains(environmentId: $environmentId, serviceId: $serviceId, projectId: $projectId) {
+		customDomains {
+			... CustomDomain
+		}
+	}
+}
+fragment CustomDomain on CustomDomain {
+	id
+	domain
+	status {
+		dnsRecords {
+			hostlabel
+			requiredValue
+			zone
+		}
+	}
+	environmentId
+	serviceId
 }
 `,
-		Variables: &__deleteCustomDomainInput{
-			Id: id,
+		Variables: &__listCustomDomainsInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+			ProjectId:     projectId,
 		},
 	}
 	var err error
 
-	var data deleteCustomDomainResponse
+	var data listCustomDomainsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4240,25 +11647,36 @@ mutation deleteCustomDomain ($id: String!) {
 	return &data, err
 }
 
-func deleteEnvironment(
+func listDeploymentTriggers(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteEnvironmentResponse, error) {
+	projectId string,
+	environmentId string,
+	serviceId string,
+) (*listDeploymentTriggersResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteEnvironment",
+		OpName: "listDeploymentTriggers",
 		Query: `
-mutation deleteEnvironment ($id: String!) {
-	environmentDelete(id: $id)
+query listDeploymentTriggers ($projectId: String!, $environmentId: String!, $serviceId: String!) {
+	deploymentTriggers(environmentId: $environmentId, projectId: $projectId, serviceId: $serviceId) {
+		edges {
+			node {
+				id
+				branch
+			}
+		}
+	}
 }
 `,
-		Variables: &__deleteEnvironmentInput{
-			Id: id,
+		Variables: &__listDeploymentTriggersInput{
+			ProjectId:     projectId,
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
 		},
 	}
 	var err error
 
-	var data deleteEnvironmentResponse
+	var data listDeploymentTriggersResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4270,26 +11688,43 @@ mutation deleteEnvironment ($id: String!) {
 	return &data, err
 }
 
-// Delete a private network endpoint
-func deletePrivateNetworkEndpoint(
+func listDeployments(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deletePrivateNetworkEndpointResponse, error) {
+	input DeploymentListInput,
+	first int,
+	after *string,
+) (*listDeploymentsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deletePrivateNetworkEndpoint",
+		OpName: "listDeployments",
 		Query: `
-mutation deletePrivateNetworkEndpoint ($id: String!) {
-	privateNetworkEndpointDelete(id: $id)
+query listDeployments ($input: DeploymentListInput!, $first: Int!, $after: String) {
+	deployments(input: $input, first: $first, after: $after) {
+		edges {
+			node {
+				id
+				status
+				createdAt
+				url
+				meta
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
 }
 `,
-		Variables: &__deletePrivateNetworkEndpointInput{
-			Id: id,
+		Variables: &__listDeploymentsInput{
+			Input: input,
+			First: first,
+			After: after,
 		},
 	}
 	var err error
 
-	var data deletePrivateNetworkEndpointResponse
+	var data listDeploymentsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4301,26 +11736,40 @@ mutation deletePrivateNetworkEndpoint ($id: String!) {
 	return &data, err
 }
 
-// Delete all private networks for an environment
-func deletePrivateNetworksForEnvironment(
+func listDomains(
 	ctx context.Context,
 	client graphql.Client,
 	environmentId string,
-) (*deletePrivateNetworksForEnvironmentResponse, error) {
+	serviceId string,
+	projectId string,
+) (*listDomainsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deletePrivateNetworksForEnvironment",
+		OpName: "listDomains",
 		Query: `
-mutation deletePrivateNetworksForEnvironment ($environmentId: String!) {
-	privateNetworksForEnvironmentDelete(environmentId: $environmentId)
+query listDomains ($environmentId: String!, $serviceId: String!, $projectId: String!) {
+	domains(environmentId: $environmentId, serviceId: $serviceId, projectId: $projectId) {
+		serviceDomains {
+			id
+			domain
+			targetPort
+		}
+		customDomains {
+			id
+			domain
+			targetPort
+		}
+	}
 }
 `,
-		Variables: &__deletePrivateNetworksForEnvironmentInput{
+		Variables: &__listDomainsInput{
 			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+			ProjectId:     projectId,
 		},
 	}
 	var err error
 
-	var data deletePrivateNetworksForEnvironmentResponse
+	var data listDomainsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4332,55 +11781,62 @@ mutation deletePrivateNetworksForEnvironment ($environmentId: String!) {
 	return &data, err
 }
 
-func deleteProject(
+func listEnvironmentCustomDomainRecords(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteProjectResponse, error) {
+	environmentId string,
+	projectId string,
+	after *string,
+) (*listEnvironmentCustomDomainRecordsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteProject",
+		OpName: "listEnvironmentCustomDomainRecords",
 		Query: `
-mutation deleteProject ($id: String!) {
-	projectDelete(id: $id)
-}
-`,
-		Variables: &__deleteProjectInput{
-			Id: id,
-		},
+query listEnvironmentCustomDomainRecords ($environmentId: String!, $projectId: String!, $after: String) {
+	environment(id: $environmentId, projectId: $projectId) {
+		serviceInstances(first: 100, after: $after) {
+			edges {
+				node {
+					domains {
+						customDomains {
+							... CustomDomainRecords
+						}
+					}
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
 	}
-	var err error
-
-	var data deleteProjectResponse
-	resp := &graphql.Response{Data: &data}
-
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
-
-	return &data, err
 }
-
-func deleteService(
-	ctx context.Context,
-	client graphql.Client,
-	id string,
-) (*deleteServiceResponse, error) {
-	req := &graphql.Request{
-		OpName: "deleteService",
-		Query: `
-mutation deleteService ($id: String!) {
-	serviceDelete(id: $id)
+fragment CustomDomainRecords on CustomDomain {
+	id
+	domain
+	environmentId
+	serviceId
+	projectId
+	status {
+		certificateStatus
+		dnsRecords {
+			recordType
+			fqdn
+			requiredValue
+			currentValue
+			status
+		}
+	}
 }
 `,
-		Variables: &__deleteServiceInput{
-			Id: id,
+		Variables: &__listEnvironmentCustomDomainRecordsInput{
+			EnvironmentId: environmentId,
+			ProjectId:     projectId,
+			After:         after,
 		},
 	}
 	var err error
 
-	var data deleteServiceResponse
+	var data listEnvironmentCustomDomainRecordsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4392,25 +11848,48 @@ mutation deleteService ($id: String!) {
 	return &data, err
 }
 
-func deleteServiceDomain(
+func listEnvironmentVolumeInstances(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteServiceDomainResponse, error) {
+	environmentId string,
+	after *string,
+) (*listEnvironmentVolumeInstancesResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteServiceDomain",
+		OpName: "listEnvironmentVolumeInstances",
 		Query: `
-mutation deleteServiceDomain ($id: String!) {
-	serviceDomainDelete(id: $id)
+query listEnvironmentVolumeInstances ($environmentId: String!, $after: String) {
+	environment(id: $environmentId) {
+		volumeInstances(first: 100, after: $after) {
+			edges {
+				node {
+					... VolumeInstance
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+fragment VolumeInstance on VolumeInstance {
+	id
+	volumeId
+	environmentId
+	serviceId
+	mountPath
+	sizeMB
+	region
 }
 `,
-		Variables: &__deleteServiceDomainInput{
-			Id: id,
+		Variables: &__listEnvironmentVolumeInstancesInput{
+			EnvironmentId: environmentId,
+			After:         after,
 		},
 	}
 	var err error
 
-	var data deleteServiceDomainResponse
+	var data listEnvironmentVolumeInstancesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4422,25 +11901,42 @@ mutation deleteServiceDomain ($id: String!) {
 	return &data, err
 }
 
-func deleteTcpProxy(
+func listEnvironments(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteTcpProxyResponse, error) {
+	projectId string,
+	isEphemeral *bool,
+	after *string,
+) (*listEnvironmentsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteTcpProxy",
+		OpName: "listEnvironments",
 		Query: `
-mutation deleteTcpProxy ($id: String!) {
-	tcpProxyDelete(id: $id)
+query listEnvironments ($projectId: String!, $isEphemeral: Boolean, $after: String) {
+	environments(projectId: $projectId, isEphemeral: $isEphemeral, first: 100, after: $after) {
+		edges {
+			node {
+				id
+				name
+				isEphemeral
+				createdAt
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
 }
 `,
-		Variables: &__deleteTcpProxyInput{
-			Id: id,
+		Variables: &__listEnvironmentsInput{
+			ProjectId:   projectId,
+			IsEphemeral: isEphemeral,
+			After:       after,
 		},
 	}
 	var err error
 
-	var data deleteTcpProxyResponse
+	var data listEnvironmentsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4452,25 +11948,46 @@ mutation deleteTcpProxy ($id: String!) {
 	return &data, err
 }
 
-func deleteVariable(
+func listEvents(
 	ctx context.Context,
 	client graphql.Client,
-	input VariableDeleteInput,
-) (*deleteVariableResponse, error) {
+	projectId string,
+	environmentId *string,
+	first int,
+	after *string,
+) (*listEventsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteVariable",
+		OpName: "listEvents",
 		Query: `
-mutation deleteVariable ($input: VariableDeleteInput!) {
-	variableDelete(input: $input)
+query listEvents ($projectId: String!, $environmentId: String, $first: Int!, $after: String) {
+	events(projectId: $projectId, environmentId: $environmentId, first: $first, after: $after) {
+		edges {
+			node {
+				id
+				action
+				object
+				environmentId
+				createdAt
+				payload
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
 }
 `,
-		Variables: &__deleteVariableInput{
-			Input: input,
+		Variables: &__listEventsInput{
+			ProjectId:     projectId,
+			EnvironmentId: environmentId,
+			First:         first,
+			After:         after,
 		},
 	}
 	var err error
 
-	var data deleteVariableResponse
+	var data listEventsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4482,25 +11999,29 @@ mutation deleteVariable ($input: VariableDeleteInput!) {
 	return &data, err
 }
 
-func deleteVolume(
+func listProjectInvitations(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteVolumeResponse, error) {
+	projectId string,
+) (*listProjectInvitationsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteVolume",
+		OpName: "listProjectInvitations",
 		Query: `
-mutation deleteVolume ($id: String!) {
-	volumeDelete(volumeId: $id)
+query listProjectInvitations ($projectId: String!) {
+	projectInvitations(id: $projectId) {
+		id
+		email
+		isExpired
+	}
 }
 `,
-		Variables: &__deleteVolumeInput{
-			Id: id,
+		Variables: &__listProjectInvitationsInput{
+			ProjectId: projectId,
 		},
 	}
 	var err error
 
-	var data deleteVolumeResponse
+	var data listProjectInvitationsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4512,27 +12033,32 @@ mutation deleteVolume ($id: String!) {
 	return &data, err
 }
 
-func disconnectService(
+func listProjectMembers(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*disconnectServiceResponse, error) {
+	projectId string,
+) (*listProjectMembersResponse, error) {
 	req := &graphql.Request{
-		OpName: "disconnectService",
+		OpName: "listProjectMembers",
 		Query: `
-mutation disconnectService ($id: String!) {
-	serviceDisconnect(id: $id) {
-		id
+query listProjectMembers ($projectId: String!) {
+	projectMembers(projectId: $projectId) {
+		... ProjectMember
 	}
 }
+fragment ProjectMember on ProjectMember {
+	id
+	email
+	role
+}
 `,
-		Variables: &__disconnectServiceInput{
-			Id: id,
+		Variables: &__listProjectMembersInput{
+			ProjectId: projectId,
 		},
 	}
 	var err error
 
-	var data disconnectServiceResponse
+	var data listProjectMembersResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4544,32 +12070,43 @@ mutation disconnectService ($id: String!) {
 	return &data, err
 }
 
-func getEnvironment(
+func listProjectServices(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getEnvironmentResponse, error) {
+	projectId string,
+	after *string,
+) (*listProjectServicesResponse, error) {
 	req := &graphql.Request{
-		OpName: "getEnvironment",
+		OpName: "listProjectServices",
 		Query: `
-query getEnvironment ($id: String!) {
-	environment(id: $id) {
-		... Environment
+query listProjectServices ($projectId: String!, $after: String) {
+	project(id: $projectId) {
+		services(first: 100, after: $after) {
+			edges {
+				node {
+					id
+					name
+					createdAt
+					updatedAt
+					templateServiceId
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
 	}
 }
-fragment Environment on Environment {
-	id
-	name
-	projectId
-}
 `,
-		Variables: &__getEnvironmentInput{
-			Id: id,
+		Variables: &__listProjectServicesInput{
+			ProjectId: projectId,
+			After:     after,
 		},
 	}
 	var err error
 
-	var data getEnvironmentResponse
+	var data listProjectServicesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4581,36 +12118,40 @@ fragment Environment on Environment {
 	return &data, err
 }
 
-func getEnvironments(
+func listProjectTokens(
 	ctx context.Context,
 	client graphql.Client,
 	projectId string,
-) (*getEnvironmentsResponse, error) {
+	after *string,
+) (*listProjectTokensResponse, error) {
 	req := &graphql.Request{
-		OpName: "getEnvironments",
+		OpName: "listProjectTokens",
 		Query: `
-query getEnvironments ($projectId: String!) {
-	environments(projectId: $projectId) {
+query listProjectTokens ($projectId: String!, $after: String) {
+	projectTokens(projectId: $projectId, first: 100, after: $after) {
 		edges {
 			node {
-				... Environment
+				id
+				name
+				environmentId
+				createdAt
 			}
 		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
 	}
 }
-fragment Environment on Environment {
-	id
-	name
-	projectId
-}
 `,
-		Variables: &__getEnvironmentsInput{
+		Variables: &__listProjectTokensInput{
 			ProjectId: projectId,
+			After:     after,
 		},
 	}
 	var err error
 
-	var data getEnvironmentsResponse
+	var data listProjectTokensResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4622,36 +12163,52 @@ fragment Environment on Environment {
 	return &data, err
 }
 
-// Get a private network endpoint for a service instance
-func getPrivateNetworkEndpoint(
+func listProjectVolumes(
 	ctx context.Context,
 	client graphql.Client,
-	environmentId *string,
-	privateNetworkId *string,
-	serviceId *string,
-) (*getPrivateNetworkEndpointResponse, error) {
+	projectId string,
+	after *string,
+) (*listProjectVolumesResponse, error) {
 	req := &graphql.Request{
-		OpName: "getPrivateNetworkEndpoint",
+		OpName: "listProjectVolumes",
 		Query: `
-query getPrivateNetworkEndpoint ($environmentId: String!, $privateNetworkId: String!, $serviceId: String!) {
-	privateNetworkEndpoint(environmentId: $environmentId, privateNetworkId: $privateNetworkId, serviceId: $serviceId) {
-		publicId
-		dnsName
-		privateIps
-		serviceInstanceId
-		tags
+query listProjectVolumes ($projectId: String!, $after: String) {
+	project(id: $projectId) {
+		volumes(first: 100, after: $after) {
+			edges {
+				node {
+					id
+					name
+					volumeInstances {
+						edges {
+							node {
+								id
+								environmentId
+								serviceId
+								mountPath
+								sizeMB
+								region
+							}
+						}
+					}
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
 	}
 }
 `,
-		Variables: &__getPrivateNetworkEndpointInput{
-			EnvironmentId:    environmentId,
-			PrivateNetworkId: privateNetworkId,
-			ServiceId:        serviceId,
+		Variables: &__listProjectVolumesInput{
+			ProjectId: projectId,
+			After:     after,
 		},
 	}
 	var err error
 
-	var data getPrivateNetworkEndpointResponse
+	var data listProjectVolumesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4663,34 +12220,40 @@ query getPrivateNetworkEndpoint ($environmentId: String!, $privateNetworkId: Str
 	return &data, err
 }
 
-// List private networks for an environment
-func getPrivateNetworks(
+func listServiceDomains(
 	ctx context.Context,
 	client graphql.Client,
 	environmentId string,
-) (*getPrivateNetworksResponse, error) {
+	serviceId string,
+	projectId string,
+) (*listServiceDomainsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getPrivateNetworks",
+		OpName: "listServiceDomains",
 		Query: `
-query getPrivateNetworks ($environmentId: String!) {
-	privateNetworks(environmentId: $environmentId) {
-		publicId
-		name
-		dnsName
-		networkId
-		environmentId
-		projectId
-		tags
+query listServiceDomains ($environmentId: String!, $serviceId: String!, $projectId: String!) {
+	domains(environmentId: $environmentId, serviceId: $serviceId, projectId: $projectId) {
+		serviceDomains {
+			... ServiceDomain
+		}
 	}
 }
+fragment ServiceDomain on ServiceDomain {
+	id
+	domain
+	suffix
+	environmentId
+	serviceId
+}
 `,
-		Variables: &__getPrivateNetworksInput{
+		Variables: &__listServiceDomainsInput{
 			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+			ProjectId:     projectId,
 		},
 	}
 	var err error
 
-	var data getPrivateNetworksResponse
+	var data listServiceDomainsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4702,46 +12265,36 @@ query getPrivateNetworks ($environmentId: String!) {
 	return &data, err
 }
 
-func getProject(
+// Every project, to find a TCP proxy imported by its id alone
+func listTcpProxyProjects(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getProjectResponse, error) {
+	after *string,
+) (*listTcpProxyProjectsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getProject",
+		OpName: "listTcpProxyProjects",
 		Query: `
-query getProject ($id: String!) {
-	project(id: $id) {
-		... Project
-	}
-}
-fragment Project on Project {
-	id
-	name
-	description
-	isPublic
-	prDeploys
-	workspace {
-		id
-	}
-	environments {
+query listTcpProxyProjects ($after: String) {
+	projects(first: 100, after: $after) {
 		edges {
 			node {
 				id
-				name
-				createdAt
 			}
 		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
 	}
 }
 `,
-		Variables: &__getProjectInput{
-			Id: id,
+		Variables: &__listTcpProxyProjectsInput{
+			After: after,
 		},
 	}
 	var err error
 
-	var data getProjectResponse
+	var data listTcpProxyProjectsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4753,32 +12306,34 @@ fragment Project on Project {
 	return &data, err
 }
 
-func getService(
+func listVolumeInstanceBackups(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getServiceResponse, error) {
+	volumeInstanceId string,
+) (*listVolumeInstanceBackupsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getService",
+		OpName: "listVolumeInstanceBackups",
 		Query: `
-query getService ($id: String!) {
-	service(id: $id) {
-		... Service
+query listVolumeInstanceBackups ($volumeInstanceId: String!) {
+	volumeInstanceBackupList(volumeInstanceId: $volumeInstanceId) {
+		... VolumeInstanceBackup
 	}
 }
-fragment Service on Service {
+fragment VolumeInstanceBackup on VolumeInstanceBackup {
 	id
 	name
-	projectId
+	createdAt
+	expiresAt
+	usedMB
 }
 `,
-		Variables: &__getServiceInput{
-			Id: id,
+		Variables: &__listVolumeInstanceBackupsInput{
+			VolumeInstanceId: volumeInstanceId,
 		},
 	}
 	var err error
 
-	var data getServiceResponse
+	var data listVolumeInstanceBackupsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4790,38 +12345,30 @@ fragment Service on Service {
 	return &data, err
 }
 
-func getServiceInstance(
+func redeployDeployment(
 	ctx context.Context,
 	client graphql.Client,
-	environmentId string,
-	serviceId string,
-) (*getServiceInstanceResponse, error) {
+	id string,
+	usePreviousImageTag bool,
+) (*redeployDeploymentResponse, error) {
 	req := &graphql.Request{
-		OpName: "getServiceInstance",
+		OpName: "redeployDeployment",
 		Query: `
-query getServiceInstance ($environmentId: String!, $serviceId: String!) {
-	serviceInstance(environmentId: $environmentId, serviceId: $serviceId) {
-		source {
-			image
-			repo
-		}
-		rootDirectory
-		railwayConfigFile
-		cronSchedule
-		latestDeployment {
-			meta
-		}
+mutation redeployDeployment ($id: String!, $usePreviousImageTag: Boolean!) {
+	deploymentRedeploy(id: $id, usePreviousImageTag: $usePreviousImageTag) {
+		id
+		status
 	}
 }
 `,
-		Variables: &__getServiceInstanceInput{
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
+		Variables: &__redeployDeploymentInput{
+			Id:                  id,
+			UsePreviousImageTag: usePreviousImageTag,
 		},
 	}
 	var err error
 
-	var data getServiceInstanceResponse
+	var data redeployDeploymentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4833,44 +12380,27 @@ query getServiceInstance ($environmentId: String!, $serviceId: String!) {
 	return &data, err
 }
 
-func getServiceInstanceForResource(
+func redeployServiceInstance(
 	ctx context.Context,
-	client graphql.Client,
-	environmentId string,
-	serviceId string,
-) (*getServiceInstanceForResourceResponse, error) {
-	req := &graphql.Request{
-		OpName: "getServiceInstanceForResource",
-		Query: `
-query getServiceInstanceForResource ($environmentId: String!, $serviceId: String!) {
-	serviceInstance(environmentId: $environmentId, serviceId: $serviceId) {
-		id
-		environmentId
-		serviceId
-		source {
-			image
-			repo
-		}
-		builder
-		buildCommand
-		startCommand
-		preDeployCommand
-		healthcheckPath
-		healthcheckTimeout
-		restartPolicyType
-		restartPolicyMaxRetries
-		sleepApplication
-	}
+	client graphql.Client,
+	environmentId string,
+	serviceId string,
+) (*redeployServiceInstanceResponse, error) {
+	req := &graphql.Request{
+		OpName: "redeployServiceInstance",
+		Query: `
+mutation redeployServiceInstance ($environmentId: String!, $serviceId: String!) {
+	serviceInstanceRedeploy(environmentId: $environmentId, serviceId: $serviceId)
 }
 `,
-		Variables: &__getServiceInstanceForResourceInput{
+		Variables: &__redeployServiceInstanceInput{
 			EnvironmentId: environmentId,
 			ServiceId:     serviceId,
 		},
 	}
 	var err error
 
-	var data getServiceInstanceForResourceResponse
+	var data redeployServiceInstanceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4882,34 +12412,27 @@ query getServiceInstanceForResource ($environmentId: String!, $serviceId: String
 	return &data, err
 }
 
-func getServiceInstances(
+func redeployServiceInstanceWithEnv(
 	ctx context.Context,
 	client graphql.Client,
+	environmentId string,
 	serviceId string,
-) (*getServiceInstancesResponse, error) {
+) (*redeployServiceInstanceWithEnvResponse, error) {
 	req := &graphql.Request{
-		OpName: "getServiceInstances",
+		OpName: "redeployServiceInstanceWithEnv",
 		Query: `
-query getServiceInstances ($serviceId: String!) {
-	service(id: $serviceId) {
-		serviceInstances {
-			edges {
-				node {
-					id
-					environmentId
-				}
-			}
-		}
-	}
+mutation redeployServiceInstanceWithEnv ($environmentId: String!, $serviceId: String!) {
+	serviceInstanceRedeploy(environmentId: $environmentId, serviceId: $serviceId)
 }
 `,
-		Variables: &__getServiceInstancesInput{
-			ServiceId: serviceId,
+		Variables: &__redeployServiceInstanceWithEnvInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
 		},
 	}
 	var err error
 
-	var data getServiceInstancesResponse
+	var data redeployServiceInstanceWithEnvResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4921,27 +12444,27 @@ query getServiceInstances ($serviceId: String!) {
 	return &data, err
 }
 
-func getSharedVariables(
+func removeProjectMember(
 	ctx context.Context,
 	client graphql.Client,
-	projectId string,
-	environmentId string,
-) (*getSharedVariablesResponse, error) {
+	input ProjectMemberRemoveInput,
+) (*removeProjectMemberResponse, error) {
 	req := &graphql.Request{
-		OpName: "getSharedVariables",
+		OpName: "removeProjectMember",
 		Query: `
-query getSharedVariables ($projectId: String!, $environmentId: String!) {
-	variables(environmentId: $environmentId, projectId: $projectId, unrendered: true)
+mutation removeProjectMember ($input: ProjectMemberRemoveInput!) {
+	projectMemberRemove(input: $input) {
+		id
+	}
 }
 `,
-		Variables: &__getSharedVariablesInput{
-			ProjectId:     projectId,
-			EnvironmentId: environmentId,
+		Variables: &__removeProjectMemberInput{
+			Input: input,
 		},
 	}
 	var err error
 
-	var data getSharedVariablesResponse
+	var data removeProjectMemberResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4953,37 +12476,25 @@ query getSharedVariables ($projectId: String!, $environmentId: String!) {
 	return &data, err
 }
 
-func getTcpProxy(
+func removeUsageLimit(
 	ctx context.Context,
 	client graphql.Client,
-	environmentId string,
-	serviceId string,
-) (*getTcpProxyResponse, error) {
+	input UsageLimitRemoveInput,
+) (*removeUsageLimitResponse, error) {
 	req := &graphql.Request{
-		OpName: "getTcpProxy",
+		OpName: "removeUsageLimit",
 		Query: `
-query getTcpProxy ($environmentId: String!, $serviceId: String!) {
-	tcpProxies(environmentId: $environmentId, serviceId: $serviceId) {
-		... TCPProxy
-	}
-}
-fragment TCPProxy on TCPProxy {
-	id
-	applicationPort
-	proxyPort
-	domain
-	environmentId
-	serviceId
+mutation removeUsageLimit ($input: UsageLimitRemoveInput!) {
+	usageLimitRemove(input: $input)
 }
 `,
-		Variables: &__getTcpProxyInput{
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
+		Variables: &__removeUsageLimitInput{
+			Input: input,
 		},
 	}
 	var err error
 
-	var data getTcpProxyResponse
+	var data removeUsageLimitResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4995,29 +12506,25 @@ fragment TCPProxy on TCPProxy {
 	return &data, err
 }
 
-func getVariables(
+func restartDeployment(
 	ctx context.Context,
 	client graphql.Client,
-	projectId string,
-	environmentId string,
-	serviceId string,
-) (*getVariablesResponse, error) {
+	id string,
+) (*restartDeploymentResponse, error) {
 	req := &graphql.Request{
-		OpName: "getVariables",
+		OpName: "restartDeployment",
 		Query: `
-query getVariables ($projectId: String!, $environmentId: String!, $serviceId: String!) {
-	variables(environmentId: $environmentId, projectId: $projectId, serviceId: $serviceId, unrendered: true)
+mutation restartDeployment ($id: String!) {
+	deploymentRestart(id: $id)
 }
 `,
-		Variables: &__getVariablesInput{
-			ProjectId:     projectId,
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
+		Variables: &__restartDeploymentInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data getVariablesResponse
+	var data restartDeploymentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5029,48 +12536,29 @@ query getVariables ($projectId: String!, $environmentId: String!, $serviceId: St
 	return &data, err
 }
 
-func getVolumeInstances(
+func restoreVolumeInstanceBackup(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getVolumeInstancesResponse, error) {
+	volumeInstanceId string,
+	volumeInstanceBackupId string,
+) (*restoreVolumeInstanceBackupResponse, error) {
 	req := &graphql.Request{
-		OpName: "getVolumeInstances",
+		OpName: "restoreVolumeInstanceBackup",
 		Query: `
-query getVolumeInstances ($id: String!) {
-	project(id: $id) {
-		volumes {
-			edges {
-				node {
-					... Volume
-				}
-			}
-		}
-	}
-}
-fragment Volume on Volume {
-	id
-	name
-	volumeInstances {
-		edges {
-			node {
-				id
-				environmentId
-				serviceId
-				mountPath
-				sizeMB
-			}
-		}
+mutation restoreVolumeInstanceBackup ($volumeInstanceId: String!, $volumeInstanceBackupId: String!) {
+	volumeInstanceBackupRestore(volumeInstanceId: $volumeInstanceId, volumeInstanceBackupId: $volumeInstanceBackupId) {
+		workflowId
 	}
 }
 `,
-		Variables: &__getVolumeInstancesInput{
-			Id: id,
+		Variables: &__restoreVolumeInstanceBackupInput{
+			VolumeInstanceId:       volumeInstanceId,
+			VolumeInstanceBackupId: volumeInstanceBackupId,
 		},
 	}
 	var err error
 
-	var data getVolumeInstancesResponse
+	var data restoreVolumeInstanceBackupResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5082,46 +12570,25 @@ fragment Volume on Volume {
 	return &data, err
 }
 
-func listCustomDomains(
+func rollbackDeployment(
 	ctx context.Context,
 	client graphql.Client,
-	environmentId string,
-	serviceId string,
-	projectId string,
-) (*listCustomDomainsResponse, error) {
+	id string,
+) (*rollbackDeploymentResponse, error) {
 	req := &graphql.Request{
-		OpName: "listCustomDomains",
+		OpName: "rollbackDeployment",
 		Query: `
-query listCustomDomains ($environmentId: String!, $serviceId: String!, $projectId: String!) {
-	domains(environmentId: $environmentId, serviceId: $serviceId, projectId: $projectId) {
-		customDomains {
-			... CustomDomain
-		}
-	}
-}
-fragment CustomDomain on CustomDomain {
-	id
-	domain
-	status {
-		dnsRecords {
-			hostlabel
-			requiredValue
-			zone
-		}
-	}
-	environmentId
-	serviceId
+mutation rollbackDeployment ($id: String!) {
+	deploymentRollback(id: $id)
 }
 `,
-		Variables: &__listCustomDomainsInput{
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
-			ProjectId:     projectId,
+		Variables: &__rollbackDeploymentInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data listCustomDomainsResponse
+	var data rollbackDeploymentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5133,36 +12600,26 @@ fragment CustomDomain on CustomDomain {
 	return &data, err
 }
 
-func listDeploymentTriggers(
+// Leaving out the hard limit removes it, so it is always sent
+func setUsageLimit(
 	ctx context.Context,
 	client graphql.Client,
-	projectId string,
-	environmentId string,
-	serviceId string,
-) (*listDeploymentTriggersResponse, error) {
+	input UsageLimitSetInput,
+) (*setUsageLimitResponse, error) {
 	req := &graphql.Request{
-		OpName: "listDeploymentTriggers",
+		OpName: "setUsageLimit",
 		Query: `
-query listDeploymentTriggers ($projectId: String!, $environmentId: String!, $serviceId: String!) {
-	deploymentTriggers(environmentId: $environmentId, projectId: $projectId, serviceId: $serviceId) {
-		edges {
-			node {
-				id
-				branch
-			}
-		}
-	}
+mutation setUsageLimit ($input: UsageLimitSetInput!) {
+	usageLimitSet(input: $input)
 }
 `,
-		Variables: &__listDeploymentTriggersInput{
-			ProjectId:     projectId,
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
+		Variables: &__setUsageLimitInput{
+			Input: input,
 		},
 	}
 	var err error
 
-	var data listDeploymentTriggersResponse
+	var data setUsageLimitResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5174,40 +12631,27 @@ query listDeploymentTriggers ($projectId: String!, $environmentId: String!, $ser
 	return &data, err
 }
 
-func listServiceDomains(
+func transferProject(
 	ctx context.Context,
 	client graphql.Client,
-	environmentId string,
-	serviceId string,
 	projectId string,
-) (*listServiceDomainsResponse, error) {
+	input ProjectTransferInput,
+) (*transferProjectResponse, error) {
 	req := &graphql.Request{
-		OpName: "listServiceDomains",
+		OpName: "transferProject",
 		Query: `
-query listServiceDomains ($environmentId: String!, $serviceId: String!, $projectId: String!) {
-	domains(environmentId: $environmentId, serviceId: $serviceId, projectId: $projectId) {
-		serviceDomains {
-			... ServiceDomain
-		}
-	}
-}
-fragment ServiceDomain on ServiceDomain {
-	id
-	domain
-	suffix
-	environmentId
-	serviceId
+mutation transferProject ($projectId: String!, $input: ProjectTransferInput!) {
+	projectTransfer(projectId: $projectId, input: $input)
 }
 `,
-		Variables: &__listServiceDomainsInput{
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
-			ProjectId:     projectId,
+		Variables: &__transferProjectInput{
+			ProjectId: projectId,
+			Input:     input,
 		},
 	}
 	var err error
 
-	var data listServiceDomainsResponse
+	var data transferProjectResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5219,27 +12663,38 @@ fragment ServiceDomain on ServiceDomain {
 	return &data, err
 }
 
-func redeployServiceInstance(
+func updateDeploymentTrigger(
 	ctx context.Context,
 	client graphql.Client,
-	environmentId string,
-	serviceId string,
-) (*redeployServiceInstanceResponse, error) {
+	id string,
+	input DeploymentTriggerUpdateInput,
+) (*updateDeploymentTriggerResponse, error) {
 	req := &graphql.Request{
-		OpName: "redeployServiceInstance",
+		OpName: "updateDeploymentTrigger",
 		Query: `
-mutation redeployServiceInstance ($environmentId: String!, $serviceId: String!) {
-	serviceInstanceRedeploy(environmentId: $environmentId, serviceId: $serviceId)
+mutation updateDeploymentTrigger ($id: String!, $input: DeploymentTriggerUpdateInput!) {
+	deploymentTriggerUpdate(id: $id, input: $input) {
+		... DeploymentTrigger
+	}
+}
+fragment DeploymentTrigger on DeploymentTrigger {
+	id
+	projectId
+	environmentId
+	serviceId
+	repository
+	branch
+	checkSuites
 }
 `,
-		Variables: &__redeployServiceInstanceInput{
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
+		Variables: &__updateDeploymentTriggerInput{
+			Id:    id,
+			Input: input,
 		},
 	}
 	var err error
 
-	var data redeployServiceInstanceResponse
+	var data updateDeploymentTriggerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5251,27 +12706,29 @@ mutation redeployServiceInstance ($environmentId: String!, $serviceId: String!)
 	return &data, err
 }
 
-func redeployServiceInstanceWithEnv(
+func updateEnvironmentVolumeInstance(
 	ctx context.Context,
 	client graphql.Client,
+	volumeId string,
 	environmentId string,
-	serviceId string,
-) (*redeployServiceInstanceWithEnvResponse, error) {
+	input VolumeInstanceUpdateInput,
+) (*updateEnvironmentVolumeInstanceResponse, error) {
 	req := &graphql.Request{
-		OpName: "redeployServiceInstanceWithEnv",
+		OpName: "updateEnvironmentVolumeInstance",
 		Query: `
-mutation redeployServiceInstanceWithEnv ($environmentId: String!, $serviceId: String!) {
-	serviceInstanceRedeploy(environmentId: $environmentId, serviceId: $serviceId)
+mutation updateEnvironmentVolumeInstance ($volumeId: String!, $environmentId: String!, $input: VolumeInstanceUpdateInput!) {
+	volumeInstanceUpdate(volumeId: $volumeId, environmentId: $environmentId, input: $input)
 }
 `,
-		Variables: &__redeployServiceInstanceWithEnvInput{
+		Variables: &__updateEnvironmentVolumeInstanceInput{
+			VolumeId:      volumeId,
 			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
+			Input:         input,
 		},
 	}
 	var err error
 
-	var data redeployServiceInstanceWithEnvResponse
+	var data updateEnvironmentVolumeInstanceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -5303,6 +12760,7 @@ fragment Project on Project {
 	description
 	isPublic
 	prDeploys
+	botPrEnvironments
 	workspace {
 		id
 	}
@@ -5312,6 +12770,7 @@ fragment Project on Project {
 				id
 				name
 				createdAt
+				isEphemeral
 			}
 		}
 	}
@@ -5336,6 +12795,43 @@ fragment Project on Project {
 	return &data, err
 }
 
+func updateProjectMember(
+	ctx context.Context,
+	client graphql.Client,
+	input ProjectMemberUpdateInput,
+) (*updateProjectMemberResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateProjectMember",
+		Query: `
+mutation updateProjectMember ($input: ProjectMemberUpdateInput!) {
+	projectMemberUpdate(input: $input) {
+		... ProjectMember
+	}
+}
+fragment ProjectMember on ProjectMember {
+	id
+	email
+	role
+}
+`,
+		Variables: &__updateProjectMemberInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateProjectMemberResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func updateService(
 	ctx context.Context,
 	client graphql.Client,
@@ -5530,6 +13026,7 @@ fragment Volume on Volume {
 				serviceId
 				mountPath
 				sizeMB
+				region
 			}
 		}
 	}
@@ -5554,6 +13051,7 @@ fragment Volume on Volume {
 	return &data, err
 }
 
+// Leaving out the service detaches the volume, so it is always sent
 func updateVolumeInstance(
 	ctx context.Context,
 	client graphql.Client,
@@ -108,6 +108,17 @@ func (p *RailwayProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewTcpProxyResource,
 		NewPrivateNetworkResource,
 		NewPrivateNetworkEndpointResource,
+		NewVolumeInstanceResource,
+		NewDeploymentTriggerResource,
+		NewUsageLimitResource,
+		NewServiceRepoConnectionResource,
+		NewTemplateDeployResource,
+		NewVolumeBackupResource,
+		NewEnvironmentConfigResource,
+		NewDeploymentResource,
+		NewProjectMemberResource,
+		NewDeploymentRestartResource,
+		NewDeploymentRollbackResource,
 	}
 }
 
@@ -116,6 +127,28 @@ func (p *RailwayProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewProjectDataSource,
 		NewServiceDataSource,
 		NewEnvironmentDataSource,
+		NewPrivateNetworkDataSource,
+		NewServicesDataSource,
+		NewEnvironmentsDataSource,
+		NewDeploymentsDataSource,
+		NewSharedVariablesDataSource,
+		NewDomainsDataSource,
+		NewCustomDomainDataSource,
+		NewVolumesDataSource,
+		NewServiceInstanceDataSource,
+		NewUsageDataSource,
+		NewPlanLimitsDataSource,
+		NewBuildLogsDataSource,
+		NewProjectTokensDataSource,
+		NewVariablesResolvedDataSource,
+		NewServiceInstanceLimitsDataSource,
+		NewCustomDomainsDataSource,
+		NewEventsDataSource,
+		NewEnvironmentVariablesDataSource,
+		NewVolumeInstanceDataSource,
+		NewDeploymentDataSource,
+		NewMetricsDataSource,
+		NewDotenvDataSource,
 	}
 }
 
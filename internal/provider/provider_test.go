@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -21,3 +26,26 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("RAILWAY_TOKEN must be set for acceptance tests")
 	}
 }
+
+// newTestClient returns a client for a fake Railway API which responds to each operation with the JSON body
+// returned by respond.
+func newTestClient(t *testing.T, respond func(operationName string) string) *graphql.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body struct {
+			OperationName string `json:"operationName"`
+		}
+
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Errorf("unable to decode request: %s", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, respond(body.OperationName))
+	}))
+
+	t.Cleanup(server.Close)
+
+	client := graphql.NewClient(server.URL, server.Client())
+
+	return &client
+}
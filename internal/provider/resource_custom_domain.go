@@ -2,14 +2,19 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -29,14 +34,16 @@ type CustomDomainResource struct {
 }
 
 type CustomDomainResourceModel struct {
-	Id             types.String `tfsdk:"id"`
-	Domain         types.String `tfsdk:"domain"`
-	EnvironmentId  types.String `tfsdk:"environment_id"`
-	ServiceId      types.String `tfsdk:"service_id"`
-	ProjectId      types.String `tfsdk:"project_id"`
-	HostLabel      types.String `tfsdk:"host_label"`
-	Zone           types.String `tfsdk:"zone"`
-	DNSRecordValue types.String `tfsdk:"dns_record_value"`
+	Id                 types.String   `tfsdk:"id"`
+	Domain             types.String   `tfsdk:"domain"`
+	EnvironmentId      types.String   `tfsdk:"environment_id"`
+	ServiceId          types.String   `tfsdk:"service_id"`
+	ProjectId          types.String   `tfsdk:"project_id"`
+	HostLabel          types.String   `tfsdk:"host_label"`
+	Zone               types.String   `tfsdk:"zone"`
+	DNSRecordValue     types.String   `tfsdk:"dns_record_value"`
+	WaitForCertificate types.Bool     `tfsdk:"wait_for_certificate"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *CustomDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -97,6 +104,17 @@ func (r *CustomDomainResource) Schema(ctx context.Context, req resource.SchemaRe
 				MarkdownDescription: "DNS record value of the custom domain.",
 				Computed:            true,
 			},
+			"wait_for_certificate": schema.BoolAttribute{
+				MarkdownDescription: "Wait on create until the certificate of the custom domain has been issued. Running out of the create timeout fails the apply naming the missing DNS records, but keeps the domain in state; untaint it and every refresh resumes waiting until the certificate is issued, instead of recreating it and restarting its DNS verification. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 	}
 }
@@ -164,7 +182,31 @@ func (r *CustomDomainResource) Create(ctx context.Context, req resource.CreateRe
 	data.Zone = types.StringValue(domain.Status.DnsRecords[0].Zone)
 	data.DNSRecordValue = types.StringValue(domain.Status.DnsRecords[0].RequiredValue)
 
+	// The custom domain exists, so keep it in state even if its certificate is never issued
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !data.WaitForCertificate.ValueBool() {
+		return
+	}
+
+	// Read resumes waiting while this is set, so a domain kept after a failed wait isn't recreated
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, customDomainCertificatePendingKey, []byte("true"))...)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, customDomainCertificateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lastSeen, err := waitForCustomDomainCertificate(ctx, *r.client, domain.Id, service.Service.ProjectId, createTimeout)
+
+	if err != nil {
+		resp.Diagnostics.Append(customDomainCertificateError(domain.Domain, lastSeen, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, customDomainCertificatePendingKey, []byte("false"))...)
 }
 
 func (r *CustomDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -214,6 +256,39 @@ func (r *CustomDomainResource) Read(ctx context.Context, req resource.ReadReques
 
 	data.ProjectId = types.StringValue(service.Service.ProjectId)
 
+	// Imported custom domains don't have the argument set
+	if data.WaitForCertificate.IsNull() {
+		data.WaitForCertificate = types.BoolValue(false)
+	}
+
+	pending, diags := req.Private.GetKey(ctx, customDomainCertificatePendingKey)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The certificate wasn't issued when the domain was created, so keep blocking on it until it is
+	if string(pending) == "true" && data.WaitForCertificate.ValueBool() {
+		timeout, diags := data.Timeouts.Create(ctx, customDomainCertificateTimeout)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		lastSeen, err := waitForCustomDomainCertificate(ctx, *r.client, domain.Id, data.ProjectId.ValueString(), timeout)
+
+		if err != nil {
+			resp.Diagnostics.Append(customDomainCertificateError(domain.Domain, lastSeen, err))
+			return
+		}
+	}
+
+	if string(pending) == "true" {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, customDomainCertificatePendingKey, []byte("false"))...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -280,3 +355,123 @@ func (r *CustomDomainResource) ImportState(ctx context.Context, req resource.Imp
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
 }
+
+var (
+	customDomainCertificateTimeout  = 30 * time.Minute
+	customDomainCertificateInterval = 10 * time.Second
+)
+
+// customDomainCertificatePendingKey is the private state key set while the certificate of a custom domain created
+// with wait_for_certificate hasn't been issued.
+const customDomainCertificatePendingKey = "certificate_pending"
+
+// customDomainCertificateError explains why waiting for the certificate of the custom domain failed.
+func customDomainCertificateError(domain string, lastSeen *CustomDomainRecords, err error) diag.Diagnostic {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return diag.NewErrorDiagnostic(
+			"Custom Domain Certificate Not Issued",
+			fmt.Sprintf(
+				"Certificate of custom domain %s was not issued within the create timeout. %s\n\n"+
+					"The custom domain is kept in state but tainted. Run terraform untaint on it to keep it, "+
+					"and the next refresh resumes waiting for the certificate instead of recreating the domain.",
+				domain, customDomainPendingReason(lastSeen),
+			),
+		)
+	}
+
+	return diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to issue certificate of custom domain %s, got error: %s", domain, err))
+}
+
+// waitForCustomDomainCertificate polls the custom domain until its certificate is issued. It returns the custom domain
+// as last seen, so callers can explain what it was still waiting on when the wait failed.
+func waitForCustomDomainCertificate(ctx context.Context, client graphql.Client, id string, projectId string, timeout time.Duration) (*CustomDomainRecords, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var lastSeen *CustomDomainRecords
+	var lastPhase string
+
+	for {
+		response, err := getCustomDomainRecords(ctx, client, id, projectId)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return lastSeen, ctx.Err()
+			}
+
+			return lastSeen, err
+		}
+
+		lastSeen = &response.CustomDomain.CustomDomainRecords
+
+		switch lastSeen.Status.CertificateStatus {
+		case CertificateStatusCertificateStatusTypeValid:
+			tflog.Trace(ctx, "custom domain certificate is issued")
+			return lastSeen, nil
+		case CertificateStatusCertificateStatusTypeIssueFailed:
+			return lastSeen, fmt.Errorf("certificate issuance failed")
+		}
+
+		phase := "issuing certificate"
+
+		if len(customDomainMissingRecords(lastSeen)) > 0 {
+			phase = "waiting for DNS record"
+		}
+
+		if phase != lastPhase {
+			tflog.Info(ctx, phase, map[string]interface{}{
+				"domain":             lastSeen.Domain,
+				"certificate_status": string(lastSeen.Status.CertificateStatus),
+			})
+
+			lastPhase = phase
+		}
+
+		select {
+		case <-ctx.Done():
+			return lastSeen, ctx.Err()
+		case <-time.After(customDomainCertificateInterval):
+		}
+	}
+}
+
+// customDomainMissingRecords returns the DNS records of the custom domain that Railway doesn't see yet.
+func customDomainMissingRecords(customDomain *CustomDomainRecords) []CustomDomainDataSourceDnsRecordModel {
+	missing := []CustomDomainDataSourceDnsRecordModel{}
+
+	for _, record := range customDomainDnsRecords(customDomain) {
+		if record.Status.ValueString() != string(DNSRecordStatusDnsRecordStatusPropagated) {
+			missing = append(missing, record)
+		}
+	}
+
+	return missing
+}
+
+// customDomainPendingReason explains what issuing the certificate is waiting on, naming the DNS records to create.
+func customDomainPendingReason(customDomain *CustomDomainRecords) string {
+	if customDomain == nil {
+		return "Check it in the Railway dashboard."
+	}
+
+	missing := customDomainMissingRecords(customDomain)
+
+	if len(missing) == 0 {
+		return fmt.Sprintf("The DNS records are in place, but the certificate is still %s.", customDomain.Status.CertificateStatus)
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("The following DNS records are missing or have the wrong value:")
+
+	for _, record := range missing {
+		recordType := strings.TrimPrefix(record.RecordType.ValueString(), "DNS_RECORD_TYPE_")
+		builder.WriteString(fmt.Sprintf("\n  %s %s with value %q", recordType, record.Hostname.ValueString(), record.RequiredValue.ValueString()))
+
+		if current := record.CurrentValue.ValueString(); current != "" {
+			builder.WriteString(fmt.Sprintf(" (currently %q)", current))
+		}
+	}
+
+	return builder.String()
+}
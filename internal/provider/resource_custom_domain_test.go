@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -61,3 +67,98 @@ resource "railway_custom_domain" "test" {
 }
 `, name)
 }
+
+func TestWaitForCustomDomainCertificate(t *testing.T) {
+	interval := customDomainCertificateInterval
+	customDomainCertificateInterval = 10 * time.Millisecond
+	t.Cleanup(func() { customDomainCertificateInterval = interval })
+
+	testCases := map[string]struct {
+		statuses      []string
+		recordStatus  string
+		expectTimeout bool
+		expectError   string
+	}{
+		"issued": {
+			statuses:     []string{"CERTIFICATE_STATUS_TYPE_VALIDATING_OWNERSHIP", "CERTIFICATE_STATUS_TYPE_ISSUING", "CERTIFICATE_STATUS_TYPE_VALID"},
+			recordStatus: "DNS_RECORD_STATUS_PROPAGATED",
+		},
+		"issue failed": {
+			statuses:     []string{"CERTIFICATE_STATUS_TYPE_ISSUING", "CERTIFICATE_STATUS_TYPE_ISSUE_FAILED"},
+			recordStatus: "DNS_RECORD_STATUS_PROPAGATED",
+			expectError:  "certificate issuance failed",
+		},
+		"missing record": {
+			statuses:      []string{"CERTIFICATE_STATUS_TYPE_VALIDATING_OWNERSHIP"},
+			recordStatus:  "DNS_RECORD_STATUS_REQUIRES_UPDATE",
+			expectTimeout: true,
+			expectError:   `CNAME app.example.com with value "abc.up.railway.app" (currently "old.example.net")`,
+		},
+		"still issuing": {
+			statuses:      []string{"CERTIFICATE_STATUS_TYPE_ISSUING"},
+			recordStatus:  "DNS_RECORD_STATUS_PROPAGATED",
+			expectTimeout: true,
+			expectError:   "certificate is still CERTIFICATE_STATUS_TYPE_ISSUING",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var polls int32
+
+			client := newTestClient(t, func(operationName string) string {
+				if operationName != "getCustomDomainRecords" {
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+
+				index := int(atomic.AddInt32(&polls, 1)) - 1
+
+				if index >= len(testCase.statuses) {
+					index = len(testCase.statuses) - 1
+				}
+
+				return fmt.Sprintf(`{"data": {"customDomain": {"id": "domain-1", "domain": "app.example.com", "environmentId": "environment-1", "serviceId": "service-1", "projectId": "project-1", "status": {"certificateStatus": "%s", "dnsRecords": [{"recordType": "DNS_RECORD_TYPE_CNAME", "fqdn": "app.example.com", "requiredValue": "abc.up.railway.app", "currentValue": "old.example.net", "status": "%s"}]}}}}`, testCase.statuses[index], testCase.recordStatus)
+			})
+
+			timeout := time.Minute
+
+			if testCase.expectTimeout {
+				timeout = 50 * time.Millisecond
+			}
+
+			lastSeen, err := waitForCustomDomainCertificate(context.Background(), *client, "domain-1", "project-1", timeout)
+
+			if testCase.expectTimeout {
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Fatalf("expected deadline exceeded, got %v", err)
+				}
+
+				// Running out of time fails the apply, naming what is missing
+				diagnostic := customDomainCertificateError("example.com", lastSeen, err)
+
+				if diagnostic.Severity() != diag.SeverityError {
+					t.Errorf("expected an error, got %s", diagnostic.Severity())
+				}
+
+				err = errors.New(diagnostic.Detail())
+			}
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if lastSeen.Status.CertificateStatus != CertificateStatusCertificateStatusTypeValid {
+				t.Errorf("expected a valid certificate, got %s", lastSeen.Status.CertificateStatus)
+			}
+		})
+	}
+}
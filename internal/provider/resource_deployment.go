@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DeploymentResource{}
+var _ resource.ResourceWithImportState = &DeploymentResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentResource{}
+
+func NewDeploymentResource() resource.Resource {
+	return &DeploymentResource{}
+}
+
+type DeploymentResource struct {
+	client *graphql.Client
+}
+
+type DeploymentResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	ServiceId        types.String `tfsdk:"service_id"`
+	EnvironmentId    types.String `tfsdk:"environment_id"`
+	DeploymentId     types.String `tfsdk:"deployment_id"`
+	LiveDeploymentId types.String `tfsdk:"live_deployment_id"`
+	Status           types.String `tfsdk:"status"`
+}
+
+func (r *DeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway deployment. Pins a service instance to an existing deployment by redeploying it, which also rolls back to older deployments. Deploys made outside of terraform show up as drift. Destroying it leaves the live deployment running. Set `redeploy = false` on a `railway_service_instance` of the same service and environment, otherwise its redeploys replace the pinned deployment, and planning both fails unless their identifiers are only known on apply or one of them is left out of a targeted plan. Redeploys other resources would make after the pin in the same apply are skipped with a warning.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployment resource, in the format `service_id:environment_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service to pin the deployment of.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment to pin the deployment in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployment to make live.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"live_deployment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the live deployment. Redeploying creates a new deployment from `deployment_id`, so this differs from it unless `deployment_id` was already live.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the live deployment.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planServiceInstanceDeployer(r.client, data.ServiceId, data.EnvironmentId, "railway_deployment", &resp.Diagnostics)
+}
+
+func (r *DeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(serviceInstanceId(data.ServiceId.ValueString(), data.EnvironmentId.ValueString()))
+
+	if err := deployPinnedDeployment(ctx, *r.client, data, deploymentTimeout); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy deployment, got error: %s", err))
+		return
+	}
+
+	markServiceInstanceRedeployed(r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	tflog.Trace(ctx, "created a deployment")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	live, err := findLiveDeployment(ctx, *r.client, data.ServiceId.ValueString(), data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read live deployment, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(serviceInstanceId(data.ServiceId.ValueString(), data.EnvironmentId.ValueString()))
+
+	if live == nil {
+		// Nothing is live anymore, so the pinned deployment has to be deployed again
+		data.DeploymentId = types.StringNull()
+		data.LiveDeploymentId = types.StringNull()
+		data.Status = types.StringNull()
+	} else {
+		// Deployed outside of terraform, show the live deployment so the plan brings back the pinned one
+		if data.LiveDeploymentId.ValueString() != live.Id {
+			data.DeploymentId = types.StringValue(live.Id)
+		}
+
+		data.LiveDeploymentId = types.StringValue(live.Id)
+		data.Status = types.StringValue(string(live.Status))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deployPinnedDeployment(ctx, *r.client, data, deploymentTimeout); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy deployment, got error: %s", err))
+		return
+	}
+
+	markServiceInstanceRedeployed(r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	tflog.Trace(ctx, "updated a deployment")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing the live deployment would take the service down, so it is left running
+	tflog.Trace(ctx, "deleted a deployment")
+}
+
+func (r *DeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceId, environmentId, err := parseServiceInstanceId(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Unable to import deployment: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+}
+
+var (
+	deploymentTimeout  = 20 * time.Minute
+	deploymentInterval = 5 * time.Second
+)
+
+// deployPinnedDeployment makes the deployment of data live, unless it already is, and waits until the new
+// deployment succeeds.
+func deployPinnedDeployment(ctx context.Context, client graphql.Client, data *DeploymentResourceModel, timeout time.Duration) error {
+	deploymentId := data.DeploymentId.ValueString()
+
+	response, err := getDeployment(ctx, client, deploymentId)
+
+	if err != nil {
+		return err
+	}
+
+	deployment := response.Deployment
+
+	if deployment.ServiceId != data.ServiceId.ValueString() || deployment.EnvironmentId != data.EnvironmentId.ValueString() {
+		return fmt.Errorf("deployment %s doesn't belong to the service in the environment", deploymentId)
+	}
+
+	live, err := findLiveDeployment(ctx, client, data.ServiceId.ValueString(), data.EnvironmentId.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	if live != nil && live.Id == deploymentId {
+		tflog.Trace(ctx, "deployment is already live")
+
+		data.LiveDeploymentId = types.StringValue(live.Id)
+		data.Status = types.StringValue(string(live.Status))
+
+		return nil
+	}
+
+	// Reuse the image of the deployment instead of building the source again
+	redeployed, err := redeployDeployment(ctx, client, deploymentId, true)
+
+	if err != nil {
+		return err
+	}
+
+	tflog.Trace(ctx, "redeployed deployment")
+
+	status, err := waitForDeployment(ctx, client, redeployed.DeploymentRedeploy.Id, timeout)
+
+	if err != nil {
+		return err
+	}
+
+	data.LiveDeploymentId = types.StringValue(redeployed.DeploymentRedeploy.Id)
+	data.Status = types.StringValue(string(status))
+
+	return nil
+}
+
+// waitForDeployment polls the deployment until it is live or has stopped without going live.
+func waitForDeployment(ctx context.Context, client graphql.Client, deploymentId string, timeout time.Duration) (DeploymentStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		response, err := getDeployment(ctx, client, deploymentId)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+
+			return "", err
+		}
+
+		switch status := response.Deployment.Status; status {
+		case DeploymentStatusSuccess, DeploymentStatusSleeping:
+			return status, nil
+		case DeploymentStatusFailed, DeploymentStatusCrashed, DeploymentStatusRemoved, DeploymentStatusSkipped:
+			return "", fmt.Errorf("deployment %s is %s", deploymentId, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(deploymentInterval):
+		}
+	}
+}
+
+// findLiveDeployment returns the newest deployment of the service instance that is serving, or nil when there is
+// none.
+func findLiveDeployment(ctx context.Context, client graphql.Client, serviceId string, environmentId string) (*listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment, error) {
+	input := DeploymentListInput{
+		ServiceId:     serviceId,
+		EnvironmentId: environmentId,
+		Status: &DeploymentStatusInput{
+			In: []DeploymentStatus{DeploymentStatusSuccess, DeploymentStatusSleeping},
+		},
+	}
+
+	var live *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment
+	var after *string
+
+	for {
+		response, err := listDeployments(ctx, client, input, deploymentsPageSize, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		connection := response.Deployments
+
+		for i := range connection.Edges {
+			node := &connection.Edges[i].Node
+
+			if live == nil || node.CreatedAt.After(live.CreatedAt) {
+				live = node
+			}
+		}
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			return live, nil
+		}
+
+		after = &connection.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DeploymentRestartResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentRestartResource{}
+var _ resource.ResourceWithConfigValidators = &DeploymentRestartResource{}
+
+func NewDeploymentRestartResource() resource.Resource {
+	return &DeploymentRestartResource{}
+}
+
+type DeploymentRestartResource struct {
+	client *graphql.Client
+}
+
+type DeploymentRestartResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	ServiceId     types.String   `tfsdk:"service_id"`
+	EnvironmentId types.String   `tfsdk:"environment_id"`
+	Triggers      types.Map      `tfsdk:"triggers"`
+	Redeploy      types.Bool     `tfsdk:"redeploy"`
+	WaitForHealth types.Bool     `tfsdk:"wait_for_health"`
+	DeploymentId  types.String   `tfsdk:"deployment_id"`
+	Status        types.String   `tfsdk:"status"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DeploymentRestartResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_restart"
+}
+
+func (r *DeploymentRestartResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway deployment restart. Restarts the live deployment of a service instance when created and whenever `triggers` change, such as after rotating a secret the service reads on start. Destroying it only stops managing the restarts.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployment restart, in the format `service_id:environment_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service to restart.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment to restart the service in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that restart the service again when they change, such as the versions of the secrets it uses.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"redeploy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to redeploy the image of the live deployment as a new deployment instead of restarting its containers. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_for_health": schema.BoolAttribute{
+				MarkdownDescription: "Wait until the new deployment is live. Only valid with `redeploy`, since a restart keeps the deployment live and Railway reports nothing until its containers are back. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployment that was last restarted, or created by the last redeploy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the deployment after the last restart.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *DeploymentRestartResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare against on create and nothing to plan on destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *DeploymentRestartResourceModel
+	var state *DeploymentRestartResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Triggers.Equal(state.Triggers) {
+		data.DeploymentId = types.StringUnknown()
+		data.Status = types.StringUnknown()
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+	}
+}
+
+func (r *DeploymentRestartResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		waitForHealthRedeployValidator{},
+	}
+}
+
+type waitForHealthRedeployValidator struct{}
+
+func (v waitForHealthRedeployValidator) Description(ctx context.Context) string {
+	return "`wait_for_health` can only be set when `redeploy` is `true`"
+}
+
+func (v waitForHealthRedeployValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v waitForHealthRedeployValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DeploymentRestartResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.WaitForHealth.ValueBool() || data.Redeploy.IsUnknown() {
+		return
+	}
+
+	// The status of a restarted deployment stays SUCCESS, so there is nothing to wait on
+	if !data.Redeploy.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for_health"),
+			"Invalid `wait_for_health` without `redeploy`",
+			"`wait_for_health` can only be set when `redeploy` is `true`, a restart keeps the live deployment and its status doesn't change while the containers restart.",
+		)
+	}
+}
+
+func (r *DeploymentRestartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentRestartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DeploymentRestartResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, deploymentTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(serviceInstanceId(data.ServiceId.ValueString(), data.EnvironmentId.ValueString()))
+
+	if err := restartLiveDeployment(ctx, *r.client, data, createTimeout); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restart deployment, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a deployment restart")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentRestartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Restarts can't be read back, so the state is kept as is
+}
+
+func (r *DeploymentRestartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DeploymentRestartResourceModel
+	var state *DeploymentRestartResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only a change of the triggers restarts, the other attributes are used for the next restart
+	if data.Triggers.Equal(state.Triggers) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, deploymentTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := restartLiveDeployment(ctx, *r.client, data, updateTimeout); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restart deployment, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a deployment restart")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentRestartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Restarts can't be undone, so there is nothing to delete
+	tflog.Trace(ctx, "deleted a deployment restart")
+}
+
+// restartLiveDeployment restarts or redeploys the live deployment of the service instance of data, and waits until
+// the redeployed one is live when asked to.
+func restartLiveDeployment(ctx context.Context, client graphql.Client, data *DeploymentRestartResourceModel, timeout time.Duration) error {
+	live, err := findLiveDeployment(ctx, client, data.ServiceId.ValueString(), data.EnvironmentId.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	if live == nil {
+		return fmt.Errorf("service %s has no live deployment in environment %s", data.ServiceId.ValueString(), data.EnvironmentId.ValueString())
+	}
+
+	deploymentId := live.Id
+	status := live.Status
+
+	if data.Redeploy.ValueBool() {
+		// Reuse the image of the deployment instead of building the source again
+		redeployed, err := redeployDeployment(ctx, client, live.Id, true)
+
+		if err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "redeployed deployment")
+
+		deploymentId = redeployed.DeploymentRedeploy.Id
+		status = redeployed.DeploymentRedeploy.Status
+	} else {
+		if _, err := restartDeployment(ctx, client, live.Id); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "restarted deployment")
+	}
+
+	if data.Redeploy.ValueBool() && data.WaitForHealth.ValueBool() {
+		status, err = waitForDeployment(ctx, client, deploymentId, timeout)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	data.DeploymentId = types.StringValue(deploymentId)
+	data.Status = types.StringValue(string(status))
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentRestartResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeploymentRestartResourceConfigDefault("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_deployment_restart.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_deployment_restart.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("railway_deployment_restart.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_deployment_restart.test", "redeploy", "true"),
+					resource.TestCheckResourceAttr("railway_deployment_restart.test", "wait_for_health", "true"),
+					resource.TestMatchResourceAttr("railway_deployment_restart.test", "deployment_id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_deployment_restart.test", "status", "SUCCESS"),
+				),
+			},
+			// Update the triggers to restart again
+			{
+				Config: testAccDeploymentRestartResourceConfigDefault("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_deployment_restart.test", "triggers.secret", "second"),
+					resource.TestMatchResourceAttr("railway_deployment_restart.test", "deployment_id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_deployment_restart.test", "status", "SUCCESS"),
+				),
+			},
+			// A restart can't be waited on
+			{
+				Config:      testAccDeploymentRestartResourceConfigRestartAndWait(),
+				ExpectError: regexp.MustCompile("`wait_for_health` can only be set when `redeploy` is `true`"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDeploymentRestartResourceConfigRestartAndWait() string {
+	return `
+resource "railway_deployment_restart" "test" {
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  wait_for_health = true
+}
+`
+}
+
+func testAccDeploymentRestartResourceConfigDefault(secret string) string {
+	return fmt.Sprintf(`
+resource "railway_deployment_restart" "test" {
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  redeploy = true
+  wait_for_health = true
+
+  triggers = {
+    secret = "%s"
+  }
+}
+`, secret)
+}
+
+func TestRestartLiveDeployment(t *testing.T) {
+	interval := deploymentInterval
+	deploymentInterval = 10 * time.Millisecond
+	t.Cleanup(func() { deploymentInterval = interval })
+
+	testCases := map[string]struct {
+		live             string
+		redeploy         bool
+		waitForHealth    bool
+		statuses         []string
+		expectError      string
+		expectRestarts   int32
+		expectRedeploys  int32
+		expectDeployment types.String
+		expectStatus     types.String
+	}{
+		"restart": {
+			live:             `[{"node": {"id": "deployment-1", "status": "SUCCESS", "createdAt": "2026-01-02T03:04:05Z"}}]`,
+			expectRestarts:   1,
+			expectDeployment: types.StringValue("deployment-1"),
+			expectStatus:     types.StringValue("SUCCESS"),
+		},
+		"redeploy and wait": {
+			live:             `[{"node": {"id": "deployment-1", "status": "SUCCESS", "createdAt": "2026-01-02T03:04:05Z"}}]`,
+			redeploy:         true,
+			waitForHealth:    true,
+			statuses:         []string{"DEPLOYING", "SUCCESS"},
+			expectRedeploys:  1,
+			expectDeployment: types.StringValue("deployment-3"),
+			expectStatus:     types.StringValue("SUCCESS"),
+		},
+		"redeploy newest": {
+			live:             `[{"node": {"id": "deployment-1", "status": "SUCCESS", "createdAt": "2026-01-02T03:04:05Z"}}, {"node": {"id": "deployment-2", "status": "SLEEPING", "createdAt": "2026-01-03T03:04:05Z"}}]`,
+			redeploy:         true,
+			expectRedeploys:  1,
+			expectDeployment: types.StringValue("deployment-3"),
+			expectStatus:     types.StringValue("INITIALIZING"),
+		},
+		"redeploy and crash": {
+			live:            `[{"node": {"id": "deployment-1", "status": "SUCCESS", "createdAt": "2026-01-02T03:04:05Z"}}]`,
+			redeploy:        true,
+			waitForHealth:   true,
+			statuses:        []string{"BUILDING", "CRASHED"},
+			expectRedeploys: 1,
+			expectError:     "deployment deployment-3 is CRASHED",
+		},
+		"nothing live": {
+			live:        `[]`,
+			expectError: "has no live deployment",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var restarts int32
+			var redeploys int32
+			var statusIndex int32
+
+			client := newTestClient(t, func(operationName string) string {
+				switch operationName {
+				case "listDeployments":
+					return fmt.Sprintf(`{"data": {"deployments": {"edges": %s, "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}`, testCase.live)
+				case "restartDeployment":
+					atomic.AddInt32(&restarts, 1)
+					return `{"data": {"deploymentRestart": true}}`
+				case "redeployDeployment":
+					atomic.AddInt32(&redeploys, 1)
+					return `{"data": {"deploymentRedeploy": {"id": "deployment-3", "status": "INITIALIZING"}}}`
+				case "getDeployment":
+					status := testCase.statuses[atomic.AddInt32(&statusIndex, 1)-1]
+					return fmt.Sprintf(`{"data": {"deployment": {"id": "deployment-3", "status": "%s", "serviceId": "service-1", "environmentId": "environment-1", "createdAt": "2026-01-04T03:04:05Z", "url": "", "meta": null}}}`, status)
+				default:
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+			})
+
+			data := &DeploymentRestartResourceModel{
+				ServiceId:     types.StringValue("service-1"),
+				EnvironmentId: types.StringValue("environment-1"),
+				Redeploy:      types.BoolValue(testCase.redeploy),
+				WaitForHealth: types.BoolValue(testCase.waitForHealth),
+			}
+
+			err := restartLiveDeployment(context.Background(), *client, data, time.Minute)
+
+			if count := atomic.LoadInt32(&restarts); count != testCase.expectRestarts {
+				t.Errorf("expected %d restarts, got %d", testCase.expectRestarts, count)
+			}
+
+			if count := atomic.LoadInt32(&redeploys); count != testCase.expectRedeploys {
+				t.Errorf("expected %d redeploys, got %d", testCase.expectRedeploys, count)
+			}
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !data.DeploymentId.Equal(testCase.expectDeployment) {
+				t.Errorf("expected deployment %s, got %s", testCase.expectDeployment, data.DeploymentId)
+			}
+
+			if !data.Status.Equal(testCase.expectStatus) {
+				t.Errorf("expected status %s, got %s", testCase.expectStatus, data.Status)
+			}
+		})
+	}
+}
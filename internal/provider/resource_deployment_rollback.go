@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DeploymentRollbackResource{}
+
+func NewDeploymentRollbackResource() resource.Resource {
+	return &DeploymentRollbackResource{}
+}
+
+type DeploymentRollbackResource struct {
+	client *graphql.Client
+}
+
+type DeploymentRollbackResourceModel struct {
+	Id               types.String   `tfsdk:"id"`
+	ServiceId        types.String   `tfsdk:"service_id"`
+	EnvironmentId    types.String   `tfsdk:"environment_id"`
+	DeploymentId     types.String   `tfsdk:"deployment_id"`
+	LiveDeploymentId types.String   `tfsdk:"live_deployment_id"`
+	Status           types.String   `tfsdk:"status"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DeploymentRollbackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_rollback"
+}
+
+func (r *DeploymentRollbackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway deployment rollback. Rolls a service instance back to an earlier deployment when created, and again whenever `deployment_id` changes, then waits until the new deployment is live. Destroying it leaves the live deployment running.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployment rollback, in the format `service_id:environment_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service to roll back. The rollback fails when `deployment_id` belongs to another service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment to roll back in. The rollback fails when `deployment_id` belongs to another environment.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployment to roll back to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"live_deployment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployment created by the rollback.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the deployment created by the rollback.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *DeploymentRollbackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentRollbackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DeploymentRollbackResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, deploymentTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(serviceInstanceId(data.ServiceId.ValueString(), data.EnvironmentId.ValueString()))
+
+	if err := rollbackToDeployment(ctx, *r.client, data, createTimeout); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to roll back deployment, got error: %s", err))
+		return
+	}
+
+	markServiceInstanceRedeployed(r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	tflog.Trace(ctx, "created a deployment rollback")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentRollbackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Rollbacks can't be read back, so the state is kept as is
+}
+
+func (r *DeploymentRollbackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DeploymentRollbackResourceModel
+
+	// Every attribute but the timeouts requires replace, so there is nothing to roll back
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentRollbackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing the live deployment would take the service down, so it is left running
+	tflog.Trace(ctx, "deleted a deployment rollback")
+}
+
+// rollbackToDeployment rolls the service instance of data back to its deployment and waits until the deployment
+// created by the rollback is live.
+func rollbackToDeployment(ctx context.Context, client graphql.Client, data *DeploymentRollbackResourceModel, timeout time.Duration) error {
+	deploymentId := data.DeploymentId.ValueString()
+	serviceId := data.ServiceId.ValueString()
+	environmentId := data.EnvironmentId.ValueString()
+
+	response, err := getDeploymentRollbackTarget(ctx, client, deploymentId)
+
+	if err != nil {
+		return err
+	}
+
+	target := response.Deployment
+
+	if target.ServiceId != serviceId || target.EnvironmentId != environmentId {
+		return fmt.Errorf("deployment %s doesn't belong to the service in the environment", deploymentId)
+	}
+
+	if !target.CanRollback {
+		return fmt.Errorf("deployment %s can't be rolled back to", deploymentId)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	deployments, err := listDeploymentsOldestFirst(ctx, client, serviceId, environmentId)
+
+	if err != nil {
+		return err
+	}
+
+	// Deployments are dated by Railway, so the rollback is told apart by being newer than the newest one before it
+	var since time.Time
+
+	if len(deployments) > 0 {
+		since = deployments[len(deployments)-1].CreatedAt
+	}
+
+	if _, err := rollbackDeployment(ctx, client, deploymentId); err != nil {
+		return err
+	}
+
+	tflog.Trace(ctx, "rolled back deployment")
+
+	// The rollback doesn't return the deployment it creates, so wait for a newer deployment of the same source
+	var rolledBack string
+
+	for {
+		deployments, err := listDeploymentsOldestFirst(ctx, client, serviceId, environmentId)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return err
+		}
+
+		rolledBack = findRollbackDeployment(deployments, since, target.Meta)
+
+		if rolledBack != "" {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(deploymentInterval):
+		}
+	}
+
+	status, err := waitForDeployment(ctx, client, rolledBack, timeout)
+
+	if err != nil {
+		return err
+	}
+
+	data.LiveDeploymentId = types.StringValue(rolledBack)
+	data.Status = types.StringValue(string(status))
+
+	return nil
+}
+
+// listDeploymentsOldestFirst returns every deployment of the service instance, whatever its status, sorted by
+// creation since the API doesn't guarantee an order.
+func listDeploymentsOldestFirst(ctx context.Context, client graphql.Client, serviceId string, environmentId string) ([]listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment, error) {
+	input := DeploymentListInput{
+		ServiceId:     serviceId,
+		EnvironmentId: environmentId,
+	}
+
+	var nodes []listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment
+	var after *string
+
+	for {
+		response, err := listDeployments(ctx, client, input, deploymentsPageSize, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		connection := response.Deployments
+
+		for _, edge := range connection.Edges {
+			nodes = append(nodes, edge.Node)
+		}
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			break
+		}
+
+		after = &connection.PageInfo.EndCursor
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].CreatedAt.Before(nodes[j].CreatedAt)
+	})
+
+	return nodes, nil
+}
+
+// findRollbackDeployment returns the identifier of the oldest deployment created after since from the same source
+// as the rolled back deployment, or an empty string when there is none yet.
+func findRollbackDeployment(deployments []listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment, since time.Time, source map[string]interface{}) string {
+	for _, deployment := range deployments {
+		if !deployment.CreatedAt.After(since) {
+			continue
+		}
+
+		// A rollback redeploys the image built for the deployment, so it keeps its image and commit
+		if deploymentMetaString(deployment.Meta, "image").Equal(deploymentMetaString(source, "image")) &&
+			deploymentMetaString(deployment.Meta, "commitHash").Equal(deploymentMetaString(source, "commitHash")) {
+			return deployment.Id
+		}
+	}
+
+	return ""
+}
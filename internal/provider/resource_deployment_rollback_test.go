@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentRollbackResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeploymentRollbackResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_deployment_rollback.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttrPair("railway_deployment_rollback.test", "deployment_id", "data.railway_deployments.test", "deployments.1.id"),
+					resource.TestMatchResourceAttr("railway_deployment_rollback.test", "live_deployment_id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_deployment_rollback.test", "status", "SUCCESS"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDeploymentRollbackResourceConfigDefault() string {
+	return `
+data "railway_deployments" "test" {
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  limit = 2
+}
+
+resource "railway_deployment_rollback" "test" {
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  deployment_id = data.railway_deployments.test.deployments[1].id
+}
+`
+}
+
+func TestRollbackToDeployment(t *testing.T) {
+	interval := deploymentInterval
+	deploymentInterval = 10 * time.Millisecond
+	t.Cleanup(func() { deploymentInterval = interval })
+
+	target := `{"node": {"id": "deployment-1", "status": "REMOVED", "createdAt": "2026-01-02T03:04:05Z", "meta": {"image": "ghcr.io/myorg/api:v1"}}}`
+	live := `{"node": {"id": "deployment-2", "status": "SUCCESS", "createdAt": "2026-01-03T03:04:05Z", "meta": {"image": "ghcr.io/myorg/api:v2"}}}`
+	rollback := `{"node": {"id": "deployment-3", "status": "BUILDING", "createdAt": "2026-01-04T03:04:05Z", "meta": {"image": "ghcr.io/myorg/api:v1"}}}`
+	unrelated := `{"node": {"id": "deployment-4", "status": "BUILDING", "createdAt": "2026-01-04T03:04:04Z", "meta": {"image": "ghcr.io/myorg/api:v3"}}}`
+
+	testCases := map[string]struct {
+		serviceId      string
+		canRollback    bool
+		deployments    []string
+		statuses       []string
+		expectError    string
+		expectRollback bool
+		expectLive     types.String
+		expectStatus   types.String
+	}{
+		"rolled back": {
+			serviceId:      "service-1",
+			canRollback:    true,
+			deployments:    []string{target + ", " + live, target + ", " + live, target + ", " + live + ", " + rollback},
+			statuses:       []string{"DEPLOYING", "SUCCESS"},
+			expectRollback: true,
+			expectLive:     types.StringValue("deployment-3"),
+			expectStatus:   types.StringValue("SUCCESS"),
+		},
+		"listed out of order": {
+			serviceId:      "service-1",
+			canRollback:    true,
+			deployments:    []string{live + ", " + target, rollback + ", " + live + ", " + target},
+			statuses:       []string{"SUCCESS"},
+			expectRollback: true,
+			expectLive:     types.StringValue("deployment-3"),
+			expectStatus:   types.StringValue("SUCCESS"),
+		},
+		"other deployment first": {
+			serviceId:      "service-1",
+			canRollback:    true,
+			deployments:    []string{target + ", " + live, target + ", " + live + ", " + unrelated, target + ", " + live + ", " + unrelated + ", " + rollback},
+			statuses:       []string{"SUCCESS"},
+			expectRollback: true,
+			expectLive:     types.StringValue("deployment-3"),
+			expectStatus:   types.StringValue("SUCCESS"),
+		},
+		"crashed": {
+			serviceId:      "service-1",
+			canRollback:    true,
+			deployments:    []string{target + ", " + live, target + ", " + live + ", " + rollback},
+			statuses:       []string{"CRASHED"},
+			expectRollback: true,
+			expectError:    "deployment deployment-3 is CRASHED",
+		},
+		"other service": {
+			serviceId:   "service-2",
+			canRollback: true,
+			expectError: "doesn't belong to the service",
+		},
+		"not rollbackable": {
+			serviceId:   "service-1",
+			expectError: "can't be rolled back to",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var rollbacks int32
+			var listIndex int32
+			var statusIndex int32
+
+			client := newTestClient(t, func(operationName string) string {
+				switch operationName {
+				case "getDeploymentRollbackTarget":
+					return fmt.Sprintf(`{"data": {"deployment": {"id": "deployment-1", "serviceId": "%s", "environmentId": "environment-1", "canRollback": %t, "meta": {"image": "ghcr.io/myorg/api:v1"}}}}`, testCase.serviceId, testCase.canRollback)
+				case "listDeployments":
+					index := min(int(atomic.AddInt32(&listIndex, 1))-1, len(testCase.deployments)-1)
+					return fmt.Sprintf(`{"data": {"deployments": {"edges": [%s], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}`, testCase.deployments[index])
+				case "rollbackDeployment":
+					atomic.AddInt32(&rollbacks, 1)
+					return `{"data": {"deploymentRollback": true}}`
+				case "getDeployment":
+					status := testCase.statuses[atomic.AddInt32(&statusIndex, 1)-1]
+					return fmt.Sprintf(`{"data": {"deployment": {"id": "deployment-3", "status": "%s", "serviceId": "service-1", "environmentId": "environment-1", "createdAt": "2026-01-04T03:04:05Z", "url": "", "meta": null}}}`, status)
+				default:
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+			})
+
+			data := &DeploymentRollbackResourceModel{
+				ServiceId:     types.StringValue("service-1"),
+				EnvironmentId: types.StringValue("environment-1"),
+				DeploymentId:  types.StringValue("deployment-1"),
+			}
+
+			err := rollbackToDeployment(context.Background(), *client, data, time.Minute)
+
+			if rolledBack := atomic.LoadInt32(&rollbacks) > 0; rolledBack != testCase.expectRollback {
+				t.Errorf("expected rollback %t, got %t", testCase.expectRollback, rolledBack)
+			}
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !data.LiveDeploymentId.Equal(testCase.expectLive) {
+				t.Errorf("expected live deployment %s, got %s", testCase.expectLive, data.LiveDeploymentId)
+			}
+
+			if !data.Status.Equal(testCase.expectStatus) {
+				t.Errorf("expected status %s, got %s", testCase.expectStatus, data.Status)
+			}
+		})
+	}
+}
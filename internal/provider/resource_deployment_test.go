@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeploymentResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_deployment.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_deployment.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("railway_deployment.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttrPair("railway_deployment.test", "deployment_id", "data.railway_deployments.test", "deployments.0.id"),
+					resource.TestCheckResourceAttrSet("railway_deployment.test", "live_deployment_id"),
+					resource.TestCheckResourceAttr("railway_deployment.test", "status", "SUCCESS"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "railway_deployment.test",
+				ImportState:             true,
+				ImportStateId:           "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deployment_id"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDeploymentResourceConfigDefault() string {
+	return `
+data "railway_deployments" "test" {
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  status = "SUCCESS"
+  limit = 1
+}
+
+resource "railway_deployment" "test" {
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  deployment_id = data.railway_deployments.test.deployments[0].id
+}
+`
+}
+
+func TestDeployPinnedDeployment(t *testing.T) {
+	interval := deploymentInterval
+	deploymentInterval = 10 * time.Millisecond
+	t.Cleanup(func() { deploymentInterval = interval })
+
+	testCases := map[string]struct {
+		serviceId      string
+		live           string
+		statuses       []string
+		expectError    string
+		expectRedeploy bool
+		expectLive     types.String
+		expectStatus   types.String
+	}{
+		"already live": {
+			serviceId:    "service-1",
+			live:         `[{"node": {"id": "deployment-1", "status": "SUCCESS", "createdAt": "2026-01-02T03:04:05Z"}}]`,
+			expectLive:   types.StringValue("deployment-1"),
+			expectStatus: types.StringValue("SUCCESS"),
+		},
+		"rolled back": {
+			serviceId:      "service-1",
+			live:           `[{"node": {"id": "deployment-2", "status": "SUCCESS", "createdAt": "2026-01-02T03:04:05Z"}}, {"node": {"id": "deployment-3", "status": "SLEEPING", "createdAt": "2026-01-03T03:04:05Z"}}]`,
+			statuses:       []string{"BUILDING", "DEPLOYING", "SUCCESS"},
+			expectRedeploy: true,
+			expectLive:     types.StringValue("deployment-4"),
+			expectStatus:   types.StringValue("SUCCESS"),
+		},
+		"nothing live": {
+			serviceId:      "service-1",
+			live:           `[]`,
+			statuses:       []string{"SUCCESS"},
+			expectRedeploy: true,
+			expectLive:     types.StringValue("deployment-4"),
+			expectStatus:   types.StringValue("SUCCESS"),
+		},
+		"crashed": {
+			serviceId:      "service-1",
+			live:           `[{"node": {"id": "deployment-2", "status": "SUCCESS", "createdAt": "2026-01-02T03:04:05Z"}}]`,
+			statuses:       []string{"DEPLOYING", "CRASHED"},
+			expectRedeploy: true,
+			expectError:    "deployment deployment-4 is CRASHED",
+		},
+		"other service": {
+			serviceId:   "service-2",
+			expectError: "doesn't belong to the service",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var redeploys int32
+			var statusIndex int32
+
+			client := newTestClient(t, func(operationName string) string {
+				switch operationName {
+				case "getDeployment":
+					if atomic.LoadInt32(&redeploys) == 0 {
+						return fmt.Sprintf(`{"data": {"deployment": {"id": "deployment-1", "status": "REMOVED", "serviceId": "%s", "environmentId": "environment-1", "createdAt": "2026-01-01T03:04:05Z", "url": "", "meta": null}}}`, testCase.serviceId)
+					}
+
+					status := testCase.statuses[atomic.AddInt32(&statusIndex, 1)-1]
+					return fmt.Sprintf(`{"data": {"deployment": {"id": "deployment-4", "status": "%s", "serviceId": "service-1", "environmentId": "environment-1", "createdAt": "2026-01-04T03:04:05Z", "url": "", "meta": null}}}`, status)
+				case "listDeployments":
+					return fmt.Sprintf(`{"data": {"deployments": {"edges": %s, "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}`, testCase.live)
+				case "redeployDeployment":
+					atomic.AddInt32(&redeploys, 1)
+					return `{"data": {"deploymentRedeploy": {"id": "deployment-4", "status": "INITIALIZING"}}}`
+				default:
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+			})
+
+			data := &DeploymentResourceModel{
+				ServiceId:     types.StringValue("service-1"),
+				EnvironmentId: types.StringValue("environment-1"),
+				DeploymentId:  types.StringValue("deployment-1"),
+			}
+
+			err := deployPinnedDeployment(context.Background(), *client, data, time.Minute)
+
+			if redeployed := atomic.LoadInt32(&redeploys) > 0; redeployed != testCase.expectRedeploy {
+				t.Errorf("expected redeploy %t, got %t", testCase.expectRedeploy, redeployed)
+			}
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !data.LiveDeploymentId.Equal(testCase.expectLive) {
+				t.Errorf("expected live deployment %s, got %s", testCase.expectLive, data.LiveDeploymentId)
+			}
+
+			if !data.Status.Equal(testCase.expectStatus) {
+				t.Errorf("expected status %s, got %s", testCase.expectStatus, data.Status)
+			}
+		})
+	}
+}
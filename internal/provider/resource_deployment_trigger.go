@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DeploymentTriggerResource{}
+var _ resource.ResourceWithImportState = &DeploymentTriggerResource{}
+
+// Railway only supports GitHub repositories as deployment trigger sources
+const deploymentTriggerProvider = "github"
+
+func NewDeploymentTriggerResource() resource.Resource {
+	return &DeploymentTriggerResource{}
+}
+
+type DeploymentTriggerResource struct {
+	client *graphql.Client
+}
+
+type DeploymentTriggerResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	ProjectId     types.String `tfsdk:"project_id"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	Repository    types.String `tfsdk:"repository"`
+	Branch        types.String `tfsdk:"branch"`
+	WaitForCi     types.Bool   `tfsdk:"wait_for_ci"`
+}
+
+func (r *DeploymentTriggerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_trigger"
+}
+
+func (r *DeploymentTriggerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway deployment trigger. Deploys a service in an environment when a branch of a GitHub repository is pushed to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployment trigger.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project the deployment trigger belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service to deploy.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment to deploy to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "GitHub repository that triggers the deployments, in the format `owner/name`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^/\s]+/[^/\s]+$`), "must be a repository in the format owner/name"),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch of the repository that triggers the deployments.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"wait_for_ci": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the GitHub check suites of a commit to pass before deploying it. **Default** `false`, like in Railway.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *DeploymentTriggerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentTriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DeploymentTriggerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := DeploymentTriggerCreateInput{
+		ProjectId:     data.ProjectId.ValueString(),
+		ServiceId:     data.ServiceId.ValueString(),
+		EnvironmentId: data.EnvironmentId.ValueString(),
+		Provider:      deploymentTriggerProvider,
+		Repository:    data.Repository.ValueString(),
+		Branch:        data.Branch.ValueString(),
+		CheckSuites:   data.WaitForCi.ValueBoolPointer(),
+	}
+
+	response, err := createDeploymentTrigger(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create deployment trigger, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a deployment trigger")
+
+	setDeploymentTrigger(data, &response.DeploymentTriggerCreate.DeploymentTrigger)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentTriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DeploymentTriggerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trigger, err := findDeploymentTrigger(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment trigger, got error: %s", err))
+		return
+	}
+
+	// Deleted outside of terraform, let it be created again
+	if trigger == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setDeploymentTrigger(data, trigger)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DeploymentTriggerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := DeploymentTriggerUpdateInput{
+		Branch:      data.Branch.ValueStringPointer(),
+		CheckSuites: data.WaitForCi.ValueBoolPointer(),
+	}
+
+	response, err := updateDeploymentTrigger(ctx, *r.client, data.Id.ValueString(), input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update deployment trigger, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a deployment trigger")
+
+	setDeploymentTrigger(data, &response.DeploymentTriggerUpdate.DeploymentTrigger)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentTriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DeploymentTriggerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteDeploymentTrigger(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete deployment trigger, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a deployment trigger")
+}
+
+func (r *DeploymentTriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// findDeploymentTrigger looks up a deployment trigger by id, returning nil when it doesn't exist.
+func findDeploymentTrigger(ctx context.Context, client graphql.Client, id string) (*DeploymentTrigger, error) {
+	response, err := getDeploymentTrigger(ctx, client, id)
+
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	node, ok := response.Node.(*getDeploymentTriggerNodeDeploymentTrigger)
+
+	if !ok {
+		return nil, nil
+	}
+
+	return &node.DeploymentTrigger, nil
+}
+
+func setDeploymentTrigger(data *DeploymentTriggerResourceModel, trigger *DeploymentTrigger) {
+	data.Id = types.StringValue(trigger.Id)
+	data.ProjectId = types.StringValue(trigger.ProjectId)
+	data.EnvironmentId = types.StringValue(trigger.EnvironmentId)
+	data.ServiceId = types.StringPointerValue(trigger.ServiceId)
+	data.Repository = types.StringValue(trigger.Repository)
+	data.Branch = types.StringValue(trigger.Branch)
+	data.WaitForCi = types.BoolValue(trigger.CheckSuites)
+}
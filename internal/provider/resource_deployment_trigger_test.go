@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentTriggerResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeploymentTriggerResourceConfigDefault("main"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_deployment_trigger.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_deployment_trigger.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_deployment_trigger.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("railway_deployment_trigger.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_deployment_trigger.test", "repository", "railwayapp/starters"),
+					resource.TestCheckResourceAttr("railway_deployment_trigger.test", "branch", "main"),
+					resource.TestCheckResourceAttr("railway_deployment_trigger.test", "wait_for_ci", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_deployment_trigger.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDeploymentTriggerResourceConfigDefault("staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_deployment_trigger.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_deployment_trigger.test", "repository", "railwayapp/starters"),
+					resource.TestCheckResourceAttr("railway_deployment_trigger.test", "branch", "staging"),
+				),
+			},
+			// Update wait for CI testing
+			{
+				Config: testAccDeploymentTriggerResourceConfigWaitForCi("staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_deployment_trigger.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_deployment_trigger.test", "branch", "staging"),
+					resource.TestCheckResourceAttr("railway_deployment_trigger.test", "wait_for_ci", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDeploymentTriggerResourceConfigDefault(branch string) string {
+	return fmt.Sprintf(`
+resource "railway_deployment_trigger" "test" {
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  repository = "railwayapp/starters"
+  branch = "%s"
+}
+`, branch)
+}
+
+func testAccDeploymentTriggerResourceConfigWaitForCi(branch string) string {
+	return fmt.Sprintf(`
+resource "railway_deployment_trigger" "test" {
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  repository = "railwayapp/starters"
+  branch = "%s"
+  wait_for_ci = true
+}
+`, branch)
+}
@@ -3,14 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -29,9 +38,16 @@ type EnvironmentResource struct {
 }
 
 type EnvironmentResourceModel struct {
-	Id       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	ProjecId types.String `tfsdk:"project_id"`
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	ProjecId            types.String `tfsdk:"project_id"`
+	SourceEnvironmentId types.String `tfsdk:"source_environment_id"`
+	IsEphemeral         types.Bool   `tfsdk:"is_ephemeral"`
+	CloneVariables      types.Bool   `tfsdk:"clone_variables"`
+	CloneVolumes        types.Bool   `tfsdk:"clone_volumes"`
+	ExcludeServices     types.Set    `tfsdk:"exclude_services"`
+	ServiceIds          types.Set    `tfsdk:"service_ids"`
+	VolumeIds           types.Set    `tfsdk:"volume_ids"`
 }
 
 func (r *EnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,6 +85,68 @@ func (r *EnvironmentResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
 				},
 			},
+			"source_environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment to fork. The new environment starts with a copy of its services, volumes, configuration and variables.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"is_ephemeral": schema.BoolAttribute{
+				MarkdownDescription: "Whether the environment is ephemeral, like the environments Railway creates for pull requests. Railway may delete ephemeral environments on its own. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"clone_variables": schema.BoolAttribute{
+				MarkdownDescription: "Whether the fork keeps the variables of the source environment. When `false`, the variables are removed from the fork right after it is created. **Default** `true`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("source_environment_id")),
+				},
+			},
+			"clone_volumes": schema.BoolAttribute{
+				MarkdownDescription: "Whether the fork keeps the volumes of the source environment. When `false`, the volumes are removed from the fork right after it is created. **Default** `true`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("source_environment_id")),
+				},
+			},
+			"exclude_services": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the services of the source environment to remove from the fork right after it is created.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(uuidRegex(), "must be an id")),
+					setvalidator.AlsoRequires(path.MatchRoot("source_environment_id")),
+				},
+			},
+			"service_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the services in the environment.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"volume_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the volumes in the environment.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -105,6 +183,27 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 	input := EnvironmentCreateInput{
 		Name:      data.Name.ValueString(),
 		ProjectId: data.ProjecId.ValueString(),
+		Ephemeral: data.IsEphemeral.ValueBool(),
+	}
+
+	var excludeServices []string
+
+	if !data.ExcludeServices.IsNull() {
+		resp.Diagnostics.Append(data.ExcludeServices.ElementsAs(ctx, &excludeServices, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.SourceEnvironmentId.IsNull() {
+		input.SourceEnvironmentId = data.SourceEnvironmentId.ValueStringPointer()
+
+		// Fail before forking when the services can't be excluded
+		if err := checkServicesInEnvironment(ctx, *r.client, data.SourceEnvironmentId.ValueString(), excludeServices); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to exclude services from the forked environment, got error: %s", err))
+			return
+		}
 	}
 
 	response, err := createEnvironment(ctx, *r.client, input)
@@ -118,9 +217,43 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 
 	environment := response.EnvironmentCreate.Environment
 
+	if !data.SourceEnvironmentId.IsNull() {
+		err := waitForForkedServices(ctx, *r.client, environment.Id, data.SourceEnvironmentId.ValueString(), environmentForkReadyTimeout)
+
+		if err != nil {
+			// Save the environment so it is not orphaned, it gets replaced on the next apply
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), environment.Id)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for the services of the forked environment, got error: %s", err))
+			return
+		}
+
+		options := environmentForkOptions{
+			ExcludeServices: excludeServices,
+			SkipVariables:   !data.CloneVariables.IsNull() && !data.CloneVariables.ValueBool(),
+			SkipVolumes:     !data.CloneVolumes.IsNull() && !data.CloneVolumes.ValueBool(),
+		}
+
+		if err := reconcileForkedEnvironment(ctx, *r.client, environment.ProjectId, environment.Id, options); err != nil {
+			// Save the environment so it is not orphaned, it gets replaced on the next apply
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), environment.Id)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove what shouldn't be cloned from the forked environment, got error: %s", err))
+			return
+		}
+	}
+
+	instances, err := getEnvironment(ctx, *r.client, environment.Id)
+
+	if err != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), environment.Id)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+
 	data.Id = types.StringValue(environment.Id)
 	data.Name = types.StringValue(environment.Name)
 	data.ProjecId = types.StringValue(environment.ProjectId)
+	data.IsEphemeral = types.BoolValue(environment.IsEphemeral)
+	data.ServiceIds, data.VolumeIds = environmentInstanceIds(&instances.Environment)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -137,6 +270,12 @@ func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest
 	response, err := getEnvironment(ctx, *r.client, data.Id.ValueString())
 
 	if err != nil {
+		// Deleted outside of terraform, let it be created again
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
 		return
 	}
@@ -146,19 +285,26 @@ func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest
 	data.Id = types.StringValue(environment.Id)
 	data.Name = types.StringValue(environment.Name)
 	data.ProjecId = types.StringValue(environment.ProjectId)
+	data.IsEphemeral = types.BoolValue(environment.IsEphemeral)
+	data.ServiceIds, data.VolumeIds = environmentInstanceIds(&response.Environment)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var state *EnvironmentResourceModel
+	var prior *EnvironmentResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	state.ServiceIds = prior.ServiceIds
+	state.VolumeIds = prior.VolumeIds
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -173,6 +319,12 @@ func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	_, err := deleteEnvironment(ctx, *r.client, data.Id.ValueString())
 
+	// Ephemeral environments can be deleted by Railway before terraform gets to it
+	if err != nil && isNotFoundError(err) {
+		tflog.Trace(ctx, "environment was already deleted")
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete environment, got error: %s", err))
 		return
@@ -204,17 +356,270 @@ func (r *EnvironmentResource) ImportState(ctx context.Context, req resource.Impo
 }
 
 func findEnvironment(ctx context.Context, client graphql.Client, projectId string, name string) (*string, error) {
-	response, err := getEnvironments(ctx, client, projectId)
+	environments, err := listAllEnvironments(ctx, client, projectId, nil)
 
 	if err != nil {
 		return nil, err
 	}
 
-	for _, environment := range response.Environments.Edges {
-		if environment.Node.Name == name {
-			return &environment.Node.Id, nil
+	for _, environment := range environments {
+		if environment.Name == name {
+			return &environment.Id, nil
 		}
 	}
 
 	return nil, fmt.Errorf("environment doesn't exist in the project")
 }
+
+var (
+	environmentForkReadyTimeout  = 10 * time.Minute
+	environmentForkReadyInterval = 3 * time.Second
+)
+
+// waitForForkedServices polls the forked environment until it has an instance of every service of the source
+// environment, since Railway copies them after the environment itself is created.
+func waitForForkedServices(ctx context.Context, client graphql.Client, environmentId string, sourceEnvironmentId string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	source, err := listAllEnvironmentServiceIds(ctx, client, sourceEnvironmentId)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		forked, err := listAllEnvironmentServiceIds(ctx, client, environmentId)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return err
+		}
+
+		missing := slices.DeleteFunc(slices.Clone(source), func(serviceId string) bool {
+			return slices.Contains(forked, serviceId)
+		})
+
+		if len(missing) == 0 {
+			tflog.Trace(ctx, "forked environment is ready")
+			return nil
+		}
+
+		tflog.Trace(ctx, "waiting for services to be copied to the forked environment", map[string]interface{}{"missing": missing})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(environmentForkReadyInterval):
+		}
+	}
+}
+
+// environmentForkOptions are what isn't cloned into a forked environment. Railway always clones everything, so
+// these are removed from the fork after it is created.
+type environmentForkOptions struct {
+	ExcludeServices []string
+	SkipVariables   bool
+	SkipVolumes     bool
+}
+
+// checkServicesInEnvironment returns an error listing the services that have no instance in the environment.
+func checkServicesInEnvironment(ctx context.Context, client graphql.Client, environmentId string, serviceIds []string) error {
+	if len(serviceIds) == 0 {
+		return nil
+	}
+
+	existingIds, err := listAllEnvironmentServiceIds(ctx, client, environmentId)
+
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+
+	for _, serviceId := range existingIds {
+		existing[serviceId] = true
+	}
+
+	var missing []string
+
+	for _, serviceId := range serviceIds {
+		if !existing[serviceId] {
+			missing = append(missing, serviceId)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("services %s are not in environment %s", strings.Join(missing, ", "), environmentId)
+	}
+
+	return nil
+}
+
+// reconcileForkedEnvironment removes the excluded services, the volumes and the variables from the forked
+// environment according to the options.
+func reconcileForkedEnvironment(ctx context.Context, client graphql.Client, projectId string, environmentId string, options environmentForkOptions) error {
+	if len(options.ExcludeServices) > 0 {
+		if err := removeEnvironmentServices(ctx, client, environmentId, options.ExcludeServices, environmentForkReadyTimeout); err != nil {
+			return err
+		}
+	}
+
+	if options.SkipVolumes {
+		if err := removeEnvironmentVolumes(ctx, client, environmentId, environmentForkReadyTimeout); err != nil {
+			return err
+		}
+	}
+
+	if options.SkipVariables {
+		existingIds, err := listAllEnvironmentServiceIds(ctx, client, environmentId)
+
+		if err != nil {
+			return err
+		}
+
+		// Shared variables first, then the variables of every service
+		serviceIds := []*string{nil}
+
+		for _, serviceId := range existingIds {
+			serviceIds = append(serviceIds, &serviceId)
+		}
+
+		for _, serviceId := range serviceIds {
+			_, err := upsertVariableCollection(ctx, client, VariableCollectionUpsertInput{
+				ProjectId:     projectId,
+				EnvironmentId: environmentId,
+				ServiceId:     serviceId,
+				Variables:     map[string]interface{}{},
+				Replace:       true,
+				SkipDeploys:   true,
+			})
+
+			if err != nil {
+				return fmt.Errorf("unable to remove variables: %w", err)
+			}
+		}
+
+		tflog.Trace(ctx, "removed the variables from the forked environment")
+	}
+
+	return nil
+}
+
+// removeEnvironmentServices deletes the services from the environment through an environment patch, since
+// serviceDelete removes them from every environment that isn't a fork, and waits until the patch is applied.
+func removeEnvironmentServices(ctx context.Context, client graphql.Client, environmentId string, serviceIds []string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	services := map[string]interface{}{}
+
+	for _, serviceId := range serviceIds {
+		services[serviceId] = map[string]interface{}{"isDeleted": true}
+	}
+
+	if _, err := commitEnvironmentPatch(ctx, client, environmentId, map[string]interface{}{"services": services}); err != nil {
+		return fmt.Errorf("unable to remove services: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("services were not removed: %w", ctx.Err())
+		case <-time.After(environmentForkReadyInterval):
+		}
+
+		existingIds, err := listAllEnvironmentServiceIds(ctx, client, environmentId)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("services were not removed: %w", ctx.Err())
+			}
+
+			return err
+		}
+
+		remaining := false
+
+		for _, serviceId := range existingIds {
+			if slices.Contains(serviceIds, serviceId) {
+				remaining = true
+			}
+		}
+
+		if !remaining {
+			tflog.Trace(ctx, "removed the excluded services from the forked environment")
+			return nil
+		}
+	}
+}
+
+// removeEnvironmentVolumes deletes the volumes of the environment through an environment patch, since volumes can
+// only be deleted from every environment at once otherwise, and waits until the patch is applied.
+func removeEnvironmentVolumes(ctx context.Context, client graphql.Client, environmentId string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	response, err := getEnvironment(ctx, client, environmentId)
+
+	if err != nil {
+		return err
+	}
+
+	if len(response.Environment.VolumeInstances.Edges) == 0 {
+		return nil
+	}
+
+	volumes := map[string]interface{}{}
+
+	for _, edge := range response.Environment.VolumeInstances.Edges {
+		volumes[edge.Node.VolumeId] = map[string]interface{}{"isDeleted": true}
+	}
+
+	if _, err := commitEnvironmentPatch(ctx, client, environmentId, map[string]interface{}{"volumes": volumes}); err != nil {
+		return fmt.Errorf("unable to remove volumes: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("volumes were not removed: %w", ctx.Err())
+		case <-time.After(environmentForkReadyInterval):
+		}
+
+		response, err := getEnvironment(ctx, client, environmentId)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("volumes were not removed: %w", ctx.Err())
+			}
+
+			return err
+		}
+
+		if len(response.Environment.VolumeInstances.Edges) == 0 {
+			tflog.Trace(ctx, "removed the volumes from the forked environment")
+			return nil
+		}
+	}
+}
+
+// environmentInstanceIds returns the ids of the services and the volumes with an instance in the environment.
+func environmentInstanceIds(environment *getEnvironmentEnvironment) (types.Set, types.Set) {
+	var serviceIds []attr.Value
+	var volumeIds []attr.Value
+
+	for _, edge := range environment.ServiceInstances.Edges {
+		serviceIds = append(serviceIds, types.StringValue(edge.Node.ServiceId))
+	}
+
+	for _, edge := range environment.VolumeInstances.Edges {
+		volumeIds = append(volumeIds, types.StringValue(edge.Node.VolumeId))
+	}
+
+	return types.SetValueMust(types.StringType, serviceIds), types.SetValueMust(types.StringType, volumeIds)
+}
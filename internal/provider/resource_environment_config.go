@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &EnvironmentConfigResource{}
+var _ resource.ResourceWithImportState = &EnvironmentConfigResource{}
+var _ resource.ResourceWithModifyPlan = &EnvironmentConfigResource{}
+
+func NewEnvironmentConfigResource() resource.Resource {
+	return &EnvironmentConfigResource{}
+}
+
+type EnvironmentConfigResource struct {
+	client *graphql.Client
+}
+
+type EnvironmentConfigResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	EnvironmentId    types.String `tfsdk:"environment_id"`
+	CommitMessage    types.String `tfsdk:"commit_message"`
+	Triggers         types.Map    `tfsdk:"triggers"`
+	PatchId          types.String `tfsdk:"patch_id"`
+	HasStagedChanges types.Bool   `tfsdk:"has_staged_changes"`
+}
+
+func (r *EnvironmentConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_config"
+}
+
+func (r *EnvironmentConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway environment config. Commits the changes staged on an environment when created and whenever `triggers` change. Destroying it only stops managing the commits.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment config, the same as `environment_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment to commit the staged changes of.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"commit_message": schema.StringAttribute{
+				MarkdownDescription: "Message of the commits.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that commit the staged changes again when they change, such as the ids of the resources that stage them.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"patch_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the last commit. Null when there was nothing to commit.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"has_staged_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether the environment has staged changes that are not committed yet.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EnvironmentConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare against on create and nothing to plan on destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *EnvironmentConfigResourceModel
+	var state *EnvironmentConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Triggers.Equal(state.Triggers) {
+		data.PatchId = types.StringUnknown()
+		data.HasStagedChanges = types.BoolUnknown()
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+		return
+	}
+
+	if state.HasStagedChanges.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Uncommitted Staged Changes",
+			fmt.Sprintf("Environment %s has staged changes that are not committed. Change `triggers` to commit them.", state.EnvironmentId.ValueString()),
+		)
+	}
+}
+
+func (r *EnvironmentConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EnvironmentConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *EnvironmentConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.EnvironmentId
+	data.PatchId = types.StringNull()
+
+	if err := commitStagedChanges(ctx, *r.client, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to commit staged changes, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *EnvironmentConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasStagedChanges, err := environmentHasStagedChanges(ctx, *r.client, data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read staged changes, got error: %s", err))
+		return
+	}
+
+	data.Id = data.EnvironmentId
+	data.HasStagedChanges = types.BoolValue(hasStagedChanges)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *EnvironmentConfigResourceModel
+	var state *EnvironmentConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only a change of the triggers commits, the commit message is used for the next commit
+	if data.Triggers.Equal(state.Triggers) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	data.PatchId = state.PatchId
+
+	if err := commitStagedChanges(ctx, *r.client, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to commit staged changes, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Commits can't be undone, so there is nothing to delete
+	tflog.Trace(ctx, "deleted an environment config")
+}
+
+func (r *EnvironmentConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("environment_id"), req, resp)
+}
+
+// commitStagedChanges commits the staged changes of the environment, if there are any, and records the commit in
+// data. Committing without staged changes would create an empty commit.
+func commitStagedChanges(ctx context.Context, client graphql.Client, data *EnvironmentConfigResourceModel) error {
+	environmentId := data.EnvironmentId.ValueString()
+
+	hasStagedChanges, err := environmentHasStagedChanges(ctx, client, environmentId)
+
+	if err != nil {
+		return err
+	}
+
+	if hasStagedChanges {
+		var commitMessage *string
+
+		if !data.CommitMessage.IsNull() {
+			commitMessage = data.CommitMessage.ValueStringPointer()
+		}
+
+		response, err := commitEnvironmentStagedChanges(ctx, client, environmentId, commitMessage)
+
+		if err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "committed staged changes")
+
+		data.PatchId = types.StringValue(response.EnvironmentPatchCommit)
+
+		hasStagedChanges, err = environmentHasStagedChanges(ctx, client, environmentId)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	data.HasStagedChanges = types.BoolValue(hasStagedChanges)
+
+	return nil
+}
+
+func environmentHasStagedChanges(ctx context.Context, client graphql.Client, environmentId string) (bool, error) {
+	response, err := getEnvironmentStagedChanges(ctx, client, environmentId)
+
+	if err != nil {
+		// Environments without staged changes have no staged patch
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return response.EnvironmentStagedChanges.Status == EnvironmentPatchStatusStaged, nil
+}
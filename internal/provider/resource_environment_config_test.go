@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEnvironmentConfigResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentConfigResourceConfigDefault("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_environment_config.test", "id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_environment_config.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_environment_config.test", "commit_message", "terraform"),
+					resource.TestCheckResourceAttr("railway_environment_config.test", "has_staged_changes", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "railway_environment_config.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_message", "triggers", "patch_id"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccEnvironmentConfigResourceConfigDefault("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_environment_config.test", "triggers.version", "2"),
+					resource.TestCheckResourceAttr("railway_environment_config.test", "has_staged_changes", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEnvironmentConfigResourceConfigDefault(version string) string {
+	return fmt.Sprintf(`
+resource "railway_environment_config" "test" {
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  commit_message = "terraform"
+
+  triggers = {
+    version = "%s"
+  }
+}
+`, version)
+}
+
+func TestCommitStagedChanges(t *testing.T) {
+	testCases := map[string]struct {
+		stagedStatus  string
+		expectCommit  bool
+		expectPatchId types.String
+	}{
+		"staged changes": {
+			stagedStatus:  "STAGED",
+			expectCommit:  true,
+			expectPatchId: types.StringValue("patch-2"),
+		},
+		"nothing staged": {
+			stagedStatus:  "COMMITTED",
+			expectPatchId: types.StringValue("patch-1"),
+		},
+		"no staged patch": {
+			expectPatchId: types.StringValue("patch-1"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var commits int32
+
+			client := newTestClient(t, func(operationName string) string {
+				switch operationName {
+				case "getEnvironmentStagedChanges":
+					if testCase.stagedStatus == "" {
+						return `{"errors": [{"message": "Patch not found"}], "data": null}`
+					}
+
+					status := testCase.stagedStatus
+
+					if atomic.LoadInt32(&commits) > 0 {
+						status = "COMMITTED"
+					}
+
+					return fmt.Sprintf(`{"data": {"environmentStagedChanges": {"id": "patch-2", "status": "%s"}}}`, status)
+				case "commitEnvironmentStagedChanges":
+					atomic.AddInt32(&commits, 1)
+					return `{"data": {"environmentPatchCommit": "patch-2"}}`
+				default:
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+			})
+
+			data := &EnvironmentConfigResourceModel{
+				EnvironmentId: types.StringValue("d0519b29-5d12-4857-a5dd-76fa7418336c"),
+				CommitMessage: types.StringValue("terraform"),
+				PatchId:       types.StringValue("patch-1"),
+			}
+
+			if err := commitStagedChanges(context.Background(), *client, data); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if committed := atomic.LoadInt32(&commits) > 0; committed != testCase.expectCommit {
+				t.Errorf("expected commit %t, got %t", testCase.expectCommit, committed)
+			}
+
+			if !data.PatchId.Equal(testCase.expectPatchId) {
+				t.Errorf("expected patch id %s, got %s", testCase.expectPatchId, data.PatchId)
+			}
+
+			if data.HasStagedChanges.ValueBool() {
+				t.Error("expected no staged changes after committing")
+			}
+		})
+	}
+}
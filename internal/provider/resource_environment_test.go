@@ -1,9 +1,17 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -19,6 +27,7 @@ func TestAccEnvironmentResourceDefault(t *testing.T) {
 					resource.TestMatchResourceAttr("railway_environment.test", "id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_environment.test", "name", "integration"),
 					resource.TestCheckResourceAttr("railway_environment.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_environment.test", "is_ephemeral", "false"),
 				),
 			},
 			// ImportState testing
@@ -50,3 +59,309 @@ resource "railway_environment" "test" {
 }
 `, name)
 }
+
+func TestAccEnvironmentResourceFork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentResourceConfigFork("integration-fork"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_environment.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_environment.test", "name", "integration-fork"),
+					resource.TestCheckResourceAttr("railway_environment.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_environment.test", "source_environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_environment.test", "is_ephemeral", "true"),
+					resource.TestCheckResourceAttr("railway_environment.test", "clone_volumes", "false"),
+					resource.TestCheckTypeSetElemAttr("railway_environment.test", "service_ids.*", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("railway_environment.test", "volume_ids.#", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "railway_environment.test",
+				ImportState:             true,
+				ImportStateId:           "0bb01547-570d-4109-a5e8-138691f6a2d1:integration-fork",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_environment_id", "clone_volumes"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEnvironmentResourceConfigFork(name string) string {
+	return fmt.Sprintf(`
+resource "railway_environment" "test" {
+  name = "%s"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  source_environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  is_ephemeral = true
+  clone_volumes = false
+}
+`, name)
+}
+
+func TestAccEnvironmentResourceForkExcludeServices(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentResourceConfigForkExcludeServices("integration-fork-exclude"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_environment.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_environment.test", "exclude_services.#", "1"),
+					resource.TestCheckTypeSetElemAttr("railway_environment.test", "service_ids.*", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					// The excluded service is only removed from the fork
+					resource.TestCheckTypeSetElemAttrPair("data.railway_environment.source", "service_instances.*.service_id", "railway_service.excluded", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEnvironmentResourceConfigForkExcludeServices(name string) string {
+	return fmt.Sprintf(`
+resource "railway_service" "excluded" {
+  name = "%[1]s-excluded"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+}
+
+resource "railway_environment" "test" {
+  name = "%[1]s"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  source_environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  exclude_services = [railway_service.excluded.id]
+}
+
+data "railway_environment" "source" {
+  id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+
+  depends_on = [railway_environment.test]
+}
+`, name)
+}
+
+func TestWaitForForkedServices(t *testing.T) {
+	interval := environmentForkReadyInterval
+	environmentForkReadyInterval = 10 * time.Millisecond
+	t.Cleanup(func() { environmentForkReadyInterval = interval })
+
+	testCases := map[string]struct {
+		readyAfter  int32
+		timeout     time.Duration
+		expectError bool
+	}{
+		"ready immediately": {
+			readyAfter: 1,
+			timeout:    time.Minute,
+		},
+		"ready after polling": {
+			readyAfter: 3,
+			timeout:    time.Minute,
+		},
+		"timed out": {
+			readyAfter:  1000,
+			timeout:     50 * time.Millisecond,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var polls int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				var body struct {
+					OperationName string `json:"operationName"`
+					Variables     struct {
+						EnvironmentId string  `json:"environmentId"`
+						After         *string `json:"after"`
+					} `json:"variables"`
+				}
+
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					t.Errorf("unable to decode request: %s", err)
+				}
+
+				if body.OperationName != "getEnvironmentServiceIds" {
+					t.Errorf("unexpected operation: %s", body.OperationName)
+				}
+
+				// Every environment has two pages of services, the source has api and worker
+				serviceIds := []string{"api", "worker"}
+
+				if body.Variables.EnvironmentId == "fork" {
+					if body.Variables.After == nil {
+						atomic.AddInt32(&polls, 1)
+					}
+
+					// Until it is ready, the fork has as many services as the source but not the same ones
+					if atomic.LoadInt32(&polls) < testCase.readyAfter {
+						serviceIds = []string{"api", "db"}
+					}
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+
+				if body.Variables.After == nil {
+					fmt.Fprintf(w, `{"data": {"environment": {"serviceInstances": {"edges": [{"node": {"serviceId": "%s"}}], "pageInfo": {"hasNextPage": true, "endCursor": "cursor-1"}}}}}`, serviceIds[0])
+					return
+				}
+
+				fmt.Fprintf(w, `{"data": {"environment": {"serviceInstances": {"edges": [{"node": {"serviceId": "%s"}}], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}}`, serviceIds[1])
+			}))
+
+			t.Cleanup(server.Close)
+
+			client := graphql.NewClient(server.URL, server.Client())
+
+			err := waitForForkedServices(context.Background(), client, "fork", "source", testCase.timeout)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if polls := atomic.LoadInt32(&polls); polls != testCase.readyAfter {
+				t.Errorf("expected %d polls, got %d", testCase.readyAfter, polls)
+			}
+		})
+	}
+}
+
+func TestReconcileForkedEnvironment(t *testing.T) {
+	interval := environmentForkReadyInterval
+	environmentForkReadyInterval = 10 * time.Millisecond
+	t.Cleanup(func() { environmentForkReadyInterval = interval })
+
+	testCases := map[string]struct {
+		options     environmentForkOptions
+		expectCalls []string
+	}{
+		"clone everything": {},
+		"exclude services": {
+			options:     environmentForkOptions{ExcludeServices: []string{"worker"}},
+			expectCalls: []string{"commitEnvironmentPatch", "getEnvironmentServiceIds"},
+		},
+		"skip volumes": {
+			options:     environmentForkOptions{SkipVolumes: true},
+			expectCalls: []string{"getEnvironment", "commitEnvironmentPatch", "getEnvironment", "getEnvironment"},
+		},
+		"skip variables": {
+			options:     environmentForkOptions{SkipVariables: true},
+			expectCalls: []string{"getEnvironmentServiceIds", "upsertVariableCollection", "upsertVariableCollection"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			var reads int32
+
+			client := newTestClient(t, func(operationName string) string {
+				calls = append(calls, operationName)
+
+				switch operationName {
+				case "getEnvironment":
+					// The volume is gone on the second poll after the patch
+					volumes := `[{"node": {"volumeId": "data"}}]`
+
+					if atomic.AddInt32(&reads, 1) > 2 {
+						volumes = `[]`
+					}
+
+					return fmt.Sprintf(`{"data": {"environment": {"id": "fork", "name": "fork", "projectId": "project", "isEphemeral": false, "createdAt": "2026-01-02T03:04:05Z", "serviceInstances": {"edges": []}, "volumeInstances": {"edges": %s}}}}`, volumes)
+				case "commitEnvironmentPatch":
+					return `{"data": {"environmentPatchCommit": "patch-1"}}`
+				case "getEnvironmentServiceIds":
+					return `{"data": {"environment": {"serviceInstances": {"edges": [{"node": {"serviceId": "api"}}]}}}}`
+				case "upsertVariableCollection":
+					return `{"data": {"variableCollectionUpsert": true}}`
+				default:
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+			})
+
+			if err := reconcileForkedEnvironment(context.Background(), *client, "project", "fork", testCase.options); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if strings.Join(calls, ",") != strings.Join(testCase.expectCalls, ",") {
+				t.Errorf("expected calls %v, got %v", testCase.expectCalls, calls)
+			}
+		})
+	}
+}
+
+func TestCheckServicesInEnvironment(t *testing.T) {
+	var pages int32
+
+	// The services span two pages, worker is on the second one
+	client := newTestClient(t, func(operationName string) string {
+		if atomic.AddInt32(&pages, 1)%2 == 1 {
+			return `{"data": {"environment": {"serviceInstances": {"edges": [{"node": {"serviceId": "api"}}], "pageInfo": {"hasNextPage": true, "endCursor": "cursor-1"}}}}}`
+		}
+
+		return `{"data": {"environment": {"serviceInstances": {"edges": [{"node": {"serviceId": "worker"}}], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}}`
+	})
+
+	if err := checkServicesInEnvironment(context.Background(), *client, "production", []string{"worker"}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	err := checkServicesInEnvironment(context.Background(), *client, "production", []string{"worker", "db", "cache"})
+
+	if err == nil || !strings.Contains(err.Error(), "cache, db are not in environment production") {
+		t.Errorf("expected missing services error, got %v", err)
+	}
+}
+
+func TestRemoveEnvironmentServices(t *testing.T) {
+	interval := environmentForkReadyInterval
+	environmentForkReadyInterval = 10 * time.Millisecond
+	t.Cleanup(func() { environmentForkReadyInterval = interval })
+
+	var pages int32
+
+	// The excluded service is on the second page until the second poll
+	client := newTestClient(t, func(operationName string) string {
+		switch operationName {
+		case "commitEnvironmentPatch":
+			return `{"data": {"environmentPatchCommit": "patch-1"}}`
+		case "getEnvironmentServiceIds":
+			switch atomic.AddInt32(&pages, 1) {
+			case 1, 3:
+				return `{"data": {"environment": {"serviceInstances": {"edges": [{"node": {"serviceId": "api"}}], "pageInfo": {"hasNextPage": true, "endCursor": "cursor-1"}}}}}`
+			case 2:
+				return `{"data": {"environment": {"serviceInstances": {"edges": [{"node": {"serviceId": "worker"}}], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}}`
+			default:
+				return `{"data": {"environment": {"serviceInstances": {"edges": [], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}}`
+			}
+		default:
+			t.Errorf("unexpected operation: %s", operationName)
+			return `{}`
+		}
+	})
+
+	if err := removeEnvironmentServices(context.Background(), *client, "fork", []string{"worker"}, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if pages := atomic.LoadInt32(&pages); pages != 4 {
+		t.Errorf("expected the services to be read twice over 4 pages, got %d pages", pages)
+	}
+}
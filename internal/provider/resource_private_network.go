@@ -3,12 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -18,6 +22,7 @@ import (
 
 var _ resource.Resource = &PrivateNetworkResource{}
 var _ resource.ResourceWithImportState = &PrivateNetworkResource{}
+var _ resource.ResourceWithModifyPlan = &PrivateNetworkResource{}
 
 func NewPrivateNetworkResource() resource.Resource {
 	return &PrivateNetworkResource{}
@@ -34,6 +39,9 @@ type PrivateNetworkResourceModel struct {
 	EnvironmentId types.String `tfsdk:"environment_id"`
 	DnsName       types.String `tfsdk:"dns_name"`
 	Tags          types.List   `tfsdk:"tags"`
+
+	AllowEnvironmentWideDelete types.Bool `tfsdk:"allow_environment_wide_delete"`
+	ForceDeleteEndpoints       types.Bool `tfsdk:"force_delete_endpoints"`
 }
 
 func (r *PrivateNetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -75,13 +83,13 @@ resource "railway_private_network_endpoint" "api" {
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the private network.",
+				MarkdownDescription: "Name of the private network. Must be a valid DNS label: lowercase alphanumeric characters or hyphens, at most 63 characters.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.UTF8LengthAtLeast(1),
+					dnsLabelValidator(),
 				},
 			},
 			"project_id": schema.StringAttribute{
@@ -109,9 +117,24 @@ resource "railway_private_network_endpoint" "api" {
 				Computed:            true,
 			},
 			"tags": schema.ListAttribute{
-				MarkdownDescription: "Tags for the private network.",
+				MarkdownDescription: "Tags for the private network. Railway can't change the tags of a network, so changing them replaces it, reordering them doesn't.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					tagsRequireReplace(),
+				},
+			},
+			"allow_environment_wide_delete": schema.BoolAttribute{
+				MarkdownDescription: "Whether to allow deleting this private network when other private networks exist in the environment. Railway can only delete all private networks of an environment at once, so this also deletes the others. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"force_delete_endpoints": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete the endpoints still attached to the private network when deleting it. When `false`, deleting a network with endpoints fails and lists them. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 		},
 	}
@@ -135,31 +158,141 @@ func (r *PrivateNetworkResource) Configure(ctx context.Context, req resource.Con
 	r.client = client
 }
 
-func (r *PrivateNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+func (r *PrivateNetworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare against on create and nothing to plan on destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
 	var data *PrivateNetworkResourceModel
+	var state *PrivateNetworkResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Build tags
-	var tags []string
-	if !data.Tags.IsNull() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
+	renamed := !data.Name.IsUnknown() && !data.Name.Equal(state.Name)
+	retagged, diags := tagsChanged(ctx, data.Tags, state.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Railway has no way to rename a network or change its tags, so it is replaced
+	switch {
+	case renamed:
+		resp.Diagnostics.AddWarning(
+			"Private Network Will Be Replaced",
+			fmt.Sprintf(
+				"Railway can't rename private networks, so renaming %q to %q deletes and recreates it. "+
+					"Every endpoint attached to it is recreated with new private IPs, and its DNS name %q changes.",
+				state.Name.ValueString(), data.Name.ValueString(), state.DnsName.ValueString(),
+			),
+		)
+	case retagged:
+		resp.Diagnostics.AddWarning(
+			"Private Network Will Be Replaced",
+			fmt.Sprintf(
+				"Railway can't change the tags of private networks, so changing the tags of %q deletes and recreates it. "+
+					"Every endpoint attached to it is recreated with new private IPs.",
+				state.Name.ValueString(),
+			),
+		)
+	default:
+		return
+	}
+
+	if r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkReplaceDelete(ctx, state)...)
+}
+
+// checkReplaceDelete fails the plan when replacing the network would be refused by Delete, which only sees the
+// settings already in state, instead of failing halfway through the apply.
+func (r *PrivateNetworkResource) checkReplaceDelete(ctx context.Context, state *PrivateNetworkResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	response, err := getPrivateNetworks(ctx, *r.client, state.EnvironmentId.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read private networks, got error: %s", err))
+		return diags
+	}
+
+	var others []string
+	networkIds := make([]string, 0, len(response.PrivateNetworks))
+
+	for _, network := range response.PrivateNetworks {
+		networkIds = append(networkIds, network.PublicId)
+
+		if network.PublicId != state.Id.ValueString() {
+			others = append(others, network.Name)
 		}
-	} else {
-		tags = []string{}
 	}
 
-	input := PrivateNetworkCreateOrGetInput{
-		Name:          data.Name.ValueString(),
-		ProjectId:     data.ProjectId.ValueString(),
-		EnvironmentId: data.EnvironmentId.ValueString(),
-		Tags:          tags,
+	if len(others) > 0 && !state.AllowEnvironmentWideDelete.ValueBool() {
+		diags.AddError(
+			"Private Network Replacement Refused",
+			fmt.Sprintf(
+				"Replacing %q deletes it, and Railway can only delete all private networks of an environment at once, so it would also delete: %s. "+
+					"Apply `allow_environment_wide_delete = true` on this network before changing its name or tags.",
+				state.Name.ValueString(), strings.Join(others, ", "),
+			),
+		)
+		return diags
+	}
+
+	if state.ForceDeleteEndpoints.ValueBool() {
+		return diags
+	}
+
+	endpoints, err := listPrivateNetworkEndpoints(ctx, *r.client, state.EnvironmentId.ValueString(), networkIds)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read private network endpoints, got error: %s", err))
+		return diags
+	}
+
+	if len(endpoints) > 0 {
+		var names []string
+
+		for _, endpoint := range endpoints {
+			names = append(names, fmt.Sprintf("%s (service %s)", endpoint.dnsName, endpoint.serviceId))
+		}
+
+		diags.AddError(
+			"Private Network Replacement Refused",
+			fmt.Sprintf(
+				"Replacing %q deletes it, and it still has endpoints attached: %s. "+
+					"Apply `force_delete_endpoints = true` on this network before changing its name or tags.",
+				state.Name.ValueString(), strings.Join(names, ", "),
+			),
+		)
+	}
+
+	return diags
+}
+
+func (r *PrivateNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PrivateNetworkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := buildPrivateNetworkInput(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	response, err := createOrGetPrivateNetwork(ctx, *r.client, input)
@@ -173,17 +306,28 @@ func (r *PrivateNetworkResource) Create(ctx context.Context, req resource.Create
 
 	network := response.PrivateNetworkCreateOrGet
 
+	// A network that already existed is returned as is, with the tags it already had
+	if !sameTags(network.Tags, input.Tags) {
+		resp.Diagnostics.AddError(
+			"Private Network Already Exists",
+			fmt.Sprintf(
+				"Private network %q already exists in environment %s with tags %q instead of %q. "+
+					"Configure its current tags, or delete the network in the Railway dashboard, before creating it.",
+				input.Name, input.EnvironmentId, network.Tags, input.Tags,
+			),
+		)
+		return
+	}
+
 	data.Id = types.StringValue(network.PublicId)
 	data.DnsName = types.StringValue(network.DnsName)
 
-	// Update tags from response
-	if len(network.Tags) > 0 {
-		tagList, diags := types.ListValueFrom(ctx, types.StringType, network.Tags)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Tags = tagList
+	// The tags only match as a set, so keep them in the configured order
+	data.Tags, diags = endpointStringList(ctx, data.Tags, input.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -215,15 +359,16 @@ func (r *PrivateNetworkResource) Read(ctx context.Context, req resource.ReadRequ
 			data.ProjectId = types.StringValue(network.ProjectId)
 			data.EnvironmentId = types.StringValue(network.EnvironmentId)
 
-			if len(network.Tags) > 0 {
-				tagList, diags := types.ListValueFrom(ctx, types.StringType, network.Tags)
-				resp.Diagnostics.Append(diags...)
-				if resp.Diagnostics.HasError() {
-					return
-				}
-				data.Tags = tagList
+			// Always written, so tags removed outside of terraform show up as drift
+			tags, diags := readTags(ctx, data.Tags, network.Tags)
+			resp.Diagnostics.Append(diags...)
+
+			if resp.Diagnostics.HasError() {
+				return
 			}
 
+			data.Tags = tags
+
 			found = true
 			break
 		}
@@ -234,16 +379,33 @@ func (r *PrivateNetworkResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	if data.AllowEnvironmentWideDelete.IsNull() {
+		data.AllowEnvironmentWideDelete = types.BoolValue(false)
+	}
+
+	if data.ForceDeleteEndpoints.IsNull() {
+		data.ForceDeleteEndpoints = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *PrivateNetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Private networks are immutable - any changes require replacement
-	// This is enforced by RequiresReplace on all configurable fields
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Private networks cannot be updated. Changes require replacement.",
-	)
+	var data *PrivateNetworkResourceModel
+	var state *PrivateNetworkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Identity fields and tags require replacement, so only the order of the tags and the delete behaviour change
+	// in place, which the API knows nothing about
+	data.DnsName = state.DnsName
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *PrivateNetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -255,9 +417,88 @@ func (r *PrivateNetworkResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	// Delete all private networks for the environment
-	// Note: Railway API only provides deletion at environment level
-	_, err := deletePrivateNetworksForEnvironment(ctx, *r.client, data.EnvironmentId.ValueString())
+	// Railway API only provides deletion at environment level, so make sure no other network goes with this one
+	response, err := getPrivateNetworks(ctx, *r.client, data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private networks, got error: %s", err))
+		return
+	}
+
+	var others []string
+
+	for _, network := range response.PrivateNetworks {
+		if network.PublicId != data.Id.ValueString() {
+			others = append(others, network.Name)
+		}
+	}
+
+	if len(others) > 0 && !data.AllowEnvironmentWideDelete.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Private Network Delete Refused",
+			fmt.Sprintf(
+				"Railway can only delete all private networks of an environment at once, so deleting %q would also delete: %s. "+
+					"Set `allow_environment_wide_delete = true` on this network to delete them all.",
+				data.Name.ValueString(), strings.Join(others, ", "),
+			),
+		)
+		return
+	}
+
+	if len(others) > 0 {
+		tflog.Warn(ctx, "deleting every private network in the environment", map[string]interface{}{"networks": others})
+	}
+
+	networkIds := make([]string, 0, len(response.PrivateNetworks))
+
+	for _, network := range response.PrivateNetworks {
+		networkIds = append(networkIds, network.PublicId)
+	}
+
+	endpoints, err := r.clearPrivateNetworkEndpoints(ctx, data.EnvironmentId.ValueString(), networkIds, data.ForceDeleteEndpoints.ValueBool())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete private network endpoints, got error: %s", err))
+		return
+	}
+
+	if len(endpoints) > 0 && data.ForceDeleteEndpoints.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Private Network Endpoints Not Deleted",
+			fmt.Sprintf("Private network %q still has endpoints attached after deleting them: %s.", data.Name.ValueString(), strings.Join(endpoints, ", ")),
+		)
+		return
+	}
+
+	if len(endpoints) > 0 {
+		resp.Diagnostics.AddError(
+			"Private Network Has Endpoints",
+			fmt.Sprintf(
+				"Private network %q still has endpoints attached: %s. "+
+					"Delete them first, or set `force_delete_endpoints = true` on this network to delete them with it.",
+				data.Name.ValueString(), strings.Join(endpoints, ", "),
+			),
+		)
+		return
+	}
+
+	// Endpoint deletion takes a moment to reach the network, so retry while the network still sees them
+	for attempt := 1; ; attempt++ {
+		_, err = deletePrivateNetworksForEnvironment(ctx, *r.client, data.EnvironmentId.ValueString())
+
+		if err == nil || attempt == privateNetworkDeleteAttempts {
+			break
+		}
+
+		tflog.Debug(ctx, "retrying private network delete", map[string]interface{}{"attempt": attempt, "error": err.Error()})
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete private network, got error: %s", ctx.Err()))
+			return
+		case <-time.After(privateNetworkDeleteInterval):
+		}
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete private network, got error: %s", err))
@@ -267,6 +508,163 @@ func (r *PrivateNetworkResource) Delete(ctx context.Context, req resource.Delete
 	tflog.Trace(ctx, "deleted private network")
 }
 
+var (
+	privateNetworkDeleteAttempts = 5
+	privateNetworkDeleteInterval = 3 * time.Second
+)
+
+// clearPrivateNetworkEndpoints waits for the networks to have no endpoints left, deleting them when force is set.
+// Endpoints deleted earlier in the same apply can take a moment to disappear, so they are listed a few times before
+// giving up. It returns the endpoints that are still attached.
+func (r *PrivateNetworkResource) clearPrivateNetworkEndpoints(ctx context.Context, environmentId string, networkIds []string, force bool) ([]string, error) {
+	for attempt := 1; ; attempt++ {
+		endpoints, err := listPrivateNetworkEndpoints(ctx, *r.client, environmentId, networkIds)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(endpoints) == 0 {
+			return nil, nil
+		}
+
+		var names []string
+
+		for _, endpoint := range endpoints {
+			names = append(names, fmt.Sprintf("%s (service %s)", endpoint.dnsName, endpoint.serviceId))
+
+			if force {
+				if _, err := deletePrivateNetworkEndpoint(ctx, *r.client, endpoint.id); err != nil {
+					return nil, err
+				}
+
+				tflog.Trace(ctx, "deleted private network endpoint", map[string]interface{}{"id": endpoint.id})
+			}
+		}
+
+		if attempt == privateNetworkDeleteAttempts {
+			return names, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(privateNetworkDeleteInterval):
+		}
+	}
+}
+
+type privateNetworkEndpointRef struct {
+	id        string
+	dnsName   string
+	serviceId string
+}
+
+// listPrivateNetworkEndpoints finds the endpoints on the networks. Railway only looks endpoints up per service, so
+// every service of the environment is checked.
+func listPrivateNetworkEndpoints(ctx context.Context, client graphql.Client, environmentId string, networkIds []string) ([]privateNetworkEndpointRef, error) {
+	if len(networkIds) == 0 {
+		return nil, nil
+	}
+
+	serviceIds, err := listAllEnvironmentServiceIds(ctx, client, environmentId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []privateNetworkEndpointRef
+
+	for _, serviceId := range serviceIds {
+		for _, networkId := range networkIds {
+			result, err := getPrivateNetworkEndpoint(ctx, client, &environmentId, &networkId, &serviceId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			endpoint := result.PrivateNetworkEndpoint
+
+			if endpoint == nil || endpoint.PublicId == nil {
+				continue
+			}
+
+			ref := privateNetworkEndpointRef{id: *endpoint.PublicId, serviceId: serviceId}
+
+			if endpoint.DnsName != nil {
+				ref.dnsName = *endpoint.DnsName
+			}
+
+			endpoints = append(endpoints, ref)
+
+			// A service instance has a single private network endpoint, so the other networks can be skipped
+			break
+		}
+	}
+
+	return endpoints, nil
+}
+
+// listAllEnvironmentServiceIds reads every page of the service instances of an environment and returns their
+// service ids.
+func listAllEnvironmentServiceIds(ctx context.Context, client graphql.Client, environmentId string) ([]string, error) {
+	var serviceIds []string
+	var after *string
+
+	for {
+		response, err := getEnvironmentServiceIds(ctx, client, environmentId, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		connection := response.Environment.ServiceInstances
+
+		for _, edge := range connection.Edges {
+			serviceIds = append(serviceIds, edge.Node.ServiceId)
+		}
+
+		if !connection.PageInfo.HasNextPage || connection.PageInfo.EndCursor == "" {
+			return serviceIds, nil
+		}
+
+		after = &connection.PageInfo.EndCursor
+	}
+}
+
+func buildPrivateNetworkInput(ctx context.Context, data *PrivateNetworkResourceModel) (PrivateNetworkCreateOrGetInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Railway expects an empty list to clear the tags
+	tags := []string{}
+
+	if !data.Tags.IsNull() {
+		diags = data.Tags.ElementsAs(ctx, &tags, false)
+	}
+
+	return PrivateNetworkCreateOrGetInput{
+		Name:          data.Name.ValueString(),
+		ProjectId:     data.ProjectId.ValueString(),
+		EnvironmentId: data.EnvironmentId.ValueString(),
+		Tags:          tags,
+	}, diags
+}
+
 func (r *PrivateNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import format: environment_id:id, the environment is needed to look the network up
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || !uuidRegex().MatchString(parts[0]) || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: environment_id:id, where environment_id is a UUID. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_environment_wide_delete"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_delete_endpoints"), false)...)
 }
@@ -2,13 +2,21 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -28,14 +36,17 @@ type PrivateNetworkEndpointResource struct {
 }
 
 type PrivateNetworkEndpointResourceModel struct {
-	Id               types.String `tfsdk:"id"`
-	PrivateNetworkId types.String `tfsdk:"private_network_id"`
-	ServiceId        types.String `tfsdk:"service_id"`
-	EnvironmentId    types.String `tfsdk:"environment_id"`
-	ServiceName      types.String `tfsdk:"service_name"`
-	DnsName          types.String `tfsdk:"dns_name"`
-	PrivateIps       types.List   `tfsdk:"private_ips"`
-	Tags             types.List   `tfsdk:"tags"`
+	Id               types.String   `tfsdk:"id"`
+	PrivateNetworkId types.String   `tfsdk:"private_network_id"`
+	ServiceId        types.String   `tfsdk:"service_id"`
+	EnvironmentId    types.String   `tfsdk:"environment_id"`
+	ServiceName      types.String   `tfsdk:"service_name"`
+	DnsName          types.String   `tfsdk:"dns_name"`
+	Fqdn             types.String   `tfsdk:"fqdn"`
+	PrivateIps       types.List     `tfsdk:"private_ips"`
+	Tags             types.List     `tfsdk:"tags"`
+	WaitForReady     types.Bool     `tfsdk:"wait_for_ready"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *PrivateNetworkEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -109,30 +120,51 @@ resource "railway_private_network_endpoint" "api" {
 				},
 			},
 			"service_name": schema.StringAttribute{
-				MarkdownDescription: "Name for the service on the private network (used in DNS).",
+				MarkdownDescription: "Name for the service on the private network (used in DNS). Must be a valid DNS label: lowercase alphanumeric characters or hyphens, at most 63 characters.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.UTF8LengthAtLeast(1),
+					dnsLabelValidator(),
 				},
 			},
 			"dns_name": schema.StringAttribute{
 				MarkdownDescription: "DNS name for accessing the service on the private network.",
 				Computed:            true,
 			},
+			"fqdn": schema.StringAttribute{
+				MarkdownDescription: "Fully qualified domain name of the service on the private network, made of the endpoint and network DNS names. Null when the network DNS name isn't available.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"private_ips": schema.ListAttribute{
 				MarkdownDescription: "Private IP addresses assigned to this endpoint.",
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
 			"tags": schema.ListAttribute{
-				MarkdownDescription: "Tags for the endpoint.",
+				MarkdownDescription: "Tags for the endpoint. Railway can't change the tags of an endpoint, so changing them replaces it, reordering them doesn't.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					tagsRequireReplace(),
+				},
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Wait on create until the endpoint has been assigned a private IP. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 	}
 }
 
@@ -163,23 +195,11 @@ func (r *PrivateNetworkEndpointResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	// Build tags
-	var tags []string
-	if !data.Tags.IsNull() {
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	} else {
-		tags = []string{}
-	}
+	input, diags := buildPrivateNetworkEndpointInput(ctx, data)
+	resp.Diagnostics.Append(diags...)
 
-	input := PrivateNetworkEndpointCreateOrGetInput{
-		PrivateNetworkId: data.PrivateNetworkId.ValueString(),
-		ServiceId:        data.ServiceId.ValueString(),
-		EnvironmentId:    data.EnvironmentId.ValueString(),
-		ServiceName:      data.ServiceName.ValueString(),
-		Tags:             tags,
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	response, err := createOrGetPrivateNetworkEndpoint(ctx, *r.client, input)
@@ -193,32 +213,62 @@ func (r *PrivateNetworkEndpointResource) Create(ctx context.Context, req resourc
 
 	endpoint := response.PrivateNetworkEndpointCreateOrGet
 
-	data.Id = types.StringValue(endpoint.PublicId)
-	data.DnsName = types.StringValue(endpoint.DnsName)
+	// An endpoint that already existed is returned as is, and Railway has no mutation to change its tags
+	if !sameTags(endpoint.Tags, input.Tags) {
+		resp.Diagnostics.AddError(
+			"Private Network Endpoint Already Exists",
+			fmt.Sprintf(
+				"An endpoint for service %s already exists on private network %s with tags %q instead of %q. "+
+					"Configure its current tags, or remove the endpoint in the Railway dashboard, before creating it.",
+				input.ServiceId, input.PrivateNetworkId, endpoint.Tags, input.Tags,
+			),
+		)
+		return
+	}
+
+	privateIps := endpoint.PrivateIps
 
-	// Update private IPs from response
-	if len(endpoint.PrivateIps) > 0 {
-		ipList, diags := types.ListValueFrom(ctx, types.StringType, endpoint.PrivateIps)
+	var waitErr error
+
+	if data.WaitForReady.ValueBool() && len(privateIps) == 0 {
+		createTimeout, diags := data.Timeouts.Create(ctx, privateNetworkEndpointReadyTimeout)
 		resp.Diagnostics.Append(diags...)
+
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		data.PrivateIps = ipList
-	} else {
-		data.PrivateIps = types.ListNull(types.StringType)
+
+		privateIps, waitErr = r.waitForPrivateIps(ctx, input, createTimeout)
 	}
 
-	// Update tags from response
-	if len(endpoint.Tags) > 0 {
-		tagList, diags := types.ListValueFrom(ctx, types.StringType, endpoint.Tags)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Tags = tagList
+	data.Id = types.StringValue(endpoint.PublicId)
+	data.DnsName = types.StringValue(endpoint.DnsName)
+
+	data.Fqdn, err = privateNetworkEndpointFqdn(ctx, *r.client, input.EnvironmentId, input.PrivateNetworkId, endpoint.DnsName)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private networks, got error: %s", err))
+		return
+	}
+
+	// The tags only match as a set, so keep them in the configured order
+	resp.Diagnostics.Append(setPrivateNetworkEndpointLists(ctx, data, privateIps, input.Tags)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// The endpoint exists, so keep it in state even if it never became ready
+	if errors.Is(waitErr, context.DeadlineExceeded) {
+		resp.Diagnostics.AddError(
+			"Private Network Endpoint Not Ready",
+			fmt.Sprintf("Private network endpoint %s was not assigned a private IP within the create timeout, check it in the Railway dashboard.", endpoint.PublicId),
+		)
+	} else if waitErr != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private network endpoint %s, got error: %s", endpoint.PublicId, waitErr))
+	}
 }
 
 func (r *PrivateNetworkEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -254,6 +304,10 @@ func (r *PrivateNetworkEndpointResource) Read(ctx context.Context, req resource.
 
 	endpoint := response.PrivateNetworkEndpoint
 
+	if data.WaitForReady.IsNull() {
+		data.WaitForReady = types.BoolValue(true)
+	}
+
 	// Handle pointer fields from response
 	if endpoint.PublicId != nil {
 		data.Id = types.StringValue(*endpoint.PublicId)
@@ -262,50 +316,41 @@ func (r *PrivateNetworkEndpointResource) Read(ctx context.Context, req resource.
 		data.DnsName = types.StringValue(*endpoint.DnsName)
 	}
 
-	// Update private IPs - convert []*string to []string
-	if len(endpoint.PrivateIps) > 0 {
-		ips := make([]string, 0, len(endpoint.PrivateIps))
-		for _, ip := range endpoint.PrivateIps {
-			if ip != nil {
-				ips = append(ips, *ip)
-			}
-		}
-		ipList, diags := types.ListValueFrom(ctx, types.StringType, ips)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.PrivateIps = ipList
-	} else {
-		data.PrivateIps = types.ListNull(types.StringType)
+	data.Fqdn, err = privateNetworkEndpointFqdn(ctx, *r.client, envId, networkId, data.DnsName.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private networks, got error: %s", err))
+		return
 	}
 
-	// Update tags - convert []*string to []string
-	if len(endpoint.Tags) > 0 {
-		tags := make([]string, 0, len(endpoint.Tags))
-		for _, tag := range endpoint.Tags {
-			if tag != nil {
-				tags = append(tags, *tag)
-			}
-		}
-		tagList, diags := types.ListValueFrom(ctx, types.StringType, tags)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Tags = tagList
+	resp.Diagnostics.Append(setPrivateNetworkEndpointLists(ctx, data, derefStrings(endpoint.PrivateIps), derefStrings(endpoint.Tags))...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *PrivateNetworkEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Endpoints are immutable - any changes require replacement
-	// This is enforced by RequiresReplace on all configurable fields
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Private network endpoints cannot be updated. Changes require replacement.",
-	)
+	var data *PrivateNetworkEndpointResourceModel
+	var state *PrivateNetworkEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing the tags replaces the endpoint, so only their order and wait_for_ready change in place, which the
+	// API knows nothing about
+	data.Id = state.Id
+	data.DnsName = state.DnsName
+	data.Fqdn = state.Fqdn
+	data.PrivateIps = state.PrivateIps
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *PrivateNetworkEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -327,6 +372,249 @@ func (r *PrivateNetworkEndpointResource) Delete(ctx context.Context, req resourc
 	tflog.Trace(ctx, "deleted private network endpoint")
 }
 
+var (
+	privateNetworkEndpointReadyTimeout  = 5 * time.Minute
+	privateNetworkEndpointReadyInterval = 3 * time.Second
+)
+
+// waitForPrivateIps polls the endpoint until Railway has assigned it at least one private IP, and returns them.
+func (r *PrivateNetworkEndpointResource) waitForPrivateIps(ctx context.Context, input PrivateNetworkEndpointCreateOrGetInput, timeout time.Duration) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(privateNetworkEndpointReadyInterval):
+		}
+
+		response, err := getPrivateNetworkEndpoint(ctx, *r.client, &input.EnvironmentId, &input.PrivateNetworkId, &input.ServiceId)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			return nil, err
+		}
+
+		if response.PrivateNetworkEndpoint != nil {
+			if privateIps := derefStrings(response.PrivateNetworkEndpoint.PrivateIps); len(privateIps) > 0 {
+				tflog.Trace(ctx, "private network endpoint is ready")
+				return privateIps, nil
+			}
+		}
+	}
+}
+
+// privateNetworkEndpointFqdn joins the endpoint DNS name with the DNS name of its network. It is null when either
+// isn't known, rather than a partial name.
+func privateNetworkEndpointFqdn(ctx context.Context, client graphql.Client, environmentId string, privateNetworkId string, dnsName string) (types.String, error) {
+	if dnsName == "" {
+		return types.StringNull(), nil
+	}
+
+	response, err := getPrivateNetworks(ctx, client, environmentId)
+
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	for _, network := range response.PrivateNetworks {
+		if network.PublicId == privateNetworkId && network.DnsName != "" {
+			return types.StringValue(dnsName + "." + network.DnsName), nil
+		}
+	}
+
+	return types.StringNull(), nil
+}
+
+// setPrivateNetworkEndpointLists maps the private IPs and tags returned by the API onto the model, replacing what
+// was there.
+func setPrivateNetworkEndpointLists(ctx context.Context, data *PrivateNetworkEndpointResourceModel, privateIps []string, tags []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var listDiags diag.Diagnostics
+
+	data.PrivateIps, listDiags = endpointStringList(ctx, types.ListNull(types.StringType), privateIps)
+	diags.Append(listDiags...)
+
+	data.Tags, listDiags = readTags(ctx, data.Tags, tags)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// endpointStringList converts a list returned by the API into a list attribute. An empty list from the API is
+// null, unless the attribute currently holds a list, in which case it is empty so removing every element
+// doesn't look like drift.
+func endpointStringList(ctx context.Context, current types.List, values []string) (types.List, diag.Diagnostics) {
+	if len(values) == 0 {
+		if current.IsNull() || current.IsUnknown() {
+			return types.ListNull(types.StringType), nil
+		}
+
+		return types.ListValueMust(types.StringType, []attr.Value{}), nil
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+// readTags converts the tags returned by the API into a list attribute, keeping the current order when they are the
+// same tags, since the API doesn't keep the configured one.
+func readTags(ctx context.Context, current types.List, tags []string) (types.List, diag.Diagnostics) {
+	if !current.IsNull() && !current.IsUnknown() {
+		var currentTags []string
+
+		diags := current.ElementsAs(ctx, &currentTags, false)
+
+		if diags.HasError() {
+			return current, diags
+		}
+
+		if sameTags(currentTags, tags) {
+			return current, nil
+		}
+	}
+
+	return endpointStringList(ctx, current, tags)
+}
+
+// sameTags reports whether both lists hold the same tags, whatever their order.
+func sameTags(a []string, b []string) bool {
+	return slices.Equal(slices.Compact(slices.Sorted(slices.Values(a))), slices.Compact(slices.Sorted(slices.Values(b))))
+}
+
+// tagsRequireReplace replaces a private network or an endpoint when its tags change, since Railway has no mutation
+// to update them. Only reordering the tags is left to an in-place update.
+func tagsRequireReplace() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			changed, diags := tagsChanged(ctx, req.PlanValue, req.StateValue)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = changed
+		},
+		"Railway can't update tags, so changing them replaces the resource.",
+		"Railway can't update tags, so changing them replaces the resource.",
+	)
+}
+
+// tagsChanged reports whether the planned tags differ from the current ones as a set. Unknown tags count as changed.
+func tagsChanged(ctx context.Context, planValue types.List, stateValue types.List) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if planValue.IsUnknown() {
+		return true, diags
+	}
+
+	var planned, current []string
+
+	if !planValue.IsNull() {
+		diags.Append(planValue.ElementsAs(ctx, &planned, false)...)
+	}
+
+	if !stateValue.IsNull() && !stateValue.IsUnknown() {
+		diags.Append(stateValue.ElementsAs(ctx, &current, false)...)
+	}
+
+	return !sameTags(planned, current), diags
+}
+
+func derefStrings(values []*string) []string {
+	result := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if value != nil {
+			result = append(result, *value)
+		}
+	}
+
+	return result
+}
+
+func buildPrivateNetworkEndpointInput(ctx context.Context, data *PrivateNetworkEndpointResourceModel) (PrivateNetworkEndpointCreateOrGetInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Railway expects an empty list to clear the tags
+	tags := []string{}
+
+	if !data.Tags.IsNull() {
+		diags = data.Tags.ElementsAs(ctx, &tags, false)
+	}
+
+	return PrivateNetworkEndpointCreateOrGetInput{
+		PrivateNetworkId: data.PrivateNetworkId.ValueString(),
+		ServiceId:        data.ServiceId.ValueString(),
+		EnvironmentId:    data.EnvironmentId.ValueString(),
+		ServiceName:      data.ServiceName.ValueString(),
+		Tags:             tags,
+	}, diags
+}
+
 func (r *PrivateNetworkEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import format: environment_id:private_network_id:service_id, which is how endpoints are looked up
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 3 || !uuidRegex().MatchString(parts[0]) || parts[1] == "" || !uuidRegex().MatchString(parts[2]) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: environment_id:private_network_id:service_id, where environment_id and service_id are UUIDs. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	response, err := getPrivateNetworkEndpoint(ctx, *r.client, &parts[0], &parts[1], &parts[2])
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private network endpoint, got error: %s", err))
+		return
+	}
+
+	if response.PrivateNetworkEndpoint == nil || response.PrivateNetworkEndpoint.PublicId == nil {
+		resp.Diagnostics.AddError(
+			"Private Network Endpoint Not Found",
+			fmt.Sprintf("No endpoint exists for service %s on private network %s in environment %s.", parts[2], parts[1], parts[0]),
+		)
+
+		return
+	}
+
+	endpoint := response.PrivateNetworkEndpoint
+
+	data := &PrivateNetworkEndpointResourceModel{
+		Id:               types.StringValue(*endpoint.PublicId),
+		EnvironmentId:    types.StringValue(parts[0]),
+		PrivateNetworkId: types.StringValue(parts[1]),
+		ServiceId:        types.StringValue(parts[2]),
+		ServiceName:      types.StringNull(),
+		DnsName:          types.StringNull(),
+		Fqdn:             types.StringNull(),
+		Tags:             types.ListNull(types.StringType),
+		WaitForReady:     types.BoolValue(true),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType}),
+		},
+	}
+
+	// The service name isn't returned by the API, but it is the first label of the DNS name
+	if endpoint.DnsName != nil {
+		data.DnsName = types.StringValue(*endpoint.DnsName)
+		data.ServiceName = types.StringValue(strings.SplitN(*endpoint.DnsName, ".", 2)[0])
+	}
+
+	data.Fqdn, err = privateNetworkEndpointFqdn(ctx, *r.client, parts[0], parts[1], data.DnsName.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private networks, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setPrivateNetworkEndpointLists(ctx, data, derefStrings(endpoint.PrivateIps), derefStrings(endpoint.Tags))...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,681 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPrivateNetworkEndpointResourceTags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPrivateNetworkEndpointResourceConfigTags(`["api"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "service_name", "terraform-api"),
+					resource.TestCheckResourceAttrSet("railway_private_network_endpoint.test", "fqdn"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "tags.0", "api"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_private_network_endpoint.test",
+				ImportState:       true,
+				ImportStateIdFunc: privateNetworkEndpointImportIdFunc,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "railway_private_network_endpoint.test",
+				ImportState:   true,
+				ImportStateId: "39da7e07-fa3a-42fd-b695-d229319f2993",
+				ExpectError:   regexp.MustCompile("Expected import identifier with format: environment_id:private_network_id:service_id"),
+			},
+			// Add a tag, which Railway can't do to an existing endpoint
+			{
+				Config: testAccPrivateNetworkEndpointResourceConfigTags(`["api", "backend"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("railway_private_network_endpoint.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "tags.0", "api"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "tags.1", "backend"),
+				),
+			},
+			// Reorder the tags, which keeps the endpoint
+			{
+				Config: testAccPrivateNetworkEndpointResourceConfigTags(`["backend", "api"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("railway_private_network_endpoint.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "tags.0", "backend"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "tags.1", "api"),
+				),
+			},
+			// Clear the tags
+			{
+				Config: testAccPrivateNetworkEndpointResourceConfigTags(`[]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("railway_private_network_endpoint.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "tags.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func privateNetworkEndpointImportIdFunc(state *terraform.State) (string, error) {
+	rawState, ok := state.RootModule().Resources["railway_private_network_endpoint.test"]
+
+	if !ok {
+		return "", fmt.Errorf("Resource Not found")
+	}
+
+	return fmt.Sprintf("%s:%s:%s", rawState.Primary.Attributes["environment_id"], rawState.Primary.Attributes["private_network_id"], rawState.Primary.Attributes["service_id"]), nil
+}
+
+func testAccPrivateNetworkEndpointResourceConfigTags(tags string) string {
+	return fmt.Sprintf(`
+resource "railway_private_network" "test" {
+  name = "terraform-endpoint"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+}
+
+resource "railway_private_network_endpoint" "test" {
+  private_network_id = railway_private_network.test.id
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_name = "terraform-api"
+  tags = %s
+}
+`, tags)
+}
+
+func TestSetPrivateNetworkEndpointLists(t *testing.T) {
+	listOf := func(tags ...string) types.List {
+		values := make([]attr.Value, 0, len(tags))
+
+		for _, tag := range tags {
+			values = append(values, types.StringValue(tag))
+		}
+
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	testCases := map[string]struct {
+		current      types.List
+		privateIps   []string
+		tags         []string
+		expectedIps  types.List
+		expectedTags types.List
+	}{
+		"populated": {
+			current:      types.ListNull(types.StringType),
+			privateIps:   []string{"10.0.0.2"},
+			tags:         []string{"api"},
+			expectedIps:  listOf("10.0.0.2"),
+			expectedTags: listOf("api"),
+		},
+		"populated to empty": {
+			current:      listOf("api", "backend"),
+			privateIps:   nil,
+			tags:         []string{},
+			expectedIps:  types.ListNull(types.StringType),
+			expectedTags: listOf(),
+		},
+		"populated to fewer": {
+			current:      listOf("api", "backend"),
+			privateIps:   []string{"10.0.0.3"},
+			tags:         []string{"backend"},
+			expectedIps:  listOf("10.0.0.3"),
+			expectedTags: listOf("backend"),
+		},
+		"unset stays null": {
+			current:      types.ListNull(types.StringType),
+			privateIps:   []string{},
+			tags:         nil,
+			expectedIps:  types.ListNull(types.StringType),
+			expectedTags: types.ListNull(types.StringType),
+		},
+		"planned on create": {
+			current:      types.ListUnknown(types.StringType),
+			privateIps:   []string{"10.0.0.2"},
+			tags:         nil,
+			expectedIps:  listOf("10.0.0.2"),
+			expectedTags: types.ListNull(types.StringType),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data := &PrivateNetworkEndpointResourceModel{
+				PrivateIps: listOf("10.0.0.1"),
+				Tags:       testCase.current,
+			}
+
+			diags := setPrivateNetworkEndpointLists(context.Background(), data, testCase.privateIps, testCase.tags)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if !data.PrivateIps.Equal(testCase.expectedIps) {
+				t.Errorf("unexpected private_ips, got: %s, expected: %s", data.PrivateIps, testCase.expectedIps)
+			}
+
+			if !data.Tags.Equal(testCase.expectedTags) {
+				t.Errorf("unexpected tags, got: %s, expected: %s", data.Tags, testCase.expectedTags)
+			}
+		})
+	}
+}
+
+func TestPrivateNetworkEndpointResourceCreateExistingTags(t *testing.T) {
+	testCases := map[string]struct {
+		tags         []string
+		response     string
+		expectError  bool
+		expectedTags []string
+	}{
+		"matching": {
+			tags:         []string{"api"},
+			response:     `["api"]`,
+			expectedTags: []string{"api"},
+		},
+		"reordered": {
+			tags:         []string{"api", "backend"},
+			response:     `["backend", "api"]`,
+			expectedTags: []string{"api", "backend"},
+		},
+		"different": {
+			tags:        []string{"api"},
+			response:    `["legacy"]`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var calls int32
+
+			client := newTestClient(t, func(operationName string) string {
+				if operationName == "getPrivateNetworks" {
+					return privateNetworkEndpointTestNetworks
+				}
+
+				if operationName != "createOrGetPrivateNetworkEndpoint" {
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+
+				atomic.AddInt32(&calls, 1)
+
+				return fmt.Sprintf(`{"data": {"privateNetworkEndpointCreateOrGet": {
+  "publicId": "endpoint-1",
+  "dnsName": "terraform-api",
+  "privateIps": ["10.0.0.2"],
+  "serviceInstanceId": "8b7cb2f0-7a1d-4b5e-9c39-0a5d1b2c3d4e",
+  "tags": %s
+}}}`, testCase.response)
+			})
+
+			r := &PrivateNetworkEndpointResource{client: client}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+			model := privateNetworkEndpointTestModel()
+			model.Tags, _ = types.ListValueFrom(ctx, types.StringType, testCase.tags)
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			diags := plan.Set(ctx, model)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			resp := &fwresource.CreateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+
+			r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+
+			if calls != 1 {
+				t.Errorf("expected 1 call, got %d", calls)
+			}
+
+			if testCase.expectError {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected error diagnostics")
+				}
+
+				if !resp.State.Raw.IsNull() {
+					t.Error("expected no state to be saved")
+				}
+
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			var data PrivateNetworkEndpointResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+			var tags []string
+			resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			if fmt.Sprint(tags) != fmt.Sprint(testCase.expectedTags) {
+				t.Errorf("expected tags %v, got %v", testCase.expectedTags, tags)
+			}
+		})
+	}
+}
+
+func TestPrivateNetworkEndpointResourceCreateWaitForReady(t *testing.T) {
+	interval := privateNetworkEndpointReadyInterval
+	privateNetworkEndpointReadyInterval = 10 * time.Millisecond
+	t.Cleanup(func() { privateNetworkEndpointReadyInterval = interval })
+
+	testCases := map[string]struct {
+		waitForReady bool
+		readyAfter   int32
+		timeout      string
+		expectError  string
+		expectedIps  []string
+	}{
+		"ready after polling": {
+			waitForReady: true,
+			readyAfter:   2,
+			timeout:      "1m",
+			expectedIps:  []string{"10.0.0.2"},
+		},
+		"timed out": {
+			waitForReady: true,
+			readyAfter:   1000,
+			timeout:      "50ms",
+			expectError:  "endpoint-1",
+		},
+		"not waiting": {
+			waitForReady: false,
+			readyAfter:   1000,
+			timeout:      "1m",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var polls int32
+
+			client := newTestClient(t, func(operationName string) string {
+				switch operationName {
+				case "createOrGetPrivateNetworkEndpoint":
+					return `{"data": {"privateNetworkEndpointCreateOrGet": {
+  "publicId": "endpoint-1",
+  "dnsName": "terraform-api",
+  "privateIps": [],
+  "serviceInstanceId": "8b7cb2f0-7a1d-4b5e-9c39-0a5d1b2c3d4e",
+  "tags": ["api"]
+}}}`
+				case "getPrivateNetworkEndpoint":
+					privateIps := `[]`
+
+					if atomic.AddInt32(&polls, 1) >= testCase.readyAfter {
+						privateIps = `["10.0.0.2"]`
+					}
+
+					return fmt.Sprintf(`{"data": {"privateNetworkEndpoint": {
+  "publicId": "endpoint-1",
+  "dnsName": "terraform-api",
+  "privateIps": %s,
+  "serviceInstanceId": "8b7cb2f0-7a1d-4b5e-9c39-0a5d1b2c3d4e",
+  "tags": ["api"]
+}}}`, privateIps)
+				case "getPrivateNetworks":
+					return privateNetworkEndpointTestNetworks
+				default:
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+			})
+
+			r := &PrivateNetworkEndpointResource{client: client}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+			model := privateNetworkEndpointTestModel()
+			model.WaitForReady = types.BoolValue(testCase.waitForReady)
+			model.Timeouts = timeouts.Value{
+				Object: types.ObjectValueMust(
+					map[string]attr.Type{"create": types.StringType},
+					map[string]attr.Value{"create": types.StringValue(testCase.timeout)},
+				),
+			}
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			diags := plan.Set(ctx, model)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			resp := &fwresource.CreateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+
+			r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+
+			if testCase.expectError != "" {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected error diagnostics")
+				}
+
+				if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, testCase.expectError) {
+					t.Errorf("expected error to mention %q, got: %s", testCase.expectError, detail)
+				}
+			} else if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			if resp.State.Raw.IsNull() {
+				t.Fatal("expected endpoint to be tracked in state")
+			}
+
+			var data PrivateNetworkEndpointResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+			var privateIps []string
+
+			if !data.PrivateIps.IsNull() {
+				resp.Diagnostics.Append(data.PrivateIps.ElementsAs(ctx, &privateIps, false)...)
+			}
+
+			if resp.Diagnostics.HasError() && testCase.expectError == "" {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			if fmt.Sprint(privateIps) != fmt.Sprint(testCase.expectedIps) {
+				t.Errorf("expected private IPs %v, got %v", testCase.expectedIps, privateIps)
+			}
+		})
+	}
+}
+
+func TestPrivateNetworkEndpointFqdn(t *testing.T) {
+	client := newTestClient(t, func(operationName string) string {
+		return privateNetworkEndpointTestNetworks
+	})
+
+	testCases := map[string]struct {
+		privateNetworkId string
+		dnsName          string
+		expected         types.String
+	}{
+		"joined": {
+			privateNetworkId: "network-1",
+			dnsName:          "terraform-api",
+			expected:         types.StringValue("terraform-api.terraform-endpoint.railway.internal"),
+		},
+		"unknown network": {
+			privateNetworkId: "network-2",
+			dnsName:          "terraform-api",
+			expected:         types.StringNull(),
+		},
+		"network without dns name": {
+			privateNetworkId: "network-3",
+			dnsName:          "terraform-api",
+			expected:         types.StringNull(),
+		},
+		"endpoint without dns name": {
+			privateNetworkId: "network-1",
+			dnsName:          "",
+			expected:         types.StringNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fqdn, err := privateNetworkEndpointFqdn(context.Background(), *client, "d0519b29-5d12-4857-a5dd-76fa7418336c", testCase.privateNetworkId, testCase.dnsName)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !fqdn.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, fqdn)
+			}
+		})
+	}
+}
+
+const privateNetworkEndpointTestNetworks = `{"data": {"privateNetworks": [
+  {"publicId": "network-1", "name": "terraform-endpoint", "dnsName": "terraform-endpoint.railway.internal", "networkId": 1, "environmentId": "d0519b29-5d12-4857-a5dd-76fa7418336c", "projectId": "0bb01547-570d-4109-a5e8-138691f6a2d1", "tags": []},
+  {"publicId": "network-3", "name": "terraform-pending", "dnsName": "", "networkId": 3, "environmentId": "d0519b29-5d12-4857-a5dd-76fa7418336c", "projectId": "0bb01547-570d-4109-a5e8-138691f6a2d1", "tags": []}
+]}}`
+
+func privateNetworkEndpointTestModel() *PrivateNetworkEndpointResourceModel {
+	return &PrivateNetworkEndpointResourceModel{
+		Id:               types.StringUnknown(),
+		PrivateNetworkId: types.StringValue("network-1"),
+		ServiceId:        types.StringValue("39da7e07-fa3a-42fd-b695-d229319f2993"),
+		EnvironmentId:    types.StringValue("d0519b29-5d12-4857-a5dd-76fa7418336c"),
+		ServiceName:      types.StringValue("terraform-api"),
+		DnsName:          types.StringUnknown(),
+		Fqdn:             types.StringUnknown(),
+		PrivateIps:       types.ListUnknown(types.StringType),
+		Tags:             types.ListValueMust(types.StringType, []attr.Value{types.StringValue("api")}),
+		WaitForReady:     types.BoolValue(true),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType}),
+		},
+	}
+}
+
+func TestTagsRequireReplace(t *testing.T) {
+	listOf := func(tags ...string) types.List {
+		values := make([]attr.Value, 0, len(tags))
+
+		for _, tag := range tags {
+			values = append(values, types.StringValue(tag))
+		}
+
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	testCases := map[string]struct {
+		state                 types.List
+		plan                  types.List
+		expectRequiresReplace bool
+	}{
+		"reordered": {
+			state: listOf("api", "backend"),
+			plan:  listOf("backend", "api"),
+		},
+		"added": {
+			state:                 listOf("api"),
+			plan:                  listOf("api", "backend"),
+			expectRequiresReplace: true,
+		},
+		"cleared": {
+			state:                 listOf("api"),
+			plan:                  listOf(),
+			expectRequiresReplace: true,
+		},
+		"removed from the configuration": {
+			state:                 listOf("api"),
+			plan:                  types.ListNull(types.StringType),
+			expectRequiresReplace: true,
+		},
+		"empty removed from the configuration": {
+			state: listOf(),
+			plan:  types.ListNull(types.StringType),
+		},
+		"unknown": {
+			state:                 listOf("api"),
+			plan:                  types.ListUnknown(types.StringType),
+			expectRequiresReplace: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &PrivateNetworkEndpointResource{}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			diags := state.Set(ctx, privateNetworkEndpointTestModel())
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			diags.Append(plan.Set(ctx, privateNetworkEndpointTestModel())...)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			req := planmodifier.ListRequest{
+				Path:       path.Root("tags"),
+				State:      state,
+				Plan:       plan,
+				StateValue: testCase.state,
+				PlanValue:  testCase.plan,
+			}
+			resp := &planmodifier.ListResponse{PlanValue: testCase.plan}
+
+			tagsRequireReplace().PlanModifyList(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			if resp.RequiresReplace != testCase.expectRequiresReplace {
+				t.Errorf("expected requires replace %t, got %t", testCase.expectRequiresReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestReadTags(t *testing.T) {
+	ctx := context.Background()
+	current := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("backend"), types.StringValue("api")})
+
+	// The same tags in another order keep the current order
+	tags, diags := readTags(ctx, current, []string{"api", "backend"})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if !tags.Equal(current) {
+		t.Errorf("expected tags %s, got %s", current, tags)
+	}
+
+	// Other tags are drift
+	tags, diags = readTags(ctx, current, []string{"api"})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	expected := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("api")})
+
+	if !tags.Equal(expected) {
+		t.Errorf("expected tags %s, got %s", expected, tags)
+	}
+}
+
+func TestPrivateNetworkEndpointResourceUpdateTagOrder(t *testing.T) {
+	ctx := context.Background()
+
+	// Reordering the tags is only a change in terraform
+	client := newTestClient(t, func(operationName string) string {
+		t.Errorf("unexpected operation: %s", operationName)
+		return `{}`
+	})
+
+	r := &PrivateNetworkEndpointResource{client: client}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	current := privateNetworkEndpointTestModel()
+	current.Id = types.StringValue("endpoint-1")
+	current.DnsName = types.StringValue("terraform-api")
+	current.Fqdn = types.StringValue("terraform-api.terraform-endpoint.railway.internal")
+	current.PrivateIps = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.1")})
+	current.Tags = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("api"), types.StringValue("backend")})
+
+	planned := privateNetworkEndpointTestModel()
+	planned.Id = current.Id
+	planned.Fqdn = current.Fqdn
+	planned.Tags = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("backend"), types.StringValue("api")})
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(ctx, current)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	diags.Append(plan.Set(ctx, planned)...)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	resp := &fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data PrivateNetworkEndpointResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+	if !data.Tags.Equal(planned.Tags) {
+		t.Errorf("expected tags %s, got %s", planned.Tags, data.Tags)
+	}
+
+	if !data.DnsName.Equal(current.DnsName) || !data.PrivateIps.Equal(current.PrivateIps) {
+		t.Errorf("expected the endpoint to be kept, got dns name %s and private IPs %s", data.DnsName, data.PrivateIps)
+	}
+}
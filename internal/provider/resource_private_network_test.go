@@ -0,0 +1,699 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPrivateNetworkResourceDeleteKeepsOtherNetworks(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create two networks in the same environment
+			{
+				Config: testAccPrivateNetworkResourceConfigTwoNetworks(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network.first", "name", "terraform-first"),
+					resource.TestCheckResourceAttr("railway_private_network.first", "allow_environment_wide_delete", "false"),
+					resource.TestCheckResourceAttr("railway_private_network.second", "name", "terraform-second"),
+					resource.TestCheckResourceAttr("railway_private_network.second", "allow_environment_wide_delete", "false"),
+				),
+			},
+			// Deleting one network is refused while the other exists
+			{
+				Config:      testAccPrivateNetworkResourceConfigSecondNetwork(),
+				ExpectError: regexp.MustCompile("Private Network Delete Refused"),
+			},
+			// Changing the tags replaces the network, which is refused at plan time for the same reason
+			{
+				Config:      testAccPrivateNetworkResourceConfigTwoNetworksTagged(`["internal"]`),
+				ExpectError: regexp.MustCompile("Private Network Replacement Refused"),
+			},
+			// Both networks are still intact, allow deleting them for cleanup
+			{
+				Config: testAccPrivateNetworkResourceConfigTwoNetworks(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network.first", "name", "terraform-first"),
+					resource.TestCheckResourceAttr("railway_private_network.first", "allow_environment_wide_delete", "true"),
+					resource.TestCheckResourceAttr("railway_private_network.second", "name", "terraform-second"),
+					resource.TestCheckResourceAttr("railway_private_network.second", "allow_environment_wide_delete", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccPrivateNetworkResourceDestroyWithEndpoints(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a network with an endpoint
+			{
+				Config: testAccPrivateNetworkResourceConfigEndpoint(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network.test", "force_delete_endpoints", "false"),
+					resource.TestCheckResourceAttrSet("railway_private_network_endpoint.test", "id"),
+				),
+			},
+			// Enable cascading endpoint deletion
+			{
+				Config: testAccPrivateNetworkResourceConfigEndpoint(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network.test", "force_delete_endpoints", "true"),
+				),
+			},
+			// Destroy the network and its endpoint in the same apply
+			{
+				Config:  testAccPrivateNetworkResourceConfigEndpoint(true),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccPrivateNetworkResourceTags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPrivateNetworkResourceConfigTags(`["production"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network.test", "name", "terraform-tags"),
+					resource.TestCheckResourceAttr("railway_private_network.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("railway_private_network.test", "tags.0", "production"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_private_network.test",
+				ImportState:       true,
+				ImportStateIdFunc: privateNetworkImportIdFunc,
+				ImportStateVerify: true,
+			},
+			// ImportState with a bare identifier
+			{
+				ResourceName:  "railway_private_network.test",
+				ImportState:   true,
+				ImportStateId: "not-a-composite-id",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+			// Add a tag, which Railway can't do to an existing network
+			{
+				Config: testAccPrivateNetworkResourceConfigTags(`["production", "internal"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("railway_private_network.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("railway_private_network.test", "tags.0", "production"),
+					resource.TestCheckResourceAttr("railway_private_network.test", "tags.1", "internal"),
+				),
+			},
+			// Reorder the tags, which keeps the network
+			{
+				Config: testAccPrivateNetworkResourceConfigTags(`["internal", "production"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("railway_private_network.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("railway_private_network.test", "tags.0", "internal"),
+					resource.TestCheckResourceAttr("railway_private_network.test", "tags.1", "production"),
+				),
+			},
+			// Remove a tag
+			{
+				Config: testAccPrivateNetworkResourceConfigTags(`["internal"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("railway_private_network.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("railway_private_network.test", "tags.0", "internal"),
+				),
+			},
+			// Clear the tags
+			{
+				Config: testAccPrivateNetworkResourceConfigTags(`[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_private_network.test", "tags.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func privateNetworkImportIdFunc(state *terraform.State) (string, error) {
+	rawState, ok := state.RootModule().Resources["railway_private_network.test"]
+
+	if !ok {
+		return "", fmt.Errorf("Resource Not found")
+	}
+
+	return fmt.Sprintf("%s:%s", rawState.Primary.Attributes["environment_id"], rawState.Primary.Attributes["id"]), nil
+}
+
+func testAccPrivateNetworkResourceConfigTags(tags string) string {
+	return fmt.Sprintf(`
+resource "railway_private_network" "test" {
+  name = "terraform-tags"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  tags = %s
+}
+`, tags)
+}
+
+func testAccPrivateNetworkResourceConfigTwoNetworks(allowEnvironmentWideDelete bool) string {
+	return fmt.Sprintf(`
+resource "railway_private_network" "first" {
+  name = "terraform-first"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  allow_environment_wide_delete = %[1]t
+}
+
+resource "railway_private_network" "second" {
+  name = "terraform-second"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  allow_environment_wide_delete = %[1]t
+}
+`, allowEnvironmentWideDelete)
+}
+
+func testAccPrivateNetworkResourceConfigTwoNetworksTagged(tags string) string {
+	return fmt.Sprintf(`
+resource "railway_private_network" "first" {
+  name = "terraform-first"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  tags = %s
+}
+
+resource "railway_private_network" "second" {
+  name = "terraform-second"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+}
+`, tags)
+}
+
+func testAccPrivateNetworkResourceConfigSecondNetwork() string {
+	return `
+resource "railway_private_network" "second" {
+  name = "terraform-second"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+}
+`
+}
+
+func testAccPrivateNetworkResourceConfigEndpoint(forceDeleteEndpoints bool) string {
+	return fmt.Sprintf(`
+resource "railway_private_network" "test" {
+  name = "terraform-endpoints"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  force_delete_endpoints = %t
+}
+
+resource "railway_private_network_endpoint" "test" {
+  private_network_id = railway_private_network.test.id
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_name = "terraform-api"
+}
+`, forceDeleteEndpoints)
+}
+
+func TestPrivateNetworkResourceDeleteWithEndpoints(t *testing.T) {
+	interval := privateNetworkDeleteInterval
+	privateNetworkDeleteInterval = time.Millisecond
+	t.Cleanup(func() { privateNetworkDeleteInterval = interval })
+
+	testCases := map[string]struct {
+		hasEndpoint            bool
+		force                  bool
+		networkDeleteFailures  int32
+		expectError            string
+		expectEndpointDeleted  bool
+		expectedNetworkDeletes int32
+	}{
+		"no endpoints": {
+			expectedNetworkDeletes: 1,
+		},
+		"endpoints refused": {
+			hasEndpoint:            true,
+			expectError:            "terraform-api (service 39da7e07-fa3a-42fd-b695-d229319f2993)",
+			expectedNetworkDeletes: 0,
+		},
+		"endpoints deleted": {
+			hasEndpoint:            true,
+			force:                  true,
+			expectEndpointDeleted:  true,
+			expectedNetworkDeletes: 1,
+		},
+		"network delete retried": {
+			hasEndpoint:            true,
+			force:                  true,
+			networkDeleteFailures:  2,
+			expectEndpointDeleted:  true,
+			expectedNetworkDeletes: 3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var endpointDeleted, networkDeletes int32
+
+			client := newTestClient(t, func(operationName string) string {
+				switch operationName {
+				case "getPrivateNetworks":
+					return `{"data": {"privateNetworks": [
+  {"publicId": "network-1", "name": "terraform-endpoints", "dnsName": "terraform-endpoints.railway.internal", "networkId": 1, "environmentId": "d0519b29-5d12-4857-a5dd-76fa7418336c", "projectId": "0bb01547-570d-4109-a5e8-138691f6a2d1", "tags": []}
+]}}`
+				case "getEnvironmentServiceIds":
+					return `{"data": {"environment": {"serviceInstances": {"edges": [{"node": {"serviceId": "39da7e07-fa3a-42fd-b695-d229319f2993"}}]}}}}`
+				case "getPrivateNetworkEndpoint":
+					if !testCase.hasEndpoint || atomic.LoadInt32(&endpointDeleted) == 1 {
+						return `{"data": {"privateNetworkEndpoint": null}}`
+					}
+
+					return `{"data": {"privateNetworkEndpoint": {"publicId": "endpoint-1", "dnsName": "terraform-api", "privateIps": [], "serviceInstanceId": "8b7cb2f0-7a1d-4b5e-9c39-0a5d1b2c3d4e", "tags": []}}}`
+				case "deletePrivateNetworkEndpoint":
+					atomic.StoreInt32(&endpointDeleted, 1)
+					return `{"data": {"privateNetworkEndpointDelete": true}}`
+				case "deletePrivateNetworksForEnvironment":
+					if atomic.AddInt32(&networkDeletes, 1) <= testCase.networkDeleteFailures {
+						return `{"errors": [{"message": "private network has endpoints"}]}`
+					}
+
+					return `{"data": {"privateNetworksForEnvironmentDelete": true}}`
+				default:
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+			})
+
+			r := &PrivateNetworkResource{client: client}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			diags := state.Set(ctx, &PrivateNetworkResourceModel{
+				Id:                         types.StringValue("network-1"),
+				Name:                       types.StringValue("terraform-endpoints"),
+				ProjectId:                  types.StringValue("0bb01547-570d-4109-a5e8-138691f6a2d1"),
+				EnvironmentId:              types.StringValue("d0519b29-5d12-4857-a5dd-76fa7418336c"),
+				DnsName:                    types.StringValue("terraform-endpoints.railway.internal"),
+				Tags:                       types.ListNull(types.StringType),
+				AllowEnvironmentWideDelete: types.BoolValue(false),
+				ForceDeleteEndpoints:       types.BoolValue(testCase.force),
+			})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			resp := &fwresource.DeleteResponse{State: state}
+			r.Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+
+			if testCase.expectError != "" {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected error diagnostics")
+				}
+
+				if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, testCase.expectError) {
+					t.Errorf("expected error to mention %q, got: %s", testCase.expectError, detail)
+				}
+			} else if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			if deleted := atomic.LoadInt32(&endpointDeleted) == 1; deleted != testCase.expectEndpointDeleted {
+				t.Errorf("expected endpoint deleted to be %t, got %t", testCase.expectEndpointDeleted, deleted)
+			}
+
+			if networkDeletes != testCase.expectedNetworkDeletes {
+				t.Errorf("expected %d network deletes, got %d", testCase.expectedNetworkDeletes, networkDeletes)
+			}
+		})
+	}
+}
+
+func TestPrivateNetworkResourceReadTags(t *testing.T) {
+	testCases := map[string]struct {
+		state        types.List
+		remote       string
+		expectedTags types.List
+	}{
+		"unchanged": {
+			state:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("api")}),
+			remote:       `["api"]`,
+			expectedTags: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("api")}),
+		},
+		"changed remotely": {
+			state:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("api")}),
+			remote:       `["backend"]`,
+			expectedTags: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("backend")}),
+		},
+		"cleared remotely": {
+			state:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("api")}),
+			remote:       `[]`,
+			expectedTags: types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		"never set": {
+			state:        types.ListNull(types.StringType),
+			remote:       `[]`,
+			expectedTags: types.ListNull(types.StringType),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			client := newTestClient(t, func(operationName string) string {
+				if operationName != "getPrivateNetworks" {
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+
+				return fmt.Sprintf(`{"data": {"privateNetworks": [
+  {"publicId": "network-1", "name": "terraform-tags", "dnsName": "terraform-tags.railway.internal", "networkId": 1, "environmentId": "d0519b29-5d12-4857-a5dd-76fa7418336c", "projectId": "0bb01547-570d-4109-a5e8-138691f6a2d1", "tags": %s}
+]}}`, testCase.remote)
+			})
+
+			r := &PrivateNetworkResource{client: client}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			diags := state.Set(ctx, privateNetworkTestModel(testCase.state))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			var data PrivateNetworkResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+			if !data.Tags.Equal(testCase.expectedTags) {
+				t.Errorf("expected tags %s, got %s", testCase.expectedTags, data.Tags)
+			}
+		})
+	}
+}
+
+func TestPrivateNetworkResourceUpdateTagOrder(t *testing.T) {
+	ctx := context.Background()
+
+	// Reordering the tags is only a change in terraform
+	client := newTestClient(t, func(operationName string) string {
+		t.Errorf("unexpected operation: %s", operationName)
+		return `{}`
+	})
+
+	r := &PrivateNetworkResource{client: client}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	planned := privateNetworkTestModel(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("backend"), types.StringValue("api")}))
+	planned.DnsName = types.StringUnknown()
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(ctx, privateNetworkTestModel(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("api"), types.StringValue("backend")})))
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	diags.Append(plan.Set(ctx, planned)...)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	resp := &fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data PrivateNetworkResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+	if !data.Tags.Equal(planned.Tags) {
+		t.Errorf("expected tags %s, got %s", planned.Tags, data.Tags)
+	}
+
+	if data.DnsName.ValueString() != "terraform-tags.railway.internal" {
+		t.Errorf("expected the dns name to be kept, got %s", data.DnsName)
+	}
+}
+
+func privateNetworkTestModel(tags types.List) *PrivateNetworkResourceModel {
+	return &PrivateNetworkResourceModel{
+		Id:                         types.StringValue("network-1"),
+		Name:                       types.StringValue("terraform-tags"),
+		ProjectId:                  types.StringValue("0bb01547-570d-4109-a5e8-138691f6a2d1"),
+		EnvironmentId:              types.StringValue("d0519b29-5d12-4857-a5dd-76fa7418336c"),
+		DnsName:                    types.StringValue("terraform-tags.railway.internal"),
+		Tags:                       tags,
+		AllowEnvironmentWideDelete: types.BoolValue(false),
+		ForceDeleteEndpoints:       types.BoolValue(false),
+	}
+}
+
+func TestListPrivateNetworkEndpoints(t *testing.T) {
+	var lookups []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body struct {
+			OperationName string `json:"operationName"`
+			Variables     struct {
+				After            *string `json:"after"`
+				ServiceId        string  `json:"serviceId"`
+				PrivateNetworkId string  `json:"privateNetworkId"`
+			} `json:"variables"`
+		}
+
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Errorf("unable to decode request: %s", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch body.OperationName {
+		case "getEnvironmentServiceIds":
+			// The services span two pages
+			if body.Variables.After == nil {
+				fmt.Fprint(w, `{"data": {"environment": {"serviceInstances": {"edges": [{"node": {"serviceId": "api"}}, {"node": {"serviceId": "worker"}}], "pageInfo": {"hasNextPage": true, "endCursor": "cursor-1"}}}}}`)
+				return
+			}
+
+			fmt.Fprint(w, `{"data": {"environment": {"serviceInstances": {"edges": [{"node": {"serviceId": "db"}}], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}}`)
+		case "getPrivateNetworkEndpoint":
+			lookups = append(lookups, body.Variables.ServiceId+"/"+body.Variables.PrivateNetworkId)
+
+			switch body.Variables.ServiceId + "/" + body.Variables.PrivateNetworkId {
+			case "api/network-2", "db/network-1":
+				fmt.Fprintf(w, `{"data": {"privateNetworkEndpoint": {"publicId": "endpoint-%s", "dnsName": "%s", "privateIps": [], "serviceInstanceId": "instance", "tags": []}}}`, body.Variables.ServiceId, body.Variables.ServiceId)
+			default:
+				fmt.Fprint(w, `{"data": {"privateNetworkEndpoint": null}}`)
+			}
+		default:
+			t.Errorf("unexpected operation: %s", body.OperationName)
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	client := graphql.NewClient(server.URL, server.Client())
+
+	endpoints, err := listPrivateNetworkEndpoints(context.Background(), client, "production", []string{"network-1", "network-2"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []privateNetworkEndpointRef{
+		{id: "endpoint-api", dnsName: "api", serviceId: "api"},
+		{id: "endpoint-db", dnsName: "db", serviceId: "db"},
+	}
+
+	if !reflect.DeepEqual(endpoints, expected) {
+		t.Errorf("expected endpoints %v, got %v", expected, endpoints)
+	}
+
+	// The second network of db isn't looked up once its endpoint is found
+	expectedLookups := []string{"api/network-1", "api/network-2", "worker/network-1", "worker/network-2", "db/network-1"}
+
+	if !reflect.DeepEqual(lookups, expectedLookups) {
+		t.Errorf("expected lookups %v, got %v", expectedLookups, lookups)
+	}
+}
+
+func TestPrivateNetworkResourceModifyPlanReplace(t *testing.T) {
+	testCases := map[string]struct {
+		tags        []string
+		hasSibling  bool
+		hasEndpoint bool
+		allow       bool
+		force       bool
+		expectError string
+		expectCalls bool
+	}{
+		"reordered tags": {
+			tags: []string{"backend", "api"},
+		},
+		"unused network": {
+			tags:        []string{"api"},
+			expectCalls: true,
+		},
+		"other networks": {
+			tags:        []string{"api"},
+			hasSibling:  true,
+			expectError: "would also delete: terraform-other",
+			expectCalls: true,
+		},
+		"other networks allowed": {
+			tags:        []string{"api"},
+			hasSibling:  true,
+			allow:       true,
+			expectCalls: true,
+		},
+		"endpoints": {
+			tags:        []string{"api"},
+			hasEndpoint: true,
+			expectError: "terraform-api (service 39da7e07-fa3a-42fd-b695-d229319f2993)",
+			expectCalls: true,
+		},
+		"endpoints forced": {
+			tags:        []string{"api"},
+			hasEndpoint: true,
+			force:       true,
+			expectCalls: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var calls int32
+
+			client := newTestClient(t, func(operationName string) string {
+				atomic.AddInt32(&calls, 1)
+
+				switch operationName {
+				case "getPrivateNetworks":
+					networks := `{"publicId": "network-1", "name": "terraform-tags", "dnsName": "terraform-tags.railway.internal", "networkId": 1, "environmentId": "d0519b29-5d12-4857-a5dd-76fa7418336c", "projectId": "0bb01547-570d-4109-a5e8-138691f6a2d1", "tags": ["api", "backend"]}`
+
+					if testCase.hasSibling {
+						networks += `, {"publicId": "network-2", "name": "terraform-other", "dnsName": "terraform-other.railway.internal", "networkId": 2, "environmentId": "d0519b29-5d12-4857-a5dd-76fa7418336c", "projectId": "0bb01547-570d-4109-a5e8-138691f6a2d1", "tags": []}`
+					}
+
+					return fmt.Sprintf(`{"data": {"privateNetworks": [%s]}}`, networks)
+				case "getEnvironmentServiceIds":
+					return `{"data": {"environment": {"serviceInstances": {"edges": [{"node": {"serviceId": "39da7e07-fa3a-42fd-b695-d229319f2993"}}], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}}`
+				case "getPrivateNetworkEndpoint":
+					if !testCase.hasEndpoint {
+						return `{"data": {"privateNetworkEndpoint": null}}`
+					}
+
+					return `{"data": {"privateNetworkEndpoint": {"publicId": "endpoint-1", "dnsName": "terraform-api", "privateIps": [], "serviceInstanceId": "8b7cb2f0-7a1d-4b5e-9c39-0a5d1b2c3d4e", "tags": []}}}`
+				default:
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+			})
+
+			r := &PrivateNetworkResource{client: client}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+			current := privateNetworkTestModel(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("api"), types.StringValue("backend")}))
+			current.AllowEnvironmentWideDelete = types.BoolValue(testCase.allow)
+			current.ForceDeleteEndpoints = types.BoolValue(testCase.force)
+
+			tags := make([]attr.Value, 0, len(testCase.tags))
+
+			for _, tag := range testCase.tags {
+				tags = append(tags, types.StringValue(tag))
+			}
+
+			planned := privateNetworkTestModel(types.ListValueMust(types.StringType, tags))
+			planned.AllowEnvironmentWideDelete = current.AllowEnvironmentWideDelete
+			planned.ForceDeleteEndpoints = current.ForceDeleteEndpoints
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			diags := state.Set(ctx, current)
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			diags.Append(plan.Set(ctx, planned)...)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+
+			if testCase.expectError != "" {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected error diagnostics")
+				}
+
+				if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, testCase.expectError) {
+					t.Errorf("expected error to mention %q, got: %s", testCase.expectError, detail)
+				}
+			} else if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			if called := atomic.LoadInt32(&calls) > 0; called != testCase.expectCalls {
+				t.Errorf("expected the api to be called to be %t, got %t", testCase.expectCalls, called)
+			}
+		})
+	}
+}
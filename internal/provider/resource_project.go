@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -49,8 +50,10 @@ type ProjectResourceModel struct {
 	Description        types.String `tfsdk:"description"`
 	Private            types.Bool   `tfsdk:"private"`
 	HasPrDeploys       types.Bool   `tfsdk:"has_pr_deploys"`
+	PrEnvironmentsBot  types.Bool   `tfsdk:"pr_environments_use_bot"`
 	WorkspaceId        types.String `tfsdk:"workspace_id"`
 	DefaultEnvironment types.Object `tfsdk:"default_environment"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -93,13 +96,18 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"pr_environments_use_bot": schema.BoolAttribute{
+				MarkdownDescription: "Whether PR deploys also create environments for pull requests opened by bots, such as Dependabot. **Default** `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"workspace_id": schema.StringAttribute{
-				MarkdownDescription: "Identifier of the workspace the project belongs to. Required if the railway token has access to multiple workspaces.",
+				MarkdownDescription: "Identifier of the workspace the project belongs to. Required if the railway token has access to multiple workspaces. Changing it transfers the project to the other workspace, keeping its id.",
 				Computed:            true,
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
@@ -140,6 +148,12 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether to prevent destroying the project. It has to be disabled and applied before the project can be destroyed. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -204,11 +218,32 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	project := response.ProjectCreate.Project
 
+	// Bot PR environments can't be set when creating the project
+	if data.PrEnvironmentsBot.ValueBool() != project.BotPrEnvironments {
+		updateResponse, err := updateProject(ctx, *r.client, project.Id, ProjectUpdateInput{
+			Name:              project.Name,
+			Description:       project.Description,
+			IsPublic:          project.IsPublic,
+			PrDeploys:         project.PrDeploys,
+			BotPrEnvironments: data.PrEnvironmentsBot.ValueBool(),
+		})
+
+		if err != nil {
+			// Save the project so it is not orphaned, the setting is applied again on the next apply
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), project.Id)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s", err))
+			return
+		}
+
+		project = updateResponse.ProjectUpdate.Project
+	}
+
 	data.Id = types.StringValue(project.Id)
 	data.Name = types.StringValue(project.Name)
 	data.Description = types.StringValue(project.Description)
 	data.Private = types.BoolValue(!project.IsPublic)
 	data.HasPrDeploys = types.BoolValue(project.PrDeploys)
+	data.PrEnvironmentsBot = types.BoolValue(project.BotPrEnvironments)
 
 	if project.Workspace != nil {
 		data.WorkspaceId = types.StringValue(project.Workspace.Id)
@@ -253,11 +288,17 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	data.Description = types.StringValue(project.Description)
 	data.Private = types.BoolValue(!project.IsPublic)
 	data.HasPrDeploys = types.BoolValue(project.PrDeploys)
+	data.PrEnvironmentsBot = types.BoolValue(project.BotPrEnvironments)
 
 	if project.Workspace != nil {
 		data.WorkspaceId = types.StringValue(project.Workspace.Id)
 	}
 
+	// Not stored in Railway, imported projects aren't protected until configured
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+
 	data.DefaultEnvironment = types.ObjectValueMust(
 		defaultEnvironmentAttrTypes,
 		map[string]attr.Value{
@@ -271,19 +312,40 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *ProjectResourceModel
+	var state *ProjectResourceModel
 	var defaultEnvironmentData *ProjectResourceDefaultEnvironmentModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if !data.WorkspaceId.IsUnknown() && !data.WorkspaceId.IsNull() && !data.WorkspaceId.Equal(state.WorkspaceId) {
+		_, err := transferProject(ctx, *r.client, data.Id.ValueString(), ProjectTransferInput{
+			WorkspaceId: data.WorkspaceId.ValueString(),
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to transfer project, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "transferred a project")
+
+		if err := waitForProjectTransfer(ctx, *r.client, data.Id.ValueString(), data.WorkspaceId.ValueString(), projectTransferTimeout); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to transfer project, got error: %s", err))
+			return
+		}
+	}
+
 	input := ProjectUpdateInput{
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
-		IsPublic:    !data.Private.ValueBool(),
-		PrDeploys:   data.HasPrDeploys.ValueBool(),
+		Name:              data.Name.ValueString(),
+		Description:       data.Description.ValueString(),
+		IsPublic:          !data.Private.ValueBool(),
+		PrDeploys:         data.HasPrDeploys.ValueBool(),
+		BotPrEnvironments: data.PrEnvironmentsBot.ValueBool(),
 	}
 
 	resp.Diagnostics.Append(data.DefaultEnvironment.As(ctx, &defaultEnvironmentData, basetypes.ObjectAsOptions{})...)
@@ -303,28 +365,29 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	project := response.ProjectUpdate.Project
 
-	noOfEnvironments := len(project.Environments.Edges)
-
-	if noOfEnvironments < 1 {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Expected at least one environment, got %d", noOfEnvironments))
-		return
-	}
-
 	data.Id = types.StringValue(project.Id)
 	data.Name = types.StringValue(project.Name)
 	data.Description = types.StringValue(project.Description)
 	data.Private = types.BoolValue(!project.IsPublic)
 	data.HasPrDeploys = types.BoolValue(project.PrDeploys)
+	data.PrEnvironmentsBot = types.BoolValue(project.BotPrEnvironments)
 
 	if project.Workspace != nil {
 		data.WorkspaceId = types.StringValue(project.Workspace.Id)
 	}
 
+	environment, err := findDefaultEnvironment(ctx, *r.client, project.Id)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read default environment, got error: %s", err))
+		return
+	}
+
 	data.DefaultEnvironment = types.ObjectValueMust(
 		defaultEnvironmentAttrTypes,
 		map[string]attr.Value{
-			"id":   types.StringValue(project.Environments.Edges[0].Node.Id),
-			"name": types.StringValue(project.Environments.Edges[0].Node.Name),
+			"id":   types.StringValue(environment.Id),
+			"name": types.StringValue(environment.Name),
 		},
 	)
 
@@ -342,6 +405,10 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if deletionProtected(data.DeletionProtection, "project", &resp.Diagnostics) {
+		return
+	}
+
 	_, err := deleteProject(ctx, *r.client, data.Id.ValueString())
 
 	if err != nil {
@@ -356,7 +423,43 @@ func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportSt
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func defaultEnvironmentForProject(ctx context.Context, client graphql.Client, projectId string) (*Project, *ProjectEnvironmentsProjectEnvironmentsConnectionEdgesProjectEnvironmentsConnectionEdgeNodeEnvironment, error) {
+var (
+	projectTransferTimeout  = 10 * time.Minute
+	projectTransferInterval = 3 * time.Second
+)
+
+// waitForProjectTransfer polls the project until it belongs to the workspace. Transfers that wait for the
+// destination workspace to accept them never get there, so they fail once the timeout passes.
+func waitForProjectTransfer(ctx context.Context, client graphql.Client, projectId string, workspaceId string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pending := fmt.Errorf("transfer to workspace %s is still pending, it may have to be accepted in the destination workspace", workspaceId)
+
+	for {
+		response, err := getProject(ctx, client, projectId)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return pending
+			}
+
+			return err
+		}
+
+		if workspace := response.Project.Workspace; workspace != nil && workspace.Id == workspaceId {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return pending
+		case <-time.After(projectTransferInterval):
+		}
+	}
+}
+
+func defaultEnvironmentForProject(ctx context.Context, client graphql.Client, projectId string) (*Project, *listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment, error) {
 	response, err := getProject(ctx, client, projectId)
 
 	if err != nil {
@@ -364,16 +467,56 @@ func defaultEnvironmentForProject(ctx context.Context, client graphql.Client, pr
 	}
 
 	project := response.Project.Project
-	noOfEnvironments := len(project.Environments.Edges)
+	environment, err := findDefaultEnvironment(ctx, client, project.Id)
 
-	if noOfEnvironments < 1 {
-		return nil, nil, fmt.Errorf("expected at least one environment, got %d", noOfEnvironments)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Mark the oldest environment as the default
-	sort.SliceStable(project.Environments.Edges, func(i, j int) bool {
-		return project.Environments.Edges[i].Node.CreatedAt.Before(project.Environments.Edges[j].Node.CreatedAt)
+	return &project, environment, nil
+}
+
+// findDefaultEnvironment reads every page of the environments of the project, since the project itself only
+// returns the first one, and picks the default among them.
+func findDefaultEnvironment(ctx context.Context, client graphql.Client, projectId string) (*listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment, error) {
+	environments, err := listAllEnvironments(ctx, client, projectId, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	environment := defaultEnvironment(environments)
+
+	if environment == nil {
+		return nil, fmt.Errorf("expected at least one non-ephemeral environment, got %d environments", len(environments))
+	}
+
+	return environment, nil
+}
+
+// defaultEnvironment picks the oldest environment that isn't ephemeral, the API doesn't guarantee the order of
+// the environments. Returns nil when there is no such environment.
+func defaultEnvironment(all []listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) *listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment {
+	var environments []listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment
+
+	for _, environment := range all {
+		if !environment.IsEphemeral {
+			environments = append(environments, environment)
+		}
+	}
+
+	if len(environments) == 0 {
+		return nil
+	}
+
+	// Environments created together can share a timestamp, so fall back to the id to stay stable
+	sort.Slice(environments, func(i, j int) bool {
+		if !environments[i].CreatedAt.Equal(environments[j].CreatedAt) {
+			return environments[i].CreatedAt.Before(environments[j].CreatedAt)
+		}
+
+		return environments[i].Id < environments[j].Id
 	})
 
-	return &project, &project.Environments.Edges[0].Node, nil
+	return &environments[0]
 }
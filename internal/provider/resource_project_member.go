@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var _ resource.Resource = &ProjectMemberResource{}
+var _ resource.ResourceWithImportState = &ProjectMemberResource{}
+
+func NewProjectMemberResource() resource.Resource {
+	return &ProjectMemberResource{}
+}
+
+type ProjectMemberResource struct {
+	client *graphql.Client
+}
+
+type ProjectMemberResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	ProjectId    types.String `tfsdk:"project_id"`
+	Email        types.String `tfsdk:"email"`
+	Role         types.String `tfsdk:"role"`
+	UserId       types.String `tfsdk:"user_id"`
+	InvitationId types.String `tfsdk:"invitation_id"`
+}
+
+func (r *ProjectMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_member"
+}
+
+func (r *ProjectMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway project member. Invites the user by email, or adopts them when they are already a member. Changing the role of a member doesn't invite them again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project member, made of the project id and email.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the member.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the member in the project. One of `ADMIN`, `MEMBER` or `VIEWER`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(ProjectRoleAdmin), string(ProjectRoleMember), string(ProjectRoleViewer)),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user. Null while the invitation hasn't been accepted.",
+				Computed:            true,
+			},
+			"invitation_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the pending invitation. Null once the user is a member.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ProjectMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := findProjectMember(ctx, *r.client, data.ProjectId.ValueString(), data.Email.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project members, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(data.ProjectId.ValueString() + ":" + data.Email.ValueString())
+
+	// Already a member, so adopt them instead of sending an invitation
+	if member != nil {
+		if string(member.Role) != data.Role.ValueString() {
+			member = r.updateRole(ctx, data, member.Id, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		data.UserId = types.StringValue(member.Id)
+		data.InvitationId = types.StringNull()
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	invitation, err := createProjectInvitation(ctx, *r.client, data.ProjectId.ValueString(), ProjectInvitee{
+		Email: data.Email.ValueString(),
+		Role:  ProjectRole(data.Role.ValueString()),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite project member, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "invited a project member")
+
+	data.UserId = types.StringNull()
+	data.InvitationId = types.StringValue(invitation.ProjectInvitationCreate.Id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProjectMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := findProjectMember(ctx, *r.client, data.ProjectId.ValueString(), data.Email.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project members, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(data.ProjectId.ValueString() + ":" + data.Email.ValueString())
+
+	// The live role, so roles changed in the dashboard show up as drift
+	if member != nil {
+		data.Role = types.StringValue(string(member.Role))
+		data.UserId = types.StringValue(member.Id)
+		data.InvitationId = types.StringNull()
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	invitationId, err := findProjectInvitation(ctx, *r.client, data.ProjectId.ValueString(), data.Email.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project invitations, got error: %s", err))
+		return
+	}
+
+	// Removed, declined or expired, let it be invited again
+	if invitationId == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Invitations don't report their role, so it is kept from state
+	data.UserId = types.StringNull()
+	data.InvitationId = types.StringValue(invitationId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ProjectMemberResourceModel
+	var state *ProjectMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.UserId = state.UserId
+	data.InvitationId = state.InvitationId
+
+	if data.Role.Equal(state.Role) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if !state.UserId.IsNull() {
+		r.updateRole(ctx, data, state.UserId.ValueString(), &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// A pending invitation can't be changed, only replaced with one for the new role
+	_, err := deleteProjectInvitation(ctx, *r.client, state.InvitationId.ValueString())
+
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project invitation, got error: %s", err))
+		return
+	}
+
+	invitation, err := createProjectInvitation(ctx, *r.client, data.ProjectId.ValueString(), ProjectInvitee{
+		Email: data.Email.ValueString(),
+		Role:  ProjectRole(data.Role.ValueString()),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite project member, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "invited a project member again")
+
+	data.InvitationId = types.StringValue(invitation.ProjectInvitationCreate.Id)
+
+	resp.Diagnostics.AddWarning(
+		"Project Invitation Sent Again",
+		fmt.Sprintf("%s hadn't accepted the invitation yet, so it was replaced with an invitation for the %s role.", data.Email.ValueString(), data.Role.ValueString()),
+	)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProjectMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.UserId.IsNull() {
+		_, err := removeProjectMember(ctx, *r.client, ProjectMemberRemoveInput{
+			ProjectId: data.ProjectId.ValueString(),
+			UserId:    data.UserId.ValueString(),
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove project member, got error: %s", graphqlErrorMessage(err)))
+			return
+		}
+
+		tflog.Trace(ctx, "removed a project member")
+		return
+	}
+
+	_, err := deleteProjectInvitation(ctx, *r.client, data.InvitationId.ValueString())
+
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project invitation, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a project invitation")
+}
+
+func (r *ProjectMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectId, email, ok := strings.Cut(req.ID, ":")
+
+	if !ok || projectId == "" || email == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_id:email. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), email)...)
+}
+
+// updateRole changes the role of the member in place. Railway's own message is reported when it refuses, like when
+// the last admin would be downgraded.
+func (r *ProjectMemberResource) updateRole(ctx context.Context, data *ProjectMemberResourceModel, userId string, diags *diag.Diagnostics) *ProjectMember {
+	response, err := updateProjectMember(ctx, *r.client, ProjectMemberUpdateInput{
+		ProjectId: data.ProjectId.ValueString(),
+		Role:      ProjectRole(data.Role.ValueString()),
+		UserId:    userId,
+	})
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("role"),
+			"Unable To Change Project Role",
+			fmt.Sprintf("Railway refused to change the role of %s to %s: %s", data.Email.ValueString(), data.Role.ValueString(), graphqlErrorMessage(err)),
+		)
+
+		return nil
+	}
+
+	tflog.Trace(ctx, "updated a project member")
+
+	return &response.ProjectMemberUpdate.ProjectMember
+}
+
+func findProjectMember(ctx context.Context, client graphql.Client, projectId string, email string) (*ProjectMember, error) {
+	response, err := listProjectMembers(ctx, client, projectId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range response.ProjectMembers {
+		if strings.EqualFold(member.Email, email) {
+			return &member.ProjectMember, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findProjectInvitation returns the id of the pending invitation for the email, or an empty string when there is none.
+func findProjectInvitation(ctx context.Context, client graphql.Client, projectId string, email string) (string, error) {
+	response, err := listProjectInvitations(ctx, client, projectId)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, invitation := range response.ProjectInvitations {
+		if strings.EqualFold(invitation.Email, email) && !invitation.IsExpired {
+			return invitation.Id, nil
+		}
+	}
+
+	return "", nil
+}
+
+// graphqlErrorMessage returns the messages of the GraphQL errors Railway responded with, without the location and
+// path prefixes, or the error itself when it isn't a GraphQL error.
+func graphqlErrorMessage(err error) string {
+	var list gqlerror.List
+
+	if !errors.As(err, &list) || len(list) == 0 {
+		return err.Error()
+	}
+
+	messages := []string{}
+
+	for _, gqlErr := range list {
+		messages = append(messages, gqlErr.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
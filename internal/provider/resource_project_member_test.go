@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectMemberResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectMemberResourceConfigDefault("VIEWER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_project_member.test", "id", "0bb01547-570d-4109-a5e8-138691f6a2d1:terraform-tester@example.com"),
+					resource.TestCheckResourceAttr("railway_project_member.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_project_member.test", "email", "terraform-tester@example.com"),
+					resource.TestCheckResourceAttr("railway_project_member.test", "role", "VIEWER"),
+					resource.TestCheckNoResourceAttr("railway_project_member.test", "user_id"),
+					resource.TestCheckResourceAttrSet("railway_project_member.test", "invitation_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "railway_project_member.test",
+				ImportState:             true,
+				ImportStateId:           "0bb01547-570d-4109-a5e8-138691f6a2d1:terraform-tester@example.com",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"role"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectMemberResourceConfigDefault("MEMBER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_project_member.test", "role", "MEMBER"),
+					resource.TestCheckResourceAttrSet("railway_project_member.test", "invitation_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectMemberResourceConfigDefault(role string) string {
+	return fmt.Sprintf(`
+resource "railway_project_member" "test" {
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  email = "terraform-tester@example.com"
+  role = "%s"
+}
+`, role)
+}
+
+func TestProjectMemberUpdateRole(t *testing.T) {
+	testCases := map[string]struct {
+		response    string
+		expectError string
+	}{
+		"updated": {
+			response: `{"data": {"projectMemberUpdate": {"id": "user-1", "email": "alice@example.com", "role": "VIEWER"}}}`,
+		},
+		"last admin": {
+			response:    `{"errors": [{"message": "Project must have at least one admin", "path": ["projectMemberUpdate"]}], "data": null}`,
+			expectError: "Railway refused to change the role of alice@example.com to VIEWER: Project must have at least one admin",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := newTestClient(t, func(operationName string) string {
+				if operationName != "updateProjectMember" {
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+
+				return testCase.response
+			})
+
+			r := &ProjectMemberResource{client: client}
+
+			data := &ProjectMemberResourceModel{
+				ProjectId: types.StringValue("project-1"),
+				Email:     types.StringValue("alice@example.com"),
+				Role:      types.StringValue("VIEWER"),
+			}
+
+			var diags diag.Diagnostics
+
+			member := r.updateRole(context.Background(), data, "user-1", &diags)
+
+			if testCase.expectError != "" {
+				if !diags.HasError() || !strings.HasSuffix(diags.Errors()[0].Detail(), testCase.expectError) {
+					t.Fatalf("expected error ending with %q, got %v", testCase.expectError, diags)
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if member == nil || member.Role != ProjectRoleViewer {
+				t.Errorf("expected a viewer, got %+v", member)
+			}
+		})
+	}
+}
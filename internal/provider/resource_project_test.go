@@ -1,8 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -18,10 +24,12 @@ func TestAccProjectResourceDefault(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestMatchResourceAttr("railway_project.test", "id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_project.test", "name", "todo-app"),
+					resource.TestCheckResourceAttr("railway_project.test", "deletion_protection", "false"),
 					resource.TestCheckResourceAttr("railway_project.test", "workspace_id", "ecb63be7-63fb-47fe-95fc-1585d24e172d"),
 					resource.TestCheckResourceAttr("railway_project.test", "description", ""),
 					resource.TestCheckResourceAttr("railway_project.test", "private", "true"),
 					resource.TestCheckResourceAttr("railway_project.test", "has_pr_deploys", "false"),
+					resource.TestCheckResourceAttr("railway_project.test", "pr_environments_use_bot", "false"),
 					resource.TestMatchResourceAttr("railway_project.test", "default_environment.id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_project.test", "default_environment.name", "production"),
 				),
@@ -42,6 +50,7 @@ func TestAccProjectResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("railway_project.test", "description", ""),
 					resource.TestCheckResourceAttr("railway_project.test", "private", "true"),
 					resource.TestCheckResourceAttr("railway_project.test", "has_pr_deploys", "false"),
+					resource.TestCheckResourceAttr("railway_project.test", "pr_environments_use_bot", "false"),
 					resource.TestMatchResourceAttr("railway_project.test", "default_environment.id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_project.test", "default_environment.name", "production"),
 				),
@@ -56,6 +65,7 @@ func TestAccProjectResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("railway_project.test", "description", "nice project"),
 					resource.TestCheckResourceAttr("railway_project.test", "private", "false"),
 					resource.TestCheckResourceAttr("railway_project.test", "has_pr_deploys", "true"),
+					resource.TestCheckResourceAttr("railway_project.test", "pr_environments_use_bot", "true"),
 					resource.TestMatchResourceAttr("railway_project.test", "default_environment.id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_project.test", "default_environment.name", "production"),
 				),
@@ -86,6 +96,7 @@ func TestAccProjectResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("railway_project.test", "description", "nice project"),
 					resource.TestCheckResourceAttr("railway_project.test", "private", "false"),
 					resource.TestCheckResourceAttr("railway_project.test", "has_pr_deploys", "true"),
+					resource.TestCheckResourceAttr("railway_project.test", "pr_environments_use_bot", "true"),
 					resource.TestMatchResourceAttr("railway_project.test", "default_environment.id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_project.test", "default_environment.name", "staging"),
 				),
@@ -106,6 +117,7 @@ func TestAccProjectResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("railway_project.test", "description", "nice project"),
 					resource.TestCheckResourceAttr("railway_project.test", "private", "false"),
 					resource.TestCheckResourceAttr("railway_project.test", "has_pr_deploys", "true"),
+					resource.TestCheckResourceAttr("railway_project.test", "pr_environments_use_bot", "true"),
 					resource.TestMatchResourceAttr("railway_project.test", "default_environment.id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_project.test", "default_environment.name", "staging"),
 				),
@@ -120,6 +132,7 @@ func TestAccProjectResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("railway_project.test", "description", ""),
 					resource.TestCheckResourceAttr("railway_project.test", "private", "true"),
 					resource.TestCheckResourceAttr("railway_project.test", "has_pr_deploys", "false"),
+					resource.TestCheckResourceAttr("railway_project.test", "pr_environments_use_bot", "false"),
 					resource.TestMatchResourceAttr("railway_project.test", "default_environment.id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_project.test", "default_environment.name", "staging"),
 				),
@@ -135,6 +148,186 @@ func TestAccProjectResourceNonDefault(t *testing.T) {
 	})
 }
 
+func TestAccProjectResourceDeletionProtection(t *testing.T) {
+	var projectId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with deletion protection
+			{
+				Config: testAccProjectResourceConfigDeletionProtection("todo-app", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_project.test", "deletion_protection", "true"),
+					resource.TestCheckResourceAttrWith("railway_project.test", "id", func(value string) error {
+						projectId = value
+						return nil
+					}),
+				),
+			},
+			// Destroy is refused while protected
+			{
+				Config:      testAccProjectResourceConfigDeletionProtection("todo-app", true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			// The project still exists, so refreshing it plans no changes
+			{
+				Config:   testAccProjectResourceConfigDeletionProtection("todo-app", true),
+				PlanOnly: true,
+			},
+			// Disable deletion protection in place
+			{
+				Config: testAccProjectResourceConfigDeletionProtection("todo-app", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_project.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttrWith("railway_project.test", "id", func(value string) error {
+						if value != projectId {
+							return fmt.Errorf("expected project %s to be kept, got %s", projectId, value)
+						}
+
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestDefaultEnvironment(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	environment := func(id string, age time.Duration, isEphemeral bool) listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment {
+		return listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment{
+			Id:          id,
+			Name:        id,
+			CreatedAt:   created.Add(age),
+			IsEphemeral: isEphemeral,
+		}
+	}
+
+	testCases := map[string]struct {
+		edges    []listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment
+		expected string
+	}{
+		"oldest": {
+			edges: []listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment{
+				environment("production", 0, false),
+				environment("staging", time.Hour, false),
+				environment("development", 2*time.Hour, false),
+			},
+			expected: "production",
+		},
+		"ephemeral older": {
+			edges: []listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment{
+				environment("pr-1", 0, true),
+				environment("production", time.Hour, false),
+				environment("pr-2", 2*time.Hour, true),
+			},
+			expected: "production",
+		},
+		"same creation time": {
+			edges: []listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment{
+				environment("b", 0, false),
+				environment("a", 0, false),
+				environment("c", time.Hour, false),
+			},
+			expected: "a",
+		},
+		"only ephemeral": {
+			edges: []listEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment{
+				environment("pr-1", 0, true),
+			},
+		},
+		"empty": {},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			random := rand.New(rand.NewSource(1))
+
+			// The API doesn't guarantee the order of the edges, so the result must not depend on it
+			for i := 0; i < 10; i++ {
+				edges := append(testCase.edges[:0:0], testCase.edges...)
+				random.Shuffle(len(edges), func(a, b int) {
+					edges[a], edges[b] = edges[b], edges[a]
+				})
+
+				got := defaultEnvironment(edges)
+
+				if testCase.expected == "" {
+					if got != nil {
+						t.Fatalf("expected no default environment, got %s", got.Id)
+					}
+
+					continue
+				}
+
+				if got == nil {
+					t.Fatalf("expected default environment %s, got none", testCase.expected)
+				}
+
+				if got.Id != testCase.expected {
+					t.Fatalf("expected default environment %s, got %s", testCase.expected, got.Id)
+				}
+			}
+		})
+	}
+}
+
+func TestWaitForProjectTransfer(t *testing.T) {
+	interval := projectTransferInterval
+	projectTransferInterval = 10 * time.Millisecond
+	t.Cleanup(func() { projectTransferInterval = interval })
+
+	testCases := map[string]struct {
+		workspaces  []string
+		expectError string
+	}{
+		"transferred": {
+			workspaces: []string{"workspace-1", "workspace-1", "workspace-2"},
+		},
+		"pending acceptance": {
+			workspaces:  []string{"workspace-1"},
+			expectError: "still pending",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var reads int32
+
+			client := newTestClient(t, func(operationName string) string {
+				if operationName != "getProject" {
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+
+				index := int(atomic.AddInt32(&reads, 1)) - 1
+				workspace := testCase.workspaces[min(index, len(testCase.workspaces)-1)]
+
+				return fmt.Sprintf(`{"data": {"project": {"id": "project-1", "name": "todo-app", "workspace": {"id": "%s"}, "environments": {"edges": []}}}}`, workspace)
+			})
+
+			err := waitForProjectTransfer(context.Background(), *client, "project-1", "workspace-2", 100*time.Millisecond)
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func testAccProjectResourceConfigDefault(name string) string {
 	return fmt.Sprintf(`
 resource "railway_project" "test" {
@@ -143,6 +336,15 @@ resource "railway_project" "test" {
 `, name)
 }
 
+func testAccProjectResourceConfigDeletionProtection(name string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "railway_project" "test" {
+  name = "%s"
+  deletion_protection = %t
+}
+`, name, deletionProtection)
+}
+
 func testAccProjectResourceConfigDefaultEnvironmentName(name string, environmentName string) string {
 	return fmt.Sprintf(`
 resource "railway_project" "test" {
@@ -163,6 +365,7 @@ resource "railway_project" "test" {
   description = "nice project"
   private = false
   has_pr_deploys = true
+  pr_environments_use_bot = true
 
   default_environment = {
     name = "%s"
@@ -14,9 +14,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -28,6 +30,7 @@ import (
 
 var _ resource.Resource = &ServiceResource{}
 var _ resource.ResourceWithImportState = &ServiceResource{}
+var _ resource.ResourceWithModifyPlan = &ServiceResource{}
 
 func NewServiceResource() resource.Resource {
 	return &ServiceResource{}
@@ -38,17 +41,21 @@ type ServiceResource struct {
 }
 
 type ServiceResourceVolumeModel struct {
-	Id        types.String  `tfsdk:"id"`
-	Name      types.String  `tfsdk:"name"`
-	MountPath types.String  `tfsdk:"mount_path"`
-	Size      types.Float64 `tfsdk:"size"`
+	Id                    types.String  `tfsdk:"id"`
+	Name                  types.String  `tfsdk:"name"`
+	MountPath             types.String  `tfsdk:"mount_path"`
+	Size                  types.Float64 `tfsdk:"size"`
+	Region                types.String  `tfsdk:"region"`
+	ReplaceOnRegionChange types.Bool    `tfsdk:"replace_on_region_change"`
 }
 
 var volumeAttrTypes = map[string]attr.Type{
-	"id":         types.StringType,
-	"name":       types.StringType,
-	"mount_path": types.StringType,
-	"size":       types.Float64Type,
+	"id":                       types.StringType,
+	"name":                     types.StringType,
+	"mount_path":               types.StringType,
+	"size":                     types.Float64Type,
+	"region":                   types.StringType,
+	"replace_on_region_change": types.BoolType,
 }
 
 type ServiceResourceRegionModel struct {
@@ -69,6 +76,7 @@ type numReplicas struct {
 type ServiceResourceModel struct {
 	Id                                 types.String `tfsdk:"id"`
 	Name                               types.String `tfsdk:"name"`
+	Icon                               types.String `tfsdk:"icon"`
 	ProjectId                          types.String `tfsdk:"project_id"`
 	CronSchedule                       types.String `tfsdk:"cron_schedule"`
 	SourceImage                        types.String `tfsdk:"source_image"`
@@ -80,6 +88,7 @@ type ServiceResourceModel struct {
 	ConfigPath                         types.String `tfsdk:"config_path"`
 	Volume                             types.Object `tfsdk:"volume"`
 	Regions                            types.List   `tfsdk:"regions"`
+	DeletionProtection                 types.Bool   `tfsdk:"deletion_protection"`
 }
 
 func (r *ServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -104,6 +113,13 @@ func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringvalidator.UTF8LengthAtLeast(1),
 				},
 			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Icon of the service, such as the slug of an icon from the Railway dashboard (`postgresql`, `redis`, ...) or an image url.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				MarkdownDescription: "Identifier of the project the service belongs to.",
 				Required:            true,
@@ -125,7 +141,7 @@ func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "Source image of the service. Conflicts with `source_repo`, `source_repo_branch`, `root_directory` and `config_path`.",
 				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.UTF8LengthAtLeast(1),
+					imageReferenceValidator(),
 					stringvalidator.ConflictsWith(path.MatchRoot("source_repo")),
 					stringvalidator.ConflictsWith(path.MatchRoot("source_repo_branch")),
 					stringvalidator.ConflictsWith(path.MatchRoot("root_directory")),
@@ -188,7 +204,7 @@ func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"volume": schema.SingleNestedAttribute{
-				MarkdownDescription: "Volume connected to the service.",
+				MarkdownDescription: "Volume connected to the service. Don't combine it with a `railway_volume_instance` of the same volume, both would manage its mount path.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"id": schema.StringAttribute{
@@ -219,6 +235,23 @@ func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest
 							float64planmodifier.UseStateForUnknown(),
 						},
 					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "Region of the volume. Defaults to the default region. Railway has no API to migrate a volume, so changing it fails the plan unless `replace_on_region_change` is set.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
+					},
+					"replace_on_region_change": schema.BoolAttribute{
+						MarkdownDescription: "Replace the volume with a new, empty one in the new region when `region` changes. **All data on the volume is lost.** **Default** `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
 				},
 			},
 			"regions": schema.ListNestedAttribute{
@@ -247,6 +280,12 @@ func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether to prevent destroying the service. It has to be disabled and applied before the service can be destroyed. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -329,6 +368,64 @@ func (r *ServiceResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+func (r *ServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare against on create and nothing to plan on destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *ServiceResourceModel
+	var state *ServiceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || data.Volume.IsNull() || data.Volume.IsUnknown() || state.Volume.IsNull() {
+		return
+	}
+
+	var volumeData *ServiceResourceVolumeModel
+	var volumeState *ServiceResourceVolumeModel
+
+	resp.Diagnostics.Append(data.Volume.As(ctx, &volumeData, basetypes.ObjectAsOptions{})...)
+	resp.Diagnostics.Append(state.Volume.As(ctx, &volumeState, basetypes.ObjectAsOptions{})...)
+
+	if resp.Diagnostics.HasError() || !volumeRegionChanged(volumeState, volumeData) {
+		return
+	}
+
+	if !volumeData.ReplaceOnRegionChange.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("volume").AtName("region"),
+			"Volume Region Change",
+			fmt.Sprintf(
+				"Volume %s is in region %s. Railway has no API to migrate volumes, so moving it to %s needs a new, empty volume. Set `replace_on_region_change` to replace it and lose its data, or migrate it in the Railway dashboard.",
+				volumeState.Name.ValueString(), volumeState.Region.ValueString(), volumeData.Region.ValueString(),
+			),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Volume Will Be Replaced",
+		fmt.Sprintf("Volume %s will be deleted and replaced with an empty volume in region %s. All data on it is lost.", volumeState.Name.ValueString(), volumeData.Region.ValueString()),
+	)
+
+	// The new volume gets a new id and size
+	volumeData.Id = types.StringUnknown()
+	volumeData.Size = types.Float64Unknown()
+
+	volume, diags := types.ObjectValueFrom(ctx, volumeAttrTypes, volumeData)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("volume"), volume)...)
+}
+
 func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *ServiceResourceModel
 	var volumeData *ServiceResourceVolumeModel
@@ -346,6 +443,10 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		ProjectId: data.ProjectId.ValueString(),
 	}
 
+	if !data.Icon.IsNull() {
+		input.Icon = data.Icon.ValueStringPointer()
+	}
+
 	response, err := createService(ctx, *r.client, input)
 
 	if err != nil {
@@ -379,29 +480,11 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 			return
 		}
 
-		volumeResponse, err := createVolume(ctx, *r.client, VolumeCreateInput{
-			MountPath: volumeData.MountPath.ValueString(),
-			ProjectId: data.ProjectId.ValueString(),
-			ServiceId: data.Id.ValueStringPointer(),
-		})
-
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create volume, got error: %s", err))
-			return
-		}
-
-		tflog.Trace(ctx, "created a volume")
-
-		_, err = updateVolume(ctx, *r.client, volumeResponse.VolumeCreate.Volume.Id, VolumeUpdateInput{
-			Name: volumeData.Name.ValueString(),
-		})
+		createServiceVolume(ctx, *r.client, data, volumeData, &resp.Diagnostics)
 
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update volume, got error: %s", err))
+		if resp.Diagnostics.HasError() {
 			return
 		}
-
-		tflog.Trace(ctx, "updated a volume")
 	}
 
 	if !data.SourceRepo.IsNull() || !data.SourceImage.IsNull() {
@@ -450,10 +533,21 @@ func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	service := response.Service.Service
 
+	// Not stored in Railway, imported services aren't protected until configured
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+
 	data.Id = types.StringValue(service.Id)
 	data.Name = types.StringValue(service.Name)
 	data.ProjectId = types.StringValue(service.ProjectId)
 
+	if response.Service.Icon != nil && len(*response.Service.Icon) != 0 {
+		data.Icon = types.StringValue(*response.Service.Icon)
+	} else {
+		data.Icon = types.StringNull()
+	}
+
 	err = getAndBuildServiceInstance(ctx, *r.client, data.ProjectId.ValueString(), data.Id.ValueString(), data)
 
 	if err != nil {
@@ -492,9 +586,11 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	if data.Name.ValueString() != state.Name.ValueString() {
+	if data.Name.ValueString() != state.Name.ValueString() || !data.Icon.Equal(state.Icon) {
+		// A null icon removes it
 		input := ServiceUpdateInput{
 			Name: data.Name.ValueString(),
+			Icon: data.Icon.ValueStringPointer(),
 		}
 
 		response, err := updateService(ctx, *r.client, data.Id.ValueString(), input)
@@ -550,29 +646,11 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 			return
 		}
 
-		volumeResponse, err := createVolume(ctx, *r.client, VolumeCreateInput{
-			MountPath: volumeData.MountPath.ValueString(),
-			ProjectId: data.ProjectId.ValueString(),
-			ServiceId: data.Id.ValueStringPointer(),
-		})
-
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create volume, got error: %s", err))
-			return
-		}
-
-		tflog.Trace(ctx, "created a volume")
+		createServiceVolume(ctx, *r.client, data, volumeData, &resp.Diagnostics)
 
-		_, err = updateVolume(ctx, *r.client, volumeResponse.VolumeCreate.Volume.Id, VolumeUpdateInput{
-			Name: volumeData.Name.ValueString(),
-		})
-
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update volume, got error: %s", err))
+		if resp.Diagnostics.HasError() {
 			return
 		}
-
-		tflog.Trace(ctx, "updated a volume")
 	}
 
 	// Update volume if it was changed
@@ -589,7 +667,23 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 			return
 		}
 
-		if volumeState.Name != volumeData.Name {
+		// Without a migration API the only way to another region is a new volume, opted into at plan time
+		if volumeRegionChanged(volumeState, volumeData) {
+			_, err := deleteVolume(ctx, *r.client, volumeState.Id.ValueString())
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete volume, got error: %s", err))
+				return
+			}
+
+			tflog.Trace(ctx, "deleted a volume")
+
+			createServiceVolume(ctx, *r.client, data, volumeData, &resp.Diagnostics)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		} else if volumeState.Name != volumeData.Name {
 			_, err := updateVolume(ctx, *r.client, volumeState.Id.ValueString(), VolumeUpdateInput{
 				Name: volumeData.Name.ValueString(),
 			})
@@ -602,10 +696,10 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 			tflog.Trace(ctx, "updated a volume")
 		}
 
-		if volumeState.MountPath != volumeData.MountPath {
+		if !volumeRegionChanged(volumeState, volumeData) && volumeState.MountPath != volumeData.MountPath {
 			_, err := updateVolumeInstance(ctx, *r.client, volumeState.Id.ValueString(), VolumeInstanceUpdateInput{
 				MountPath: volumeData.MountPath.ValueString(),
-				ServiceId: data.Id.ValueString(),
+				ServiceId: data.Id.ValueStringPointer(),
 			})
 
 			if err != nil {
@@ -658,6 +752,10 @@ func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if deletionProtected(data.DeletionProtection, "service", &resp.Diagnostics) {
+		return
+	}
+
 	_, err := deleteService(ctx, *r.client, data.Id.ValueString())
 
 	if err != nil {
@@ -689,6 +787,21 @@ func (r *ServiceResource) ImportState(ctx context.Context, req resource.ImportSt
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// deletionProtected adds an error and returns true when deletion protection is enabled, so Delete can stop before
+// calling the API.
+func deletionProtected(deletionProtection types.Bool, resourceType string, diags *diag.Diagnostics) bool {
+	if !deletionProtection.ValueBool() {
+		return false
+	}
+
+	diags.AddError(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("The %s has deletion protection enabled. Set `deletion_protection = false` and apply before destroying it.", resourceType),
+	)
+
+	return true
+}
+
 func buildServiceInstanceInput(data *ServiceResourceModel, regionsData *[]ServiceResourceRegionModel) ServiceInstanceUpdateInput {
 	var instanceInput ServiceInstanceUpdateInput
 
@@ -757,6 +870,8 @@ func getAndBuildServiceInstance(ctx context.Context, client graphql.Client, proj
 
 	if response.ServiceInstance.RailwayConfigFile != nil && len(*response.ServiceInstance.RailwayConfigFile) != 0 {
 		data.ConfigPath = types.StringValue(*response.ServiceInstance.RailwayConfigFile)
+	} else {
+		data.ConfigPath = types.StringNull()
 	}
 
 	if response.ServiceInstance.Source != nil {
@@ -844,6 +959,15 @@ func getRegionsFromLatestDeployment(latestDeployment getServiceInstanceServiceIn
 }
 
 func getAndBuildVolumeInstance(ctx context.Context, client graphql.Client, projectId string, serviceId string, data *ServiceResourceModel) error {
+	// Not stored in Railway, so kept from the plan or state
+	replaceOnRegionChange := types.BoolValue(false)
+
+	if !data.Volume.IsNull() && !data.Volume.IsUnknown() {
+		if value, ok := data.Volume.Attributes()["replace_on_region_change"].(types.Bool); ok && !value.IsNull() && !value.IsUnknown() {
+			replaceOnRegionChange = value
+		}
+	}
+
 	data.Volume = types.ObjectNull(volumeAttrTypes)
 
 	// Read the service again to get the updated source attributes
@@ -865,10 +989,12 @@ func getAndBuildVolumeInstance(ctx context.Context, client graphql.Client, proje
 				data.Volume = types.ObjectValueMust(
 					volumeAttrTypes,
 					map[string]attr.Value{
-						"id":         types.StringValue(volume.Node.Id),
-						"name":       types.StringValue(volume.Node.Name),
-						"mount_path": types.StringValue(volumeInstance.Node.MountPath),
-						"size":       types.Float64Value(float64(volumeInstance.Node.SizeMB)),
+						"id":                       types.StringValue(volume.Node.Id),
+						"name":                     types.StringValue(volume.Node.Name),
+						"mount_path":               types.StringValue(volumeInstance.Node.MountPath),
+						"size":                     types.Float64Value(float64(volumeInstance.Node.SizeMB)),
+						"region":                   types.StringPointerValue(volumeInstance.Node.Region),
+						"replace_on_region_change": replaceOnRegionChange,
 					},
 				)
 			}
@@ -878,6 +1004,48 @@ func getAndBuildVolumeInstance(ctx context.Context, client graphql.Client, proje
 	return nil
 }
 
+// volumeRegionChanged reports whether the planned region of the volume differs from the one it is in. Volumes whose
+// region Railway doesn't report are left alone.
+func volumeRegionChanged(volumeState *ServiceResourceVolumeModel, volumeData *ServiceResourceVolumeModel) bool {
+	if volumeState.Region.IsNull() || volumeData.Region.IsNull() || volumeData.Region.IsUnknown() {
+		return false
+	}
+
+	return !volumeState.Region.Equal(volumeData.Region)
+}
+
+func createServiceVolume(ctx context.Context, client graphql.Client, data *ServiceResourceModel, volumeData *ServiceResourceVolumeModel, diags *diag.Diagnostics) {
+	input := VolumeCreateInput{
+		MountPath: volumeData.MountPath.ValueString(),
+		ProjectId: data.ProjectId.ValueString(),
+		ServiceId: data.Id.ValueStringPointer(),
+	}
+
+	if !volumeData.Region.IsNull() && !volumeData.Region.IsUnknown() {
+		input.Region = volumeData.Region.ValueStringPointer()
+	}
+
+	volumeResponse, err := createVolume(ctx, client, input)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create volume, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a volume")
+
+	_, err = updateVolume(ctx, client, volumeResponse.VolumeCreate.Volume.Id, VolumeUpdateInput{
+		Name: volumeData.Name.ValueString(),
+	})
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update volume, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a volume")
+}
+
 func updateServiceConnection(ctx context.Context, client graphql.Client, serviceId string, data *ServiceResourceModel, state *ServiceResourceModel) error {
 	isSourceChanged := !state.SourceRepo.Equal(data.SourceRepo) || !state.SourceRepoBranch.Equal(data.SourceRepoBranch) || !state.SourceImage.Equal(data.SourceImage)
 	isSourcesChangedToNull := isSourceChanged && data.SourceRepo.IsNull() && data.SourceRepoBranch.IsNull() && data.SourceImage.IsNull()
@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/Khan/genqlient/graphql"
@@ -10,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -36,6 +40,7 @@ type ServiceDomainResourceModel struct {
 	ProjectId     types.String `tfsdk:"project_id"`
 	Suffix        types.String `tfsdk:"suffix"`
 	Domain        types.String `tfsdk:"domain"`
+	AppendSuffix  types.Bool   `tfsdk:"append_suffix_on_collision"`
 }
 
 func (r *ServiceDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,6 +94,12 @@ func (r *ServiceDomainResource) Schema(ctx context.Context, req resource.SchemaR
 				MarkdownDescription: "Full domain of the service domain.",
 				Computed:            true,
 			},
+			"append_suffix_on_collision": schema.BoolAttribute{
+				MarkdownDescription: "When the subdomain is taken, append a suffix derived from the service and environment to it, such as `acme-api-staging-3f2a9c`, instead of failing. The chosen domain is in `domain`. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -137,29 +148,20 @@ func (r *ServiceDomainResource) Create(ctx context.Context, req resource.CreateR
 	tflog.Trace(ctx, "created a service domain")
 
 	domain := response.ServiceDomainCreate.ServiceDomain
-	domainName := data.Subdomain.ValueString() + "." + domain.Suffix
-
-	updateInput := ServiceDomainUpdateInput{
-		ServiceDomainId: domain.Id,
-		Domain:          domainName,
-		ServiceId:       data.ServiceId.ValueString(),
-		EnvironmentId:   data.EnvironmentId.ValueString(),
-	}
 
-	updateResponse, err := updateServiceDomain(ctx, *r.client, updateInput)
+	domainName, err := setServiceDomainSubdomain(ctx, *r.client, domain.Id, data, domain.Suffix)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service domain, got error: %s", err))
-		return
-	}
 
-	if !updateResponse.ServiceDomainUpdate {
-		resp.Diagnostics.AddError("Client Error", "Unable to update service domain, got false as response")
+		// Don't leave the generated domain behind, as it isn't in state
+		if _, err := deleteServiceDomain(ctx, *r.client, domain.Id); err != nil {
+			tflog.Warn(ctx, "unable to delete generated service domain", map[string]interface{}{"id": domain.Id, "error": err.Error()})
+		}
+
 		return
 	}
 
-	tflog.Trace(ctx, "updated a service domain")
-
 	service, err := getService(ctx, *r.client, domain.ServiceId)
 
 	if err != nil {
@@ -212,30 +214,20 @@ func (r *ServiceDomainResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	domainName := data.Subdomain.ValueString() + "." + state.Suffix.ValueString()
-
-	updateInput := ServiceDomainUpdateInput{
-		ServiceDomainId: state.Id.ValueString(),
-		Domain:          domainName,
-		ServiceId:       data.ServiceId.ValueString(),
-		EnvironmentId:   data.EnvironmentId.ValueString(),
-	}
+	domainName := state.Domain.ValueString()
 
-	response, err := updateServiceDomain(ctx, *r.client, updateInput)
+	if !slices.Contains(serviceDomainCandidates(data), serviceDomainLabel(domainName, state.Suffix.ValueString())) {
+		var err error
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service domain, got error: %s", err))
-		return
-	}
+		domainName, err = setServiceDomainSubdomain(ctx, *r.client, state.Id.ValueString(), data, state.Suffix.ValueString())
 
-	if !response.ServiceDomainUpdate {
-		resp.Diagnostics.AddError("Client Error", "Unable to update service domain, got false as response")
-		return
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service domain, got error: %s", err))
+			return
+		}
 	}
 
-	tflog.Trace(ctx, "updated a service domain")
-
-	err = getAndBuildServiceDomain(ctx, *r.client, state.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), domainName, data)
+	err := getAndBuildServiceDomain(ctx, *r.client, state.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), domainName, data)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service domain, got error: %s", err))
@@ -297,7 +289,9 @@ func (r *ServiceDomainResource) ImportState(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
 }
 
-func findServiceDomain(ctx context.Context, client graphql.Client, projectId string, environmentId string, serviceId string, domain string) (*ServiceDomain, error) {
+// findServiceDomain finds the service domain by id, so a domain changed outside of Terraform is still found, or by
+// domain when the id isn't known yet, like on import.
+func findServiceDomain(ctx context.Context, client graphql.Client, projectId string, environmentId string, serviceId string, id string, domain string) (*ServiceDomain, error) {
 	response, err := listServiceDomains(ctx, client, environmentId, serviceId, projectId)
 
 	if err != nil {
@@ -305,7 +299,7 @@ func findServiceDomain(ctx context.Context, client graphql.Client, projectId str
 	}
 
 	for _, serviceDomain := range response.Domains.ServiceDomains {
-		if serviceDomain.ServiceDomain.Domain == domain {
+		if (id != "" && serviceDomain.ServiceDomain.Id == id) || (id == "" && serviceDomain.ServiceDomain.Domain == domain) {
 			return &serviceDomain.ServiceDomain, nil
 		}
 	}
@@ -314,7 +308,7 @@ func findServiceDomain(ctx context.Context, client graphql.Client, projectId str
 }
 
 func getAndBuildServiceDomain(ctx context.Context, client graphql.Client, projectId string, environmentId string, serviceId string, domain string, data *ServiceDomainResourceModel) error {
-	serviceDomain, err := findServiceDomain(ctx, client, projectId, environmentId, serviceId, domain)
+	serviceDomain, err := findServiceDomain(ctx, client, projectId, environmentId, serviceId, data.Id.ValueString(), domain)
 
 	if err != nil {
 		return err
@@ -326,8 +320,113 @@ func getAndBuildServiceDomain(ctx context.Context, client graphql.Client, projec
 	data.Suffix = types.StringValue(serviceDomain.Suffix)
 	data.Domain = types.StringValue(serviceDomain.Domain)
 
-	data.Subdomain = types.StringValue(serviceDomain.Domain[:len(serviceDomain.Domain)-len(serviceDomain.Suffix)-1])
 	data.ProjectId = types.StringValue(projectId)
 
+	// Imported service domains don't have the argument set
+	if data.AppendSuffix.IsNull() {
+		data.AppendSuffix = types.BoolValue(false)
+	}
+
+	// A suffixed domain chosen on collision still matches the configured subdomain, anything else is drift
+	label := serviceDomainLabel(serviceDomain.Domain, serviceDomain.Suffix)
+
+	if data.Subdomain.IsNull() || !slices.Contains(serviceDomainCandidates(data), label) {
+		data.Subdomain = types.StringValue(label)
+	}
+
 	return nil
 }
+
+// serviceDomainCollisionAttempts is how many suffixed subdomains are tried after the configured one is taken.
+const serviceDomainCollisionAttempts = 3
+
+// serviceDomainLabel returns the subdomain part of a service domain.
+func serviceDomainLabel(domain string, suffix string) string {
+	return strings.TrimSuffix(domain, "."+suffix)
+}
+
+// serviceDomainCandidates returns the subdomains to try in order. The suffixes are derived from the service and
+// environment, so every apply picks the same ones.
+func serviceDomainCandidates(data *ServiceDomainResourceModel) []string {
+	subdomain := data.Subdomain.ValueString()
+	candidates := []string{subdomain}
+
+	if !data.AppendSuffix.ValueBool() {
+		return candidates
+	}
+
+	for attempt := 0; attempt < serviceDomainCollisionAttempts; attempt++ {
+		hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%s:%d", data.ServiceId.ValueString(), data.EnvironmentId.ValueString(), attempt)))
+		suffix := "-" + hex.EncodeToString(hash[:])[:6]
+
+		// Keep the subdomain a valid DNS label
+		base := subdomain
+
+		if len(base)+len(suffix) > 63 {
+			base = strings.TrimRight(base[:63-len(suffix)], "-")
+		}
+
+		candidates = append(candidates, base+suffix)
+	}
+
+	return candidates
+}
+
+// isServiceDomainCollision reports whether Railway rejected the domain because it is taken.
+func isServiceDomainCollision(err error) bool {
+	message := strings.ToLower(err.Error())
+
+	for _, reason := range []string{"not available", "already taken", "already in use", "already exists"} {
+		if strings.Contains(message, reason) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setServiceDomainSubdomain points the service domain at the configured subdomain, or at the next candidate while it
+// is taken, and returns the domain that was set.
+func setServiceDomainSubdomain(ctx context.Context, client graphql.Client, id string, data *ServiceDomainResourceModel, suffix string) (string, error) {
+	candidates := serviceDomainCandidates(data)
+
+	for i, candidate := range candidates {
+		domainName := candidate + "." + suffix
+
+		input := ServiceDomainUpdateInput{
+			ServiceDomainId: id,
+			Domain:          domainName,
+			ServiceId:       data.ServiceId.ValueString(),
+			EnvironmentId:   data.EnvironmentId.ValueString(),
+		}
+
+		response, err := updateServiceDomain(ctx, client, input)
+
+		if err != nil {
+			if !isServiceDomainCollision(err) {
+				return "", err
+			}
+
+			if i == len(candidates)-1 {
+				if len(candidates) == 1 {
+					return "", fmt.Errorf("domain %s is already taken, choose another subdomain or set append_suffix_on_collision", domainName)
+				}
+
+				return "", fmt.Errorf("domain %s and its suffixed alternatives are already taken, choose another subdomain", data.Subdomain.ValueString()+"."+suffix)
+			}
+
+			tflog.Info(ctx, "service domain is taken, trying the next one", map[string]interface{}{"domain": domainName})
+			continue
+		}
+
+		if !response.ServiceDomainUpdate {
+			return "", fmt.Errorf("got false as response")
+		}
+
+		tflog.Trace(ctx, "updated a service domain")
+
+		return domainName, nil
+	}
+
+	return "", fmt.Errorf("no subdomain to set")
+}
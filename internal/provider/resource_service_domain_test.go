@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -79,3 +86,113 @@ resource "railway_service_domain" "test" {
 }
 `, name)
 }
+
+func TestSetServiceDomainSubdomain(t *testing.T) {
+	testCases := map[string]struct {
+		appendSuffix bool
+		taken        int
+		expected     string
+		expectError  string
+	}{
+		"available": {
+			expected: "acme-api-staging.up.railway.app",
+		},
+		"taken": {
+			taken:       1,
+			expectError: "domain acme-api-staging.up.railway.app is already taken",
+		},
+		"taken with suffix": {
+			appendSuffix: true,
+			taken:        2,
+			expected:     "acme-api-staging-",
+		},
+		"all taken with suffix": {
+			appendSuffix: true,
+			taken:        1 + serviceDomainCollisionAttempts,
+			expectError:  "suffixed alternatives are already taken",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var domains []string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				var body struct {
+					Variables struct {
+						Input ServiceDomainUpdateInput `json:"input"`
+					} `json:"variables"`
+				}
+
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					t.Errorf("unable to decode request: %s", err)
+				}
+
+				domains = append(domains, body.Variables.Input.Domain)
+				w.Header().Set("Content-Type", "application/json")
+
+				if len(domains) <= testCase.taken {
+					fmt.Fprint(w, `{"errors": [{"message": "Domain is not available"}], "data": null}`)
+					return
+				}
+
+				fmt.Fprint(w, `{"data": {"serviceDomainUpdate": true}}`)
+			}))
+
+			t.Cleanup(server.Close)
+
+			client := graphql.NewClient(server.URL, server.Client())
+
+			data := &ServiceDomainResourceModel{
+				Subdomain:     types.StringValue("acme-api-staging"),
+				ServiceId:     types.StringValue("39da7e07-fa3a-42fd-b695-d229319f2993"),
+				EnvironmentId: types.StringValue("d0519b29-5d12-4857-a5dd-76fa7418336c"),
+				AppendSuffix:  types.BoolValue(testCase.appendSuffix),
+			}
+
+			domain, err := setServiceDomainSubdomain(context.Background(), client, "domain-1", data, "up.railway.app")
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !strings.HasPrefix(domain, testCase.expected) {
+				t.Errorf("expected domain starting with %s, got %s", testCase.expected, domain)
+			}
+
+			// The suffixes must be the same on every apply
+			if again := serviceDomainCandidates(data); !strings.HasPrefix(domain, again[testCase.taken]+".") {
+				t.Errorf("expected domain %s to be candidate %d of %v", domain, testCase.taken, again)
+			}
+		})
+	}
+}
+
+func TestServiceDomainCandidates(t *testing.T) {
+	data := &ServiceDomainResourceModel{
+		Subdomain:     types.StringValue(strings.Repeat("a", 62)),
+		ServiceId:     types.StringValue("39da7e07-fa3a-42fd-b695-d229319f2993"),
+		EnvironmentId: types.StringValue("d0519b29-5d12-4857-a5dd-76fa7418336c"),
+		AppendSuffix:  types.BoolValue(true),
+	}
+
+	candidates := serviceDomainCandidates(data)
+
+	if len(candidates) != 1+serviceDomainCollisionAttempts {
+		t.Fatalf("expected %d candidates, got %v", 1+serviceDomainCollisionAttempts, candidates)
+	}
+
+	for _, candidate := range candidates {
+		if len(candidate) > 63 {
+			t.Errorf("expected candidate %s to be a valid DNS label", candidate)
+		}
+	}
+}
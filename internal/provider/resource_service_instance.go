@@ -2,11 +2,16 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +25,8 @@ import (
 
 var _ resource.Resource = &ServiceInstanceResource{}
 var _ resource.ResourceWithImportState = &ServiceInstanceResource{}
+var _ resource.ResourceWithUpgradeState = &ServiceInstanceResource{}
+var _ resource.ResourceWithModifyPlan = &ServiceInstanceResource{}
 
 func NewServiceInstanceResource() resource.Resource {
 	return &ServiceInstanceResource{}
@@ -30,14 +37,15 @@ type ServiceInstanceResource struct {
 }
 
 type ServiceInstanceResourceModel struct {
-	Id                       types.String `tfsdk:"id"`
-	ServiceId                types.String `tfsdk:"service_id"`
-	EnvironmentId            types.String `tfsdk:"environment_id"`
-	SourceImage              types.String `tfsdk:"source_image"`
-	SourceRepo               types.String `tfsdk:"source_repo"`
-	RegistryCredentialsUser  types.String `tfsdk:"registry_credentials_username"`
-	RegistryCredentialsPass  types.String `tfsdk:"registry_credentials_password"`
-	Redeploy                 types.Bool   `tfsdk:"redeploy"`
+	Id                         types.String `tfsdk:"id"`
+	ServiceId                  types.String `tfsdk:"service_id"`
+	EnvironmentId              types.String `tfsdk:"environment_id"`
+	SourceImage                types.String `tfsdk:"source_image"`
+	SourceRepo                 types.String `tfsdk:"source_repo"`
+	RegistryCredentialsUser    types.String `tfsdk:"registry_credentials_username"`
+	RegistryCredentialsPass    types.String `tfsdk:"registry_credentials_password"`
+	Redeploy                   types.Bool   `tfsdk:"redeploy"`
+	RedeployOnCredentialChange types.Bool   `tfsdk:"redeploy_on_credential_change"`
 
 	// Build configuration
 	Builder          types.String `tfsdk:"builder"`
@@ -63,6 +71,7 @@ func (r *ServiceInstanceResource) Metadata(ctx context.Context, req resource.Met
 
 func (r *ServiceInstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: `Railway service instance - environment-scoped service configuration.
 
 This resource allows you to configure a service instance for a specific environment,
@@ -121,6 +130,21 @@ resource "railway_service_instance" "api_production" {
   healthcheck_timeout = 60
 }
 ` + "```" + `
+
+## Build Arguments
+
+Railway has no build arguments separate from variables. Every variable of the service is available while building
+` + "`source_repo`" + `, so a Dockerfile reads it by declaring a matching ` + "`ARG`" + `. Set build arguments with
+` + "`railway_variable`" + ` or ` + "`railway_variable_collection`" + `, whose values are sensitive.
+
+` + "```hcl" + `
+resource "railway_variable" "git_sha" {
+  service_id     = railway_service.api.id
+  environment_id = railway_environment.production.id
+  name           = "GIT_SHA"
+  value          = var.git_sha
+}
+` + "```" + `
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -154,7 +178,7 @@ resource "railway_service_instance" "api_production" {
 				MarkdownDescription: "Docker image to deploy for this service instance. Conflicts with `source_repo`.",
 				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.UTF8LengthAtLeast(1),
+					imageReferenceValidator(),
 					stringvalidator.ConflictsWith(path.MatchRoot("source_repo")),
 				},
 			},
@@ -184,11 +208,17 @@ resource "railway_service_instance" "api_production" {
 				},
 			},
 			"redeploy": schema.BoolAttribute{
-				MarkdownDescription: "Whether to trigger a redeployment after updating the service instance. **Default** `true`.",
+				MarkdownDescription: "Whether to trigger a redeployment after updating the service instance. Set it to `false` when a `railway_deployment` pins the deployment of the service instance. **Default** `true`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"redeploy_on_credential_change": schema.BoolAttribute{
+				MarkdownDescription: "Whether to trigger a redeployment when only the registry credentials changed. New credentials are used on the next image pull, so rotating them doesn't require a redeployment. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 
 			// Build configuration
 			"builder": schema.StringAttribute{
@@ -271,6 +301,69 @@ func (r *ServiceInstanceResource) Configure(ctx context.Context, req resource.Co
 	r.client = client
 }
 
+func (r *ServiceInstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *ServiceInstanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Redeploy.ValueBool() {
+		planServiceInstanceDeployer(r.client, data.ServiceId, data.EnvironmentId, "railway_service_instance", &resp.Diagnostics)
+	}
+
+	// Nothing to compare against on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state *ServiceInstanceResourceModel
+	var config *ServiceInstanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if hasDeploymentChange(data, state) {
+		// Settings not set in the configuration are decided by Railway and may change with the new deployment
+		if config.Builder.IsNull() {
+			data.Builder = types.StringUnknown()
+		}
+
+		if config.RestartPolicyType.IsNull() {
+			data.RestartPolicyType = types.StringUnknown()
+		}
+
+		if config.RestartPolicyMaxRetries.IsNull() {
+			data.RestartPolicyMaxRetries = types.Int64Unknown()
+		}
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+
+		return
+	}
+
+	if req.Plan.Raw.Equal(req.State.Raw) || !data.Redeploy.ValueBool() || hasCredentialChange(data, state) {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Service Instance Will Be Redeployed",
+		"No deployment settings of the service instance changed, but `redeploy` is enabled so applying this plan will still redeploy it. "+
+			"Set `redeploy = false` to apply the change without a redeployment.",
+	)
+}
+
 func (r *ServiceInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *ServiceInstanceResourceModel
 
@@ -284,6 +377,8 @@ func (r *ServiceInstanceResource) Create(ctx context.Context, req resource.Creat
 	input := r.buildUpdateInput(ctx, data)
 
 	// Update the service instance
+	unlock := lockServiceInstance(data.ServiceId.ValueString(), data.EnvironmentId.ValueString())
+
 	_, err := updateServiceInstanceWithEnv(
 		ctx,
 		*r.client,
@@ -292,6 +387,10 @@ func (r *ServiceInstanceResource) Create(ctx context.Context, req resource.Creat
 		input,
 	)
 
+	changedAt := time.Now()
+
+	unlock()
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service instance, got error: %s", err))
 		return
@@ -299,25 +398,8 @@ func (r *ServiceInstanceResource) Create(ctx context.Context, req resource.Creat
 
 	tflog.Trace(ctx, "updated service instance")
 
-	// Trigger redeployment if enabled
-	if data.Redeploy.ValueBool() {
-		_, err = redeployServiceInstanceWithEnv(
-			ctx,
-			*r.client,
-			data.EnvironmentId.ValueString(),
-			data.ServiceId.ValueString(),
-		)
-
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service instance, got error: %s", err))
-			return
-		}
-
-		tflog.Trace(ctx, "redeployed service instance")
-	}
-
 	// Set the composite ID
-	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.ServiceId.ValueString(), data.EnvironmentId.ValueString()))
+	data.Id = types.StringValue(serviceInstanceId(data.ServiceId.ValueString(), data.EnvironmentId.ValueString()))
 
 	// Read back the current state
 	err = r.readServiceInstance(ctx, data)
@@ -327,7 +409,22 @@ func (r *ServiceInstanceResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Track the instance before redeploying so a failed redeploy doesn't lose the applied update
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Trigger redeployment if enabled
+	if data.Redeploy.ValueBool() {
+		err = redeployServiceInstanceOnce(ctx, r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), changedAt, &resp.Diagnostics)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service instance, got error: %s", err))
+			return
+		}
+	}
 }
 
 func (r *ServiceInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -351,6 +448,7 @@ func (r *ServiceInstanceResource) Read(ctx context.Context, req resource.ReadReq
 
 func (r *ServiceInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *ServiceInstanceResourceModel
+	var state *ServiceInstanceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
@@ -358,10 +456,18 @@ func (r *ServiceInstanceResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build the update input
 	input := r.buildUpdateInput(ctx, data)
 
 	// Update the service instance
+	unlock := lockServiceInstance(data.ServiceId.ValueString(), data.EnvironmentId.ValueString())
+
 	_, err := updateServiceInstanceWithEnv(
 		ctx,
 		*r.client,
@@ -370,6 +476,10 @@ func (r *ServiceInstanceResource) Update(ctx context.Context, req resource.Updat
 		input,
 	)
 
+	changedAt := time.Now()
+
+	unlock()
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service instance, got error: %s", err))
 		return
@@ -377,23 +487,6 @@ func (r *ServiceInstanceResource) Update(ctx context.Context, req resource.Updat
 
 	tflog.Trace(ctx, "updated service instance")
 
-	// Trigger redeployment if enabled
-	if data.Redeploy.ValueBool() {
-		_, err = redeployServiceInstanceWithEnv(
-			ctx,
-			*r.client,
-			data.EnvironmentId.ValueString(),
-			data.ServiceId.ValueString(),
-		)
-
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service instance, got error: %s", err))
-			return
-		}
-
-		tflog.Trace(ctx, "redeployed service instance")
-	}
-
 	// Read back the current state
 	err = r.readServiceInstance(ctx, data)
 
@@ -402,7 +495,30 @@ func (r *ServiceInstanceResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	// Track the applied update before redeploying so a failed redeploy doesn't lose it
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	redeploy := data.Redeploy.ValueBool()
+
+	// Registry credentials are only used on the next image pull, so rotating them alone doesn't need a new deployment
+	if redeploy && !data.RedeployOnCredentialChange.ValueBool() && isCredentialOnlyChange(data, state) {
+		tflog.Trace(ctx, "skipping service instance redeploy, only registry credentials changed")
+		redeploy = false
+	}
+
+	// Trigger redeployment if enabled
+	if redeploy {
+		err = redeployServiceInstanceOnce(ctx, r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), changedAt, &resp.Diagnostics)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service instance, got error: %s", err))
+			return
+		}
+	}
 }
 
 func (r *ServiceInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -412,8 +528,172 @@ func (r *ServiceInstanceResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *ServiceInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: service_id:environment_id
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	serviceId, environmentId, err := parseServiceInstanceId(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Unable to import service instance: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+}
+
+// serviceInstanceLocks serializes writes to the same service instance from different resources, since Railway
+// can drop one of two interleaved updates.
+var serviceInstanceLocks = struct {
+	sync.Mutex
+	locks map[string]*sync.Mutex
+}{
+	locks: map[string]*sync.Mutex{},
+}
+
+// lockServiceInstance locks the service instance for writing and returns the function to unlock it.
+func lockServiceInstance(serviceId string, environmentId string) func() {
+	key := serviceInstanceId(serviceId, environmentId)
+
+	serviceInstanceLocks.Lock()
+
+	lock, ok := serviceInstanceLocks.locks[key]
+
+	if !ok {
+		lock = &sync.Mutex{}
+		serviceInstanceLocks.locks[key] = lock
+	}
+
+	serviceInstanceLocks.Unlock()
+
+	lock.Lock()
+
+	return lock.Unlock
+}
+
+// serviceInstanceRedeploys tracks when each configured client last started redeploying a service instance, and the
+// service instances pinned to a deployment, so resources sharing a service instance don't redeploy it again for
+// changes an earlier redeploy already picked up.
+var serviceInstanceRedeploys = struct {
+	sync.Mutex
+	started map[*graphql.Client]map[string]time.Time
+	pinned  map[*graphql.Client]map[string]bool
+}{
+	started: map[*graphql.Client]map[string]time.Time{},
+	pinned:  map[*graphql.Client]map[string]bool{},
+}
+
+// serviceInstanceDeployers tracks, for each configured client, the resource types planned to deploy each service
+// instance. Terraform plans every resource of a configuration with the same client, so a railway_deployment and a
+// redeploying railway_service_instance of the same service instance are reported by whichever is planned last.
+var serviceInstanceDeployers = struct {
+	sync.Mutex
+	planned map[*graphql.Client]map[string]string
+}{
+	planned: map[*graphql.Client]map[string]string{},
+}
+
+// planServiceInstanceDeployer records that a resource of typeName deploys the service instance, and adds an error
+// when a resource of another type was already planned to. Identifiers unknown until apply can't be compared, so
+// they are skipped, as are resources outside of a targeted plan.
+func planServiceInstanceDeployer(client *graphql.Client, serviceId types.String, environmentId types.String, typeName string, diags *diag.Diagnostics) {
+	if client == nil || serviceId.IsUnknown() || serviceId.IsNull() || environmentId.IsUnknown() || environmentId.IsNull() {
+		return
+	}
+
+	serviceInstanceDeployers.Lock()
+	defer serviceInstanceDeployers.Unlock()
+
+	planned, ok := serviceInstanceDeployers.planned[client]
+
+	if !ok {
+		planned = map[string]string{}
+		serviceInstanceDeployers.planned[client] = planned
+	}
+
+	key := serviceInstanceId(serviceId.ValueString(), environmentId.ValueString())
+
+	if other, ok := planned[key]; ok && other != typeName {
+		diags.AddError(
+			"Conflicting Service Instance Deployments",
+			fmt.Sprintf("Both a %s and a %s deploy service %s in environment %s, so the redeploys of railway_service_instance would replace the deployment pinned by railway_deployment. "+
+				"Set redeploy = false on the railway_service_instance.", other, typeName, serviceId.ValueString(), environmentId.ValueString()),
+		)
+
+		return
+	}
+
+	planned[key] = typeName
+}
+
+// markServiceInstanceRedeployed records that the service instance was deployed by other means, such as a pinned
+// railway_deployment, so a later redeploy in the same apply doesn't replace that deployment. The skipped redeploys
+// are reported as warnings, since their changes only take effect on the next deployment.
+func markServiceInstanceRedeployed(client *graphql.Client, environmentId string, serviceId string) {
+	serviceInstanceRedeploys.Lock()
+	defer serviceInstanceRedeploys.Unlock()
+
+	pinned, ok := serviceInstanceRedeploys.pinned[client]
+
+	if !ok {
+		pinned = map[string]bool{}
+		serviceInstanceRedeploys.pinned[client] = pinned
+	}
+
+	pinned[serviceInstanceId(serviceId, environmentId)] = true
+}
+
+// redeployServiceInstanceOnce redeploys the service instance for a change that finished at changedAt, unless a
+// redeploy by the same client started after it and so already picked it up, or a deployment is pinned to it.
+func redeployServiceInstanceOnce(ctx context.Context, client *graphql.Client, environmentId string, serviceId string, changedAt time.Time, diags *diag.Diagnostics) error {
+	key := serviceInstanceId(serviceId, environmentId)
+
+	// Holding the instance lock makes concurrent callers wait until an in-flight redeploy is issued and recorded
+	unlock := lockServiceInstance(serviceId, environmentId)
+	defer unlock()
+
+	serviceInstanceRedeploys.Lock()
+	started := serviceInstanceRedeploys.started[client][key]
+	pinned := serviceInstanceRedeploys.pinned[client][key]
+	serviceInstanceRedeploys.Unlock()
+
+	if started.After(changedAt) {
+		tflog.Trace(ctx, "skipping service instance redeploy, already redeployed after the change")
+		return nil
+	}
+
+	if pinned {
+		diags.AddWarning(
+			"Service Instance Not Redeployed",
+			fmt.Sprintf("A railway_deployment or railway_deployment_rollback deployed service %s in environment %s in this apply, so it was not redeployed after this change to keep that deployment live. "+
+				"The change takes effect on the next deployment of the service.", serviceId, environmentId),
+		)
+
+		return nil
+	}
+
+	start := time.Now()
+
+	_, err := redeployServiceInstanceWithEnv(ctx, *client, environmentId, serviceId)
+
+	if err != nil {
+		return err
+	}
+
+	serviceInstanceRedeploys.Lock()
+
+	redeploys, ok := serviceInstanceRedeploys.started[client]
+
+	if !ok {
+		redeploys = map[string]time.Time{}
+		serviceInstanceRedeploys.started[client] = redeploys
+	}
+
+	redeploys[key] = start
+
+	serviceInstanceRedeploys.Unlock()
+
+	tflog.Trace(ctx, "redeployed service instance")
+
+	return nil
 }
 
 func (r *ServiceInstanceResource) buildUpdateInput(ctx context.Context, data *ServiceInstanceResourceModel) ServiceInstanceUpdateInput {
@@ -496,6 +776,152 @@ func (r *ServiceInstanceResource) buildUpdateInput(ctx context.Context, data *Se
 	return input
 }
 
+func (r *ServiceInstanceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: upgradeServiceInstanceStateV0,
+		},
+	}
+}
+
+// Version 0 state as written by earlier releases. Attributes added after the release may be missing
+// and older states may only carry the composite id, so everything is read from the raw JSON.
+type serviceInstanceResourceModelV0 struct {
+	Id                         *string  `json:"id"`
+	ServiceId                  *string  `json:"service_id"`
+	EnvironmentId              *string  `json:"environment_id"`
+	SourceImage                *string  `json:"source_image"`
+	SourceRepo                 *string  `json:"source_repo"`
+	RegistryCredentialsUser    *string  `json:"registry_credentials_username"`
+	RegistryCredentialsPass    *string  `json:"registry_credentials_password"`
+	Redeploy                   *bool    `json:"redeploy"`
+	RedeployOnCredentialChange *bool    `json:"redeploy_on_credential_change"`
+	Builder                    *string  `json:"builder"`
+	BuildCommand               *string  `json:"build_command"`
+	StartCommand               *string  `json:"start_command"`
+	PreDeployCommand           []string `json:"pre_deploy_command"`
+	HealthcheckPath            *string  `json:"healthcheck_path"`
+	HealthcheckTimeout         *int64   `json:"healthcheck_timeout"`
+	RestartPolicyType          *string  `json:"restart_policy_type"`
+	RestartPolicyMaxRetries    *int64   `json:"restart_policy_max_retries"`
+	SleepApplication           *bool    `json:"sleep_application"`
+}
+
+func upgradeServiceInstanceStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError("Unable to Upgrade State", "Service instance state is missing, unable to upgrade it.")
+		return
+	}
+
+	var prior serviceInstanceResourceModelV0
+
+	err := json.Unmarshal(req.RawState.JSON, &prior)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade State", fmt.Sprintf("Unable to decode service instance state, got error: %s", err))
+		return
+	}
+
+	serviceId := stringValueOrEmpty(prior.ServiceId)
+	environmentId := stringValueOrEmpty(prior.EnvironmentId)
+
+	// Derive the identifiers from the composite id when they are missing
+	if serviceId == "" || environmentId == "" {
+		serviceId, environmentId, err = parseServiceInstanceId(stringValueOrEmpty(prior.Id))
+
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade State", fmt.Sprintf("Unable to upgrade service instance state: %s", err))
+			return
+		}
+	}
+
+	data := ServiceInstanceResourceModel{
+		Id:                         types.StringValue(serviceInstanceId(serviceId, environmentId)),
+		ServiceId:                  types.StringValue(serviceId),
+		EnvironmentId:              types.StringValue(environmentId),
+		SourceImage:                types.StringPointerValue(prior.SourceImage),
+		SourceRepo:                 types.StringPointerValue(prior.SourceRepo),
+		RegistryCredentialsUser:    types.StringPointerValue(prior.RegistryCredentialsUser),
+		RegistryCredentialsPass:    types.StringPointerValue(prior.RegistryCredentialsPass),
+		Redeploy:                   types.BoolValue(true),
+		RedeployOnCredentialChange: types.BoolValue(false),
+		Builder:                    types.StringPointerValue(prior.Builder),
+		BuildCommand:               types.StringPointerValue(prior.BuildCommand),
+		StartCommand:               types.StringPointerValue(prior.StartCommand),
+		PreDeployCommand:           types.ListNull(types.StringType),
+		HealthcheckPath:            types.StringPointerValue(prior.HealthcheckPath),
+		HealthcheckTimeout:         types.Int64PointerValue(prior.HealthcheckTimeout),
+		RestartPolicyType:          types.StringPointerValue(prior.RestartPolicyType),
+		RestartPolicyMaxRetries:    types.Int64PointerValue(prior.RestartPolicyMaxRetries),
+		SleepApplication:           types.BoolPointerValue(prior.SleepApplication),
+	}
+
+	if prior.Redeploy != nil {
+		data.Redeploy = types.BoolValue(*prior.Redeploy)
+	}
+
+	if prior.RedeployOnCredentialChange != nil {
+		data.RedeployOnCredentialChange = types.BoolValue(*prior.RedeployOnCredentialChange)
+	}
+
+	if prior.PreDeployCommand != nil {
+		preDeployCommand, diags := types.ListValueFrom(ctx, types.StringType, prior.PreDeployCommand)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.PreDeployCommand = preDeployCommand
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func stringValueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+// isCredentialOnlyChange reports whether the registry credentials are the only deployable settings that differ
+// between the plan and the state.
+func isCredentialOnlyChange(data *ServiceInstanceResourceModel, state *ServiceInstanceResourceModel) bool {
+	return hasCredentialChange(data, state) && !hasDeploymentChange(data, state)
+}
+
+func hasCredentialChange(data *ServiceInstanceResourceModel, state *ServiceInstanceResourceModel) bool {
+	return !data.RegistryCredentialsUser.Equal(state.RegistryCredentialsUser) || !data.RegistryCredentialsPass.Equal(state.RegistryCredentialsPass)
+}
+
+// hasDeploymentChange reports whether any setting affecting the deployment, other than the registry credentials,
+// differs between the plan and the state. Unknown planned values come from unset computed attributes and are not changes.
+func hasDeploymentChange(data *ServiceInstanceResourceModel, state *ServiceInstanceResourceModel) bool {
+	settings := [][2]attr.Value{
+		{data.SourceImage, state.SourceImage},
+		{data.SourceRepo, state.SourceRepo},
+		{data.Builder, state.Builder},
+		{data.BuildCommand, state.BuildCommand},
+		{data.StartCommand, state.StartCommand},
+		{data.PreDeployCommand, state.PreDeployCommand},
+		{data.HealthcheckPath, state.HealthcheckPath},
+		{data.HealthcheckTimeout, state.HealthcheckTimeout},
+		{data.RestartPolicyType, state.RestartPolicyType},
+		{data.RestartPolicyMaxRetries, state.RestartPolicyMaxRetries},
+		{data.SleepApplication, state.SleepApplication},
+	}
+
+	for _, setting := range settings {
+		if !setting[0].IsUnknown() && !setting[0].Equal(setting[1]) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r *ServiceInstanceResource) readServiceInstance(ctx context.Context, data *ServiceInstanceResourceModel) error {
 	response, err := getServiceInstanceForResource(
 		ctx,
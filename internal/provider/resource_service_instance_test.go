@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServiceInstanceResourceUpgradeStateV0(t *testing.T) {
+	testCases := map[string]struct {
+		rawState string
+		expected ServiceInstanceResourceModel
+	}{
+		"full": {
+			rawState: `{
+  "builder": "NIXPACKS",
+  "build_command": null,
+  "environment_id": "d0519b29-5d12-4857-a5dd-76fa7418336c",
+  "healthcheck_path": "/health",
+  "healthcheck_timeout": 60,
+  "id": "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c",
+  "pre_deploy_command": ["npm run db:migrate"],
+  "redeploy": false,
+  "registry_credentials_password": "secret",
+  "registry_credentials_username": "bot",
+  "restart_policy_max_retries": 3,
+  "restart_policy_type": "ON_FAILURE",
+  "service_id": "39da7e07-fa3a-42fd-b695-d229319f2993",
+  "sleep_application": true,
+  "source_image": "ghcr.io/myorg/api:v1.2.3",
+  "source_repo": null,
+  "start_command": "npm run start:prod"
+}`,
+			expected: ServiceInstanceResourceModel{
+				Id:                         types.StringValue("39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+				ServiceId:                  types.StringValue("39da7e07-fa3a-42fd-b695-d229319f2993"),
+				EnvironmentId:              types.StringValue("d0519b29-5d12-4857-a5dd-76fa7418336c"),
+				SourceImage:                types.StringValue("ghcr.io/myorg/api:v1.2.3"),
+				SourceRepo:                 types.StringNull(),
+				RegistryCredentialsUser:    types.StringValue("bot"),
+				RegistryCredentialsPass:    types.StringValue("secret"),
+				Redeploy:                   types.BoolValue(false),
+				RedeployOnCredentialChange: types.BoolValue(false),
+				Builder:                    types.StringValue("NIXPACKS"),
+				BuildCommand:               types.StringNull(),
+				StartCommand:               types.StringValue("npm run start:prod"),
+				PreDeployCommand:           types.ListValueMust(types.StringType, []attr.Value{types.StringValue("npm run db:migrate")}),
+				HealthcheckPath:            types.StringValue("/health"),
+				HealthcheckTimeout:         types.Int64Value(60),
+				RestartPolicyType:          types.StringValue("ON_FAILURE"),
+				RestartPolicyMaxRetries:    types.Int64Value(3),
+				SleepApplication:           types.BoolValue(true),
+			},
+		},
+		"composite id only": {
+			rawState: `{
+  "builder": "RAILPACK",
+  "id": "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c",
+  "restart_policy_max_retries": 10,
+  "restart_policy_type": "ON_FAILURE"
+}`,
+			expected: ServiceInstanceResourceModel{
+				Id:                         types.StringValue("39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+				ServiceId:                  types.StringValue("39da7e07-fa3a-42fd-b695-d229319f2993"),
+				EnvironmentId:              types.StringValue("d0519b29-5d12-4857-a5dd-76fa7418336c"),
+				SourceImage:                types.StringNull(),
+				SourceRepo:                 types.StringNull(),
+				RegistryCredentialsUser:    types.StringNull(),
+				RegistryCredentialsPass:    types.StringNull(),
+				Redeploy:                   types.BoolValue(true),
+				RedeployOnCredentialChange: types.BoolValue(false),
+				Builder:                    types.StringValue("RAILPACK"),
+				BuildCommand:               types.StringNull(),
+				StartCommand:               types.StringNull(),
+				PreDeployCommand:           types.ListNull(types.StringType),
+				HealthcheckPath:            types.StringNull(),
+				HealthcheckTimeout:         types.Int64Null(),
+				RestartPolicyType:          types.StringValue("ON_FAILURE"),
+				RestartPolicyMaxRetries:    types.Int64Value(10),
+				SleepApplication:           types.BoolNull(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := upgradeServiceInstanceTestState(t, testCase.rawState)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			expected := tfsdk.State{Schema: resp.State.Schema}
+			diags := expected.Set(context.Background(), &testCase.expected)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if !resp.State.Raw.Equal(expected.Raw) {
+				t.Errorf("unexpected upgraded state\ngot:      %s\nexpected: %s", resp.State.Raw, expected.Raw)
+			}
+		})
+	}
+}
+
+func TestServiceInstanceResourceUpgradeStateV0InvalidId(t *testing.T) {
+	resp := upgradeServiceInstanceTestState(t, `{"id": "39da7e07-fa3a-42fd-b695-d229319f2993", "redeploy": true}`)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected error diagnostics for id without environment")
+	}
+}
+
+func upgradeServiceInstanceTestState(t *testing.T, rawState string) *resource.UpgradeStateResponse {
+	ctx := context.Background()
+	r := &ServiceInstanceResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+
+	if !ok {
+		t.Fatal("missing state upgrader for version 0")
+	}
+
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	}
+
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+
+	upgrader.StateUpgrader(ctx, req, resp)
+
+	return resp
+}
+
+func TestServiceInstanceResourceCreateRedeployFailure(t *testing.T) {
+	ctx := context.Background()
+	r, schema := newServiceInstanceTestResource(t, true)
+
+	plan := tfsdk.Plan{Schema: schema}
+	diags := plan.Set(ctx, serviceInstanceTestModel())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)},
+	}
+
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	checkServiceInstanceRedeployFailure(t, resp.Diagnostics, resp.State)
+}
+
+func TestServiceInstanceResourceUpdateRedeployFailure(t *testing.T) {
+	ctx := context.Background()
+	r, schema := newServiceInstanceTestResource(t, true)
+
+	prior := serviceInstanceTestModel()
+	prior.Id = types.StringValue("39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c")
+	prior.SourceImage = types.StringValue("ghcr.io/myorg/api:v1.2.2")
+
+	state := tfsdk.State{Schema: schema}
+	diags := state.Set(ctx, prior)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	planned := serviceInstanceTestModel()
+	planned.Id = prior.Id
+
+	plan := tfsdk.Plan{Schema: schema}
+	diags = plan.Set(ctx, planned)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	resp := &resource.UpdateResponse{
+		State: state,
+	}
+
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	checkServiceInstanceRedeployFailure(t, resp.Diagnostics, resp.State)
+
+	var data ServiceInstanceResourceModel
+	resp.State.Get(ctx, &data)
+
+	if data.SourceImage.ValueString() != "ghcr.io/myorg/api:v1.2.3" {
+		t.Errorf("expected updated source image in state, got %s", data.SourceImage)
+	}
+}
+
+func checkServiceInstanceRedeployFailure(t *testing.T, diags diag.Diagnostics, state tfsdk.State) {
+	if !diags.HasError() {
+		t.Fatal("expected error diagnostics for failed redeploy")
+	}
+
+	if state.Raw.IsNull() {
+		t.Fatal("expected service instance to be tracked in state after failed redeploy")
+	}
+
+	var data ServiceInstanceResourceModel
+	diags = state.Get(context.Background(), &data)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if data.Id.ValueString() != "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c" {
+		t.Errorf("unexpected id in state: %s", data.Id)
+	}
+
+	if data.Builder.ValueString() != "RAILPACK" {
+		t.Errorf("expected builder to be read back before redeploy, got %s", data.Builder)
+	}
+}
+
+func serviceInstanceTestModel() *ServiceInstanceResourceModel {
+	return &ServiceInstanceResourceModel{
+		Id:                         types.StringUnknown(),
+		ServiceId:                  types.StringValue("39da7e07-fa3a-42fd-b695-d229319f2993"),
+		EnvironmentId:              types.StringValue("d0519b29-5d12-4857-a5dd-76fa7418336c"),
+		SourceImage:                types.StringValue("ghcr.io/myorg/api:v1.2.3"),
+		SourceRepo:                 types.StringNull(),
+		RegistryCredentialsUser:    types.StringNull(),
+		RegistryCredentialsPass:    types.StringNull(),
+		Redeploy:                   types.BoolValue(true),
+		RedeployOnCredentialChange: types.BoolValue(false),
+		Builder:                    types.StringUnknown(),
+		BuildCommand:               types.StringNull(),
+		StartCommand:               types.StringNull(),
+		PreDeployCommand:           types.ListNull(types.StringType),
+		HealthcheckPath:            types.StringNull(),
+		HealthcheckTimeout:         types.Int64Null(),
+		RestartPolicyType:          types.StringUnknown(),
+		RestartPolicyMaxRetries:    types.Int64Unknown(),
+		SleepApplication:           types.BoolNull(),
+	}
+}
+
+// newServiceInstanceTestResource returns a resource backed by a fake Railway API whose redeploy mutation
+// fails when failRedeploy is set.
+func newServiceInstanceTestResource(t *testing.T, failRedeploy bool) (*ServiceInstanceResource, rschema.Schema) {
+	client := newTestClient(t, func(operationName string) string {
+		switch operationName {
+		case "updateServiceInstanceWithEnv":
+			return `{"data": {"serviceInstanceUpdate": true}}`
+		case "redeployServiceInstanceWithEnv":
+			if failRedeploy {
+				return `{"errors": [{"message": "deployment failed"}]}`
+			}
+
+			return `{"data": {"serviceInstanceRedeploy": true}}`
+		case "getServiceInstanceForResource":
+			return `{"data": {"serviceInstance": {
+  "id": "8b7cb2f0-7a1d-4b5e-9c39-0a5d1b2c3d4e",
+  "environmentId": "d0519b29-5d12-4857-a5dd-76fa7418336c",
+  "serviceId": "39da7e07-fa3a-42fd-b695-d229319f2993",
+  "source": {"image": "ghcr.io/myorg/api:v1.2.3", "repo": null},
+  "builder": "RAILPACK",
+  "buildCommand": null,
+  "startCommand": null,
+  "preDeployCommand": null,
+  "healthcheckPath": null,
+  "healthcheckTimeout": null,
+  "restartPolicyType": "ON_FAILURE",
+  "restartPolicyMaxRetries": 10,
+  "sleepApplication": null
+}}}`
+		default:
+			t.Errorf("unexpected operation: %s", operationName)
+			return `{}`
+		}
+	})
+
+	r := &ServiceInstanceResource{client: client}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+
+	return r, schemaResp.Schema
+}
+
+func TestRedeployServiceInstanceOnce(t *testing.T) {
+	testCases := map[string]struct {
+		changes         []bool
+		pinned          bool
+		expectRedeploys int
+		expectWarning   bool
+	}{
+		"coalesced": {
+			changes:         []bool{true, false, false},
+			expectRedeploys: 1,
+		},
+		"changed after redeploy": {
+			changes:         []bool{true, true},
+			expectRedeploys: 2,
+		},
+		"changed after coalesced redeploys": {
+			changes:         []bool{true, false, true, false},
+			expectRedeploys: 2,
+		},
+		"pinned": {
+			changes:         []bool{true},
+			pinned:          true,
+			expectRedeploys: 0,
+			expectWarning:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			redeploys := 0
+
+			client := newTestClient(t, func(operationName string) string {
+				if operationName != "redeployServiceInstanceWithEnv" {
+					t.Errorf("unexpected operation: %s", operationName)
+				}
+
+				redeploys++
+
+				return `{"data": {"serviceInstanceRedeploy": true}}`
+			})
+
+			if testCase.pinned {
+				markServiceInstanceRedeployed(client, "d0519b29-5d12-4857-a5dd-76fa7418336c", "39da7e07-fa3a-42fd-b695-d229319f2993")
+			}
+
+			var changedAt time.Time
+			var diags diag.Diagnostics
+
+			for _, changed := range testCase.changes {
+				if changed {
+					changedAt = time.Now()
+				}
+
+				err := redeployServiceInstanceOnce(context.Background(), client, "d0519b29-5d12-4857-a5dd-76fa7418336c", "39da7e07-fa3a-42fd-b695-d229319f2993", changedAt, &diags)
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			if redeploys != testCase.expectRedeploys {
+				t.Errorf("expected %d redeploys, got %d", testCase.expectRedeploys, redeploys)
+			}
+
+			if warned := diags.WarningsCount() > 0; warned != testCase.expectWarning {
+				t.Errorf("expected warning %t, got %t", testCase.expectWarning, warned)
+			}
+		})
+	}
+}
+
+func TestPlanServiceInstanceDeployer(t *testing.T) {
+	client := newTestClient(t, func(operationName string) string {
+		t.Errorf("unexpected operation: %s", operationName)
+		return `{}`
+	})
+
+	plan := func(serviceId types.String, typeName string) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		planServiceInstanceDeployer(client, serviceId, types.StringValue("environment"), typeName, &diags)
+
+		return diags
+	}
+
+	if diags := plan(types.StringValue("api"), "railway_service_instance"); diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	// Planned again, such as when applying
+	if diags := plan(types.StringValue("api"), "railway_service_instance"); diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if diags := plan(types.StringValue("worker"), "railway_deployment"); diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if diags := plan(types.StringUnknown(), "railway_deployment"); diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if diags := plan(types.StringValue("api"), "railway_deployment"); !diags.HasError() {
+		t.Fatal("expected a conflict error")
+	}
+}
@@ -3,13 +3,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
+	"sync"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,6 +26,7 @@ import (
 
 var _ resource.Resource = &ServiceLimitsResource{}
 var _ resource.ResourceWithImportState = &ServiceLimitsResource{}
+var _ resource.ResourceWithConfigValidators = &ServiceLimitsResource{}
 
 func NewServiceLimitsResource() resource.Resource {
 	return &ServiceLimitsResource{}
@@ -29,11 +37,15 @@ type ServiceLimitsResource struct {
 }
 
 type ServiceLimitsResourceModel struct {
-	Id            types.String  `tfsdk:"id"`
-	ServiceId     types.String  `tfsdk:"service_id"`
-	EnvironmentId types.String  `tfsdk:"environment_id"`
-	MemoryGB      types.Float64 `tfsdk:"memory_gb"`
-	VCPUs         types.Float64 `tfsdk:"vcpus"`
+	Id                 types.String  `tfsdk:"id"`
+	ServiceId          types.String  `tfsdk:"service_id"`
+	EnvironmentId      types.String  `tfsdk:"environment_id"`
+	MemoryGB           types.Float64 `tfsdk:"memory_gb"`
+	MemoryMB           types.Int64   `tfsdk:"memory_mb"`
+	VCPUs              types.Float64 `tfsdk:"vcpus"`
+	SkipPlanValidation types.Bool    `tfsdk:"skip_plan_validation"`
+	SkipVerification   types.Bool    `tfsdk:"skip_verification"`
+	Redeploy           types.Bool    `tfsdk:"redeploy"`
 }
 
 func (r *ServiceLimitsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -97,23 +109,63 @@ resource "railway_service_limits" "api_staging" {
 				},
 			},
 			"memory_gb": schema.Float64Attribute{
-				MarkdownDescription: "Memory allocation in GB (e.g., 0.5, 1, 2, 4, 8). Minimum is 0.25 GB.",
+				MarkdownDescription: "Memory allocation in GB (e.g., 0.5, 1, 2, 4, 8). Minimum is 0.25 GB, in increments of 0.25 GB. Conflicts with `memory_mb`.",
 				Optional:            true,
 				Validators: []validator.Float64{
 					float64validator.AtLeast(0.25),
+					memoryGBIncrementValidator(),
+					float64validator.ConflictsWith(path.MatchRoot("memory_mb")),
+				},
+			},
+			"memory_mb": schema.Int64Attribute{
+				MarkdownDescription: "Memory allocation in MB (e.g., 512, 768, 1024). Minimum is 256 MB, in increments of 256 MB. Conflicts with `memory_gb`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(256),
+					memoryMBIncrementValidator(),
+					int64validator.ConflictsWith(path.MatchRoot("memory_gb")),
 				},
 			},
 			"vcpus": schema.Float64Attribute{
-				MarkdownDescription: "vCPU allocation (e.g., 0.5, 1, 2, 4, 8). Minimum is 0.25 vCPU.",
+				MarkdownDescription: "vCPU allocation (e.g., 0.5, 1, 2, 4, 8). Minimum is 0.25 vCPU, in increments of 0.25 vCPU.",
 				Optional:            true,
 				Validators: []validator.Float64{
 					float64validator.AtLeast(0.25),
+					vcpuIncrementValidator(),
 				},
 			},
+			"skip_plan_validation": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip checking the limits against the maximums of the workspace plan before applying them. Use this when the workspace has custom limits that Railway doesn't report. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"redeploy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to redeploy the service instance after changing the limits, so running containers pick them up. It isn't redeployed again when a redeploy started after the change, such as one by `railway_service_instance` in the same apply. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"skip_verification": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip waiting for Railway to report the applied limits after creating or updating them. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
 
+func (r *ServiceLimitsResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("memory_gb"),
+			path.MatchRoot("memory_mb"),
+			path.MatchRoot("vcpus"),
+		),
+	}
+}
+
 func (r *ServiceLimitsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -141,11 +193,22 @@ func (r *ServiceLimitsResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	if !data.SkipPlanValidation.ValueBool() {
+		resp.Diagnostics.Append(r.validatePlanLimits(ctx, data)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Build the limits input
 	input := r.buildLimitsInput(data)
 
 	// Update the service instance limits
+	unlock := lockServiceInstance(input.ServiceId, input.EnvironmentId)
 	_, err := updateServiceInstanceLimits(ctx, *r.client, input)
+	changedAt := time.Now()
+	unlock()
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set service limits, got error: %s", err))
@@ -155,9 +218,32 @@ func (r *ServiceLimitsResource) Create(ctx context.Context, req resource.CreateR
 	tflog.Trace(ctx, "set service instance limits")
 
 	// Set the composite ID
-	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.ServiceId.ValueString(), data.EnvironmentId.ValueString()))
+	data.Id = types.StringValue(serviceInstanceId(data.ServiceId.ValueString(), data.EnvironmentId.ValueString()))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SkipVerification.ValueBool() {
+		err = r.waitForLimits(ctx, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to verify service limits, got error: %s", err))
+			return
+		}
+	}
+
+	// Railway applies new limits on the next deployment
+	if data.Redeploy.ValueBool() {
+		err = redeployServiceInstanceOnce(ctx, r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), changedAt, &resp.Diagnostics)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service instance, got error: %s", err))
+			return
+		}
+	}
 }
 
 func (r *ServiceLimitsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -169,8 +255,37 @@ func (r *ServiceLimitsResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	// Railway API doesn't provide a way to read limits back, so we preserve the configured state
-	// The limits are write-only from Terraform's perspective
+	response, err := getServiceInstanceLimitOverride(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service limits, got error: %s", err))
+		return
+	}
+
+	// Limits that were never overridden stay null so they don't show up as a diff against an unset attribute
+	memoryGB, vcpus := parseServiceInstanceLimits(response.ServiceInstanceLimitOverride)
+
+	// Memory is reported back in whichever unit is configured, defaulting to GB
+	if !data.MemoryMB.IsNull() && memoryGB != nil {
+		data.MemoryMB = types.Int64Value(memoryGBToMB(*memoryGB))
+	} else {
+		data.MemoryGB = types.Float64PointerValue(memoryGB)
+		data.MemoryMB = types.Int64Null()
+	}
+
+	data.VCPUs = types.Float64PointerValue(vcpus)
+
+	if data.SkipPlanValidation.IsNull() {
+		data.SkipPlanValidation = types.BoolValue(false)
+	}
+
+	if data.SkipVerification.IsNull() {
+		data.SkipVerification = types.BoolValue(false)
+	}
+
+	if data.Redeploy.IsNull() {
+		data.Redeploy = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -184,11 +299,22 @@ func (r *ServiceLimitsResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	if !data.SkipPlanValidation.ValueBool() {
+		resp.Diagnostics.Append(r.validatePlanLimits(ctx, data)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Build the limits input
 	input := r.buildLimitsInput(data)
 
 	// Update the service instance limits
+	unlock := lockServiceInstance(input.ServiceId, input.EnvironmentId)
 	_, err := updateServiceInstanceLimits(ctx, *r.client, input)
+	changedAt := time.Now()
+	unlock()
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service limits, got error: %s", err))
@@ -198,6 +324,29 @@ func (r *ServiceLimitsResource) Update(ctx context.Context, req resource.UpdateR
 	tflog.Trace(ctx, "updated service instance limits")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SkipVerification.ValueBool() {
+		err = r.waitForLimits(ctx, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to verify service limits, got error: %s", err))
+			return
+		}
+	}
+
+	// Railway applies new limits on the next deployment
+	if data.Redeploy.ValueBool() {
+		err = redeployServiceInstanceOnce(ctx, r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), changedAt, &resp.Diagnostics)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service instance, got error: %s", err))
+			return
+		}
+	}
 }
 
 func (r *ServiceLimitsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -208,8 +357,20 @@ func (r *ServiceLimitsResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *ServiceLimitsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: service_id:environment_id
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	serviceId, environmentId, err := parseServiceInstanceId(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Unable to import service limits: %s", err))
+		return
+	}
+
+	// Memory and vCPU limits are populated by the Read that follows the import
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skip_plan_validation"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skip_verification"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("redeploy"), false)...)
 }
 
 func (r *ServiceLimitsResource) buildLimitsInput(data *ServiceLimitsResourceModel) ServiceInstanceLimitsUpdateInput {
@@ -218,10 +379,7 @@ func (r *ServiceLimitsResource) buildLimitsInput(data *ServiceLimitsResourceMode
 		EnvironmentId: data.EnvironmentId.ValueString(),
 	}
 
-	if !data.MemoryGB.IsNull() {
-		memoryGB := data.MemoryGB.ValueFloat64()
-		input.MemoryGB = &memoryGB
-	}
+	input.MemoryGB = serviceLimitsMemoryGB(data)
 
 	if !data.VCPUs.IsNull() {
 		vcpus := data.VCPUs.ValueFloat64()
@@ -230,3 +388,194 @@ func (r *ServiceLimitsResource) buildLimitsInput(data *ServiceLimitsResourceMode
 
 	return input
 }
+
+// servicePlanLimits caches the plan of the project of a service, keyed by service id per configured client, so
+// validating the same service again during plan and apply doesn't repeat the lookup. The project is only known
+// through the service, so services of the same project still look it up once each.
+var servicePlanLimits = struct {
+	sync.Mutex
+	projects map[*graphql.Client]map[string]*getServicePlanLimitsServiceProject
+}{
+	projects: map[*graphql.Client]map[string]*getServicePlanLimitsServiceProject{},
+}
+
+func (r *ServiceLimitsResource) getServicePlan(ctx context.Context, serviceId string) (*getServicePlanLimitsServiceProject, error) {
+	servicePlanLimits.Lock()
+	defer servicePlanLimits.Unlock()
+
+	projects, ok := servicePlanLimits.projects[r.client]
+
+	if !ok {
+		projects = map[string]*getServicePlanLimitsServiceProject{}
+		servicePlanLimits.projects[r.client] = projects
+	}
+
+	if project, ok := projects[serviceId]; ok {
+		return project, nil
+	}
+
+	response, err := getServicePlanLimits(ctx, *r.client, serviceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	projects[serviceId] = &response.Service.Project
+
+	return &response.Service.Project, nil
+}
+
+func (r *ServiceLimitsResource) validatePlanLimits(ctx context.Context, data *ServiceLimitsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	project, err := r.getServicePlan(ctx, data.ServiceId.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read plan limits, got error: %s", err))
+		return diags
+	}
+
+	maxMemoryGB, maxVCPUs := parseServiceInstanceLimits(project.SubscriptionPlanLimit)
+
+	if memoryGB := serviceLimitsMemoryGB(data); maxMemoryGB != nil && memoryGB != nil && *memoryGB > *maxMemoryGB {
+		memoryPath := path.Root("memory_gb")
+
+		if !data.MemoryMB.IsNull() {
+			memoryPath = path.Root("memory_mb")
+		}
+
+		diags.AddAttributeError(
+			memoryPath,
+			"Service Limits Exceed Plan",
+			fmt.Sprintf(
+				"Requested %s GB of memory but the %s plan allows at most %s GB. Lower the limit, upgrade the plan or set `skip_plan_validation = true` if the workspace has custom limits.",
+				formatFloat64(*memoryGB), project.SubscriptionType, formatFloat64(*maxMemoryGB),
+			),
+		)
+	}
+
+	if maxVCPUs != nil && !data.VCPUs.IsNull() && data.VCPUs.ValueFloat64() > *maxVCPUs {
+		diags.AddAttributeError(
+			path.Root("vcpus"),
+			"Service Limits Exceed Plan",
+			fmt.Sprintf(
+				"Requested %s vCPU but the %s plan allows at most %s vCPU. Lower the limit, upgrade the plan or set `skip_plan_validation = true` if the workspace has custom limits.",
+				formatFloat64(data.VCPUs.ValueFloat64()), project.SubscriptionType, formatFloat64(*maxVCPUs),
+			),
+		)
+	}
+
+	return diags
+}
+
+// Railway can take a while to report updated limits, so they are polled until they match what was sent.
+var (
+	serviceLimitsVerifyAttempts = 10
+	serviceLimitsVerifyInterval = 3 * time.Second
+)
+
+// Tolerances absorb memory reported rounded to the MB, and floating point error in vCPUs.
+const (
+	memoryGBTolerance = 1.0 / 1024
+	vcpusTolerance    = 1e-6
+)
+
+// Memory is converted with binary units in both directions, so a GB is 1024 MB and an MB is 1024 * 1024 bytes.
+const (
+	memoryMBPerGB    = 1024
+	memoryBytesPerGB = 1024 * 1024 * 1024
+)
+
+func (r *ServiceLimitsResource) waitForLimits(ctx context.Context, input ServiceInstanceLimitsUpdateInput) error {
+	var memoryGB, vcpus *float64
+
+	for attempt := 1; ; attempt++ {
+		response, err := getServiceInstanceLimitOverride(ctx, *r.client, input.EnvironmentId, input.ServiceId)
+
+		if err != nil {
+			return err
+		}
+
+		memoryGB, vcpus = parseServiceInstanceLimits(response.ServiceInstanceLimitOverride)
+
+		if limitMatches(input.MemoryGB, memoryGB, memoryGBTolerance) && limitMatches(input.VCPUs, vcpus, vcpusTolerance) {
+			tflog.Trace(ctx, "verified service instance limits")
+			return nil
+		}
+
+		if attempt == serviceLimitsVerifyAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(serviceLimitsVerifyInterval):
+		}
+	}
+
+	return fmt.Errorf(
+		"limits did not converge after %d attempts, expected memory %s GB and %s vCPU, got memory %s GB and %s vCPU",
+		serviceLimitsVerifyAttempts, formatLimit(input.MemoryGB), formatLimit(input.VCPUs), formatLimit(memoryGB), formatLimit(vcpus),
+	)
+}
+
+// limitMatches reports whether the reported limit matches the one sent. Limits that weren't sent aren't checked.
+func limitMatches(expected *float64, actual *float64, tolerance float64) bool {
+	if expected == nil {
+		return true
+	}
+
+	return actual != nil && math.Abs(*expected-*actual) <= tolerance
+}
+
+func formatLimit(value *float64) string {
+	if value == nil {
+		return "unset"
+	}
+
+	return formatFloat64(*value)
+}
+
+// serviceLimitsMemoryGB returns the configured memory in GB, converting from `memory_mb` when that is set instead.
+func serviceLimitsMemoryGB(data *ServiceLimitsResourceModel) *float64 {
+	if !data.MemoryMB.IsNull() {
+		memoryGB := float64(data.MemoryMB.ValueInt64()) / memoryMBPerGB
+		return &memoryGB
+	}
+
+	if !data.MemoryGB.IsNull() {
+		memoryGB := data.MemoryGB.ValueFloat64()
+		return &memoryGB
+	}
+
+	return nil
+}
+
+func memoryGBToMB(memoryGB float64) int64 {
+	return int64(math.Round(memoryGB * memoryMBPerGB))
+}
+
+// parseServiceInstanceLimits extracts the memory (in GB) and vCPU overrides from the limit override returned by
+// the API. Railway reports them per container, with memory in bytes.
+func parseServiceInstanceLimits(override map[string]interface{}) (*float64, *float64) {
+	var memoryGB *float64
+	var vcpus *float64
+
+	containers, ok := override["containers"].(map[string]interface{})
+
+	if !ok {
+		return memoryGB, vcpus
+	}
+
+	if memoryBytes, ok := containers["memoryBytes"].(float64); ok {
+		value := memoryBytes / memoryBytesPerGB
+		memoryGB = &value
+	}
+
+	if cpu, ok := containers["cpu"].(float64); ok {
+		vcpus = &cpu
+	}
+
+	return memoryGB, vcpus
+}
@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceLimitsResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// At least one limit is required
+			{
+				Config:      testAccServiceLimitsResourceConfigEmpty(),
+				ExpectError: regexp.MustCompile("At least one attribute out of"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccServiceLimitsResourceConfigDefault(1, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_service_limits.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_service_limits.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("railway_service_limits.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_service_limits.test", "memory_gb", "1"),
+					resource.TestCheckResourceAttr("railway_service_limits.test", "vcpus", "1"),
+					resource.TestCheckResourceAttr("railway_service_limits.test", "skip_plan_validation", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_service_limits.test",
+				ImportState:       true,
+				ImportStateId:     "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c",
+				ImportStateVerify: true,
+			},
+			// ImportState with a malformed identifier
+			{
+				ResourceName:  "railway_service_limits.test",
+				ImportState:   true,
+				ImportStateId: "39da7e07-fa3a-42fd-b695-d229319f2993:staging",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+			// Detect limits modified outside of Terraform
+			{
+				PreConfig: func() {
+					testAccUpdateServiceLimits(t, 2, 2)
+				},
+				Config:             testAccServiceLimitsResourceConfigDefault(1, 1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Restore the configured limits
+			{
+				Config: testAccServiceLimitsResourceConfigDefault(1, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_service_limits.test", "memory_gb", "1"),
+					resource.TestCheckResourceAttr("railway_service_limits.test", "vcpus", "1"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccServiceLimitsResourceConfigDefault(0.5, 0.5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_service_limits.test", "memory_gb", "0.5"),
+					resource.TestCheckResourceAttr("railway_service_limits.test", "vcpus", "0.5"),
+				),
+			},
+			// Update with memory in MB
+			{
+				Config: testAccServiceLimitsResourceConfigMemoryMB(768, 0.5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_service_limits.test", "memory_mb", "768"),
+					resource.TestCheckNoResourceAttr("railway_service_limits.test", "memory_gb"),
+					resource.TestCheckResourceAttr("railway_service_limits.test", "vcpus", "0.5"),
+				),
+			},
+			// Limits above the plan maximums are rejected before applying
+			{
+				Config:      testAccServiceLimitsResourceConfigDefault(0.5, 64),
+				ExpectError: regexp.MustCompile("Service Limits Exceed Plan"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUpdateServiceLimits(t *testing.T, memoryGB float64, vcpus float64) {
+	httpClient := http.Client{
+		Transport: &authedTransport{
+			token:   os.Getenv(envVarName),
+			wrapped: http.DefaultTransport,
+		},
+	}
+
+	client := graphql.NewClient("https://backboard.railway.app/graphql/v2?source=terraform_provider_railway", &httpClient)
+
+	_, err := updateServiceInstanceLimits(context.Background(), client, ServiceInstanceLimitsUpdateInput{
+		ServiceId:     "39da7e07-fa3a-42fd-b695-d229319f2993",
+		EnvironmentId: "d0519b29-5d12-4857-a5dd-76fa7418336c",
+		MemoryGB:      &memoryGB,
+		VCPUs:         &vcpus,
+	})
+
+	if err != nil {
+		t.Fatalf("unable to update service limits outside of terraform: %s", err)
+	}
+}
+
+func testAccServiceLimitsResourceConfigDefault(memoryGB float64, vcpus float64) string {
+	return fmt.Sprintf(`
+resource "railway_service_limits" "test" {
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  memory_gb = %g
+  vcpus = %g
+}
+`, memoryGB, vcpus)
+}
+
+func testAccServiceLimitsResourceConfigEmpty() string {
+	return `
+resource "railway_service_limits" "test" {
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+}
+`
+}
+
+func testAccServiceLimitsResourceConfigMemoryMB(memoryMB int64, vcpus float64) string {
+	return fmt.Sprintf(`
+resource "railway_service_limits" "test" {
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  memory_mb = %d
+  vcpus = %g
+}
+`, memoryMB, vcpus)
+}
+
+func TestServiceLimitsResourceWaitForLimits(t *testing.T) {
+	interval := serviceLimitsVerifyInterval
+	serviceLimitsVerifyInterval = time.Millisecond
+	t.Cleanup(func() { serviceLimitsVerifyInterval = interval })
+
+	memoryGB := 0.75
+	vcpus := 1.0
+	input := ServiceInstanceLimitsUpdateInput{
+		ServiceId:     "39da7e07-fa3a-42fd-b695-d229319f2993",
+		EnvironmentId: "d0519b29-5d12-4857-a5dd-76fa7418336c",
+		MemoryGB:      &memoryGB,
+		VCPUs:         &vcpus,
+	}
+
+	testCases := map[string]struct {
+		responses   []string
+		expectError bool
+	}{
+		"converged": {
+			responses: []string{
+				`{"data": {"serviceInstanceLimitOverride": {"containers": {"cpu": 1, "memoryBytes": 805306368}}}}`,
+			},
+		},
+		"eventually converged": {
+			responses: []string{
+				`{"data": {"serviceInstanceLimitOverride": null}}`,
+				`{"data": {"serviceInstanceLimitOverride": {"containers": {"cpu": 2, "memoryBytes": 2147483648}}}}`,
+				`{"data": {"serviceInstanceLimitOverride": {"containers": {"cpu": 1, "memoryBytes": 805306368}}}}`,
+			},
+		},
+		"converged within rounding": {
+			responses: []string{
+				`{"data": {"serviceInstanceLimitOverride": {"containers": {"cpu": 1, "memoryBytes": 805830656}}}}`,
+			},
+		},
+		"never converged": {
+			responses: []string{
+				`{"data": {"serviceInstanceLimitOverride": {"containers": {"cpu": 2, "memoryBytes": 2147483648}}}}`,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			reads := 0
+
+			client := newTestClient(t, func(operationName string) string {
+				if operationName != "getServiceInstanceLimitOverride" {
+					t.Errorf("unexpected operation: %s", operationName)
+				}
+
+				response := testCase.responses[min(reads, len(testCase.responses)-1)]
+				reads++
+
+				return response
+			})
+
+			r := &ServiceLimitsResource{client: client}
+			err := r.waitForLimits(context.Background(), input)
+
+			if (err != nil) != testCase.expectError {
+				t.Errorf("expected error: %t, got: %v", testCase.expectError, err)
+			}
+		})
+	}
+}
+
+func TestServiceLimitsResourceSerializedWithServiceInstance(t *testing.T) {
+	ctx := context.Background()
+
+	var inFlight, maxInFlight int32
+
+	client := newTestClient(t, func(operationName string) string {
+		switch operationName {
+		case "updateServiceInstanceWithEnv", "updateServiceInstanceLimits":
+			current := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+
+				if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+					break
+				}
+			}
+
+			time.Sleep(20 * time.Millisecond)
+
+			if operationName == "updateServiceInstanceLimits" {
+				return `{"data": {"serviceInstanceLimitsUpdate": true}}`
+			}
+
+			return `{"data": {"serviceInstanceUpdate": true}}`
+		case "getServiceInstanceForResource":
+			return `{"data": {"serviceInstance": {"builder": "RAILPACK", "restartPolicyType": "ON_FAILURE", "restartPolicyMaxRetries": 10}}}`
+		default:
+			t.Errorf("unexpected operation: %s", operationName)
+			return `{}`
+		}
+	})
+
+	instance := &ServiceInstanceResource{client: client}
+	instanceSchema := &fwresource.SchemaResponse{}
+	instance.Schema(ctx, fwresource.SchemaRequest{}, instanceSchema)
+
+	instanceData := serviceInstanceTestModel()
+	instanceData.Redeploy = types.BoolValue(false)
+
+	instancePlan := tfsdk.Plan{Schema: instanceSchema.Schema}
+	instancePlan.Set(ctx, instanceData)
+
+	limits := &ServiceLimitsResource{client: client}
+	limitsSchema := &fwresource.SchemaResponse{}
+	limits.Schema(ctx, fwresource.SchemaRequest{}, limitsSchema)
+
+	limitsPlan := tfsdk.Plan{Schema: limitsSchema.Schema}
+	limitsPlan.Set(ctx, &ServiceLimitsResourceModel{
+		Id:                 types.StringUnknown(),
+		ServiceId:          types.StringValue("39da7e07-fa3a-42fd-b695-d229319f2993"),
+		EnvironmentId:      types.StringValue("d0519b29-5d12-4857-a5dd-76fa7418336c"),
+		MemoryGB:           types.Float64Value(1),
+		MemoryMB:           types.Int64Null(),
+		VCPUs:              types.Float64Value(1),
+		SkipPlanValidation: types.BoolValue(true),
+		SkipVerification:   types.BoolValue(true),
+		Redeploy:           types.BoolValue(false),
+	})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 3; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: instanceSchema.Schema}}
+			instance.Create(ctx, fwresource.CreateRequest{Plan: instancePlan}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: limitsSchema.Schema}}
+			limits.Create(ctx, fwresource.CreateRequest{Plan: limitsPlan}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if maxInFlight != 1 {
+		t.Errorf("expected writes to the service instance to be serialized, got %d concurrent writes", maxInFlight)
+	}
+}
+
+func TestServiceLimitsMemoryRoundTrip(t *testing.T) {
+	for _, memoryMB := range []int64{256, 512, 768, 1024, 1536, 8192, 32768} {
+		data := &ServiceLimitsResourceModel{
+			MemoryMB: types.Int64Value(memoryMB),
+			MemoryGB: types.Float64Null(),
+		}
+
+		sent := serviceLimitsMemoryGB(data)
+
+		// Railway reports the memory it was sent in bytes
+		override := map[string]interface{}{
+			"containers": map[string]interface{}{"memoryBytes": *sent * memoryBytesPerGB},
+		}
+
+		memoryGB, _ := parseServiceInstanceLimits(override)
+
+		if memoryGB == nil {
+			t.Fatalf("expected memory for %d MB", memoryMB)
+		}
+
+		if read := memoryGBToMB(*memoryGB); read != memoryMB {
+			t.Errorf("expected %d MB to read back unchanged, got %d MB", memoryMB, read)
+		}
+
+		if !limitMatches(sent, memoryGB, memoryGBTolerance) {
+			t.Errorf("expected %s GB to match %s GB", formatLimit(sent), formatLimit(memoryGB))
+		}
+	}
+}
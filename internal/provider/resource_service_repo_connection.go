@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ServiceRepoConnectionResource{}
+var _ resource.ResourceWithImportState = &ServiceRepoConnectionResource{}
+
+func NewServiceRepoConnectionResource() resource.Resource {
+	return &ServiceRepoConnectionResource{}
+}
+
+type ServiceRepoConnectionResource struct {
+	client *graphql.Client
+}
+
+type ServiceRepoConnectionResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	ServiceId  types.String `tfsdk:"service_id"`
+	Repository types.String `tfsdk:"repository"`
+	Branch     types.String `tfsdk:"branch"`
+}
+
+func (r *ServiceRepoConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_repo_connection"
+}
+
+func (r *ServiceRepoConnectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway service repository connection. Connects a GitHub repository to an existing service, such as one created from a template. Destroying it disconnects the repository and keeps the service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the connection, the same as `service_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service to connect the repository to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "GitHub repository to connect, in the format `owner/name`. The Railway GitHub app must have access to it.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^/\s]+/[^/\s]+$`), "must be a repository in the format owner/name"),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch of the repository to deploy. Defaults to the default branch of the repository.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *ServiceRepoConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServiceRepoConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ServiceRepoConnectionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.connect(ctx, data, &resp.Diagnostics) {
+		return
+	}
+
+	tflog.Trace(ctx, "created a service repo connection")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceRepoConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ServiceRepoConnectionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := getServiceRepoConnection(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service repo connection, got error: %s", err))
+		return
+	}
+
+	// Disconnected outside of terraform, let it be connected again
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceRepoConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ServiceRepoConnectionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.connect(ctx, data, &resp.Diagnostics) {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a service repo connection")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceRepoConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ServiceRepoConnectionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the repository is disconnected, the service stays
+	_, err := disconnectService(ctx, *r.client, data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disconnect service repository, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a service repo connection")
+}
+
+func (r *ServiceRepoConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !uuidRegex().MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), req.ID)...)
+}
+
+// connect connects the repository of data to its service and reads the connection back into data.
+func (r *ServiceRepoConnectionResource) connect(ctx context.Context, data *ServiceRepoConnectionResourceModel, diags *diag.Diagnostics) bool {
+	input := ServiceConnectInput{
+		Repo: data.Repository.ValueStringPointer(),
+	}
+
+	if !data.Branch.IsNull() && !data.Branch.IsUnknown() {
+		input.Branch = data.Branch.ValueStringPointer()
+	}
+
+	_, err := connectService(ctx, *r.client, data.ServiceId.ValueString(), input)
+
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf(
+				"Unable to connect repository %s, got error: %s\n\nMake sure the Railway GitHub app is installed on the owner of the repository and has access to it.",
+				data.Repository.ValueString(), err,
+			),
+		)
+
+		return false
+	}
+
+	found, err := getServiceRepoConnection(ctx, *r.client, data)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read service repo connection, got error: %s", err))
+		return false
+	}
+
+	if !found {
+		diags.AddError("Client Error", fmt.Sprintf("Repository %s is not connected to the service after connecting it", data.Repository.ValueString()))
+		return false
+	}
+
+	return true
+}
+
+// getServiceRepoConnection reads the connected repository of the service into data, reporting whether one is
+// connected. The service has a trigger per environment, the one for the repository in data is preferred.
+func getServiceRepoConnection(ctx context.Context, client graphql.Client, data *ServiceRepoConnectionResourceModel) (bool, error) {
+	response, err := getServiceRepoTriggers(ctx, client, data.ServiceId.ValueString())
+
+	if err != nil {
+		return false, err
+	}
+
+	edges := response.Service.RepoTriggers.Edges
+
+	if len(edges) == 0 {
+		return false, nil
+	}
+
+	trigger := edges[0].Node
+
+	for _, edge := range edges {
+		if edge.Node.Repository == data.Repository.ValueString() {
+			trigger = edge.Node
+			break
+		}
+	}
+
+	data.Id = types.StringValue(response.Service.Id)
+	data.ServiceId = types.StringValue(response.Service.Id)
+	data.Repository = types.StringValue(trigger.Repository)
+	data.Branch = types.StringValue(trigger.Branch)
+
+	return true, nil
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceRepoConnectionResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccServiceRepoConnectionResourceConfigDefault("main"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_service_repo_connection.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("railway_service_repo_connection.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("railway_service_repo_connection.test", "repository", "railwayapp/starters"),
+					resource.TestCheckResourceAttr("railway_service_repo_connection.test", "branch", "main"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_service_repo_connection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccServiceRepoConnectionResourceConfigDefault("staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_service_repo_connection.test", "repository", "railwayapp/starters"),
+					resource.TestCheckResourceAttr("railway_service_repo_connection.test", "branch", "staging"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccServiceRepoConnectionResourceConfigDefault(branch string) string {
+	return fmt.Sprintf(`
+resource "railway_service_repo_connection" "test" {
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  repository = "railwayapp/starters"
+  branch = "%s"
+}
+`, branch)
+}
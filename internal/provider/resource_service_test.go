@@ -5,6 +5,8 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -19,6 +21,7 @@ func TestAccServiceResourceDefault(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestMatchResourceAttr("railway_service.test", "id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_service.test", "name", "todo-app"),
+					resource.TestCheckResourceAttr("railway_service.test", "deletion_protection", "false"),
 					resource.TestCheckResourceAttr("railway_service.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
 					resource.TestCheckNoResourceAttr("railway_service.test", "cron_schedule"),
 					resource.TestCheckNoResourceAttr("railway_service.test", "source_image"),
@@ -171,6 +174,7 @@ func TestAccServiceResourceNonDefaultImage(t *testing.T) {
 					resource.TestMatchResourceAttr("railway_service.test", "id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_service.test", "name", "todo-app"),
 					resource.TestCheckResourceAttr("railway_service.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_service.test", "icon", "nodejs"),
 					resource.TestCheckNoResourceAttr("railway_service.test", "cron_schedule"),
 					resource.TestCheckResourceAttr("railway_service.test", "source_image", "hello-world"),
 					resource.TestCheckNoResourceAttr("railway_service.test", "source_repo"),
@@ -195,6 +199,7 @@ func TestAccServiceResourceNonDefaultImage(t *testing.T) {
 					resource.TestMatchResourceAttr("railway_service.test", "id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_service.test", "name", "todo-app"),
 					resource.TestCheckResourceAttr("railway_service.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_service.test", "icon", "nodejs"),
 					resource.TestCheckNoResourceAttr("railway_service.test", "cron_schedule"),
 					resource.TestCheckResourceAttr("railway_service.test", "source_image", "hello-world"),
 					resource.TestCheckNoResourceAttr("railway_service.test", "source_repo"),
@@ -213,6 +218,7 @@ func TestAccServiceResourceNonDefaultImage(t *testing.T) {
 					resource.TestMatchResourceAttr("railway_service.test", "id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_service.test", "name", "nue-todo-app"),
 					resource.TestCheckResourceAttr("railway_service.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckNoResourceAttr("railway_service.test", "icon"),
 					resource.TestCheckNoResourceAttr("railway_service.test", "cron_schedule"),
 					resource.TestCheckNoResourceAttr("railway_service.test", "source_image"),
 					resource.TestCheckNoResourceAttr("railway_service.test", "source_repo"),
@@ -416,6 +422,8 @@ func TestAccServiceResourceNonDefaultVolume(t *testing.T) {
 					resource.TestCheckResourceAttr("railway_service.test", "volume.name", "todo-app-volume"),
 					resource.TestCheckResourceAttr("railway_service.test", "volume.mount_path", "/mnt"),
 					resource.TestCheckResourceAttr("railway_service.test", "volume.size", "50000"),
+					resource.TestCheckResourceAttrSet("railway_service.test", "volume.region"),
+					resource.TestCheckResourceAttr("railway_service.test", "volume.replace_on_region_change", "false"),
 					resource.TestCheckNoResourceAttr("railway_service.test", "regions"),
 				),
 			},
@@ -586,6 +594,7 @@ func testAccServiceResourceConfigNonDefaultImage(name string) string {
 	return fmt.Sprintf(`
 resource "railway_service" "test" {
   name = "%s"
+  icon = "nodejs"
   project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
 
   source_image = "hello-world"
@@ -660,3 +669,72 @@ resource "railway_service" "test" {
 }
 `, name)
 }
+
+func TestDeletionProtected(t *testing.T) {
+	testCases := map[string]struct {
+		deletionProtection types.Bool
+		expected           bool
+	}{
+		"enabled": {
+			deletionProtection: types.BoolValue(true),
+			expected:           true,
+		},
+		"disabled": {
+			deletionProtection: types.BoolValue(false),
+		},
+		"null": {
+			deletionProtection: types.BoolNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			if protected := deletionProtected(testCase.deletionProtection, "service", &diags); protected != testCase.expected {
+				t.Errorf("expected protected %t, got %t", testCase.expected, protected)
+			}
+
+			if diags.HasError() != testCase.expected {
+				t.Errorf("expected error %t, got %v", testCase.expected, diags)
+			}
+		})
+	}
+}
+
+func TestVolumeRegionChanged(t *testing.T) {
+	testCases := map[string]struct {
+		state    types.String
+		plan     types.String
+		expected bool
+	}{
+		"same region": {
+			state: types.StringValue("us-west2"),
+			plan:  types.StringValue("us-west2"),
+		},
+		"other region": {
+			state:    types.StringValue("us-west2"),
+			plan:     types.StringValue("europe-west4-drams3a"),
+			expected: true,
+		},
+		"region not configured": {
+			state: types.StringValue("us-west2"),
+			plan:  types.StringUnknown(),
+		},
+		"region not reported": {
+			state: types.StringNull(),
+			plan:  types.StringValue("us-west2"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			volumeState := &ServiceResourceVolumeModel{Region: testCase.state}
+			volumeData := &ServiceResourceVolumeModel{Region: testCase.plan}
+
+			if changed := volumeRegionChanged(volumeState, volumeData); changed != testCase.expected {
+				t.Errorf("expected changed %t, got %t", testCase.expected, changed)
+			}
+		})
+	}
+}
@@ -45,7 +45,7 @@ func (r *SharedVariableResource) Schema(ctx context.Context, req resource.Schema
 		MarkdownDescription: "Railway shared variable.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "Identifier of the variable.",
+				MarkdownDescription: "Identifier of the variable, in the format `project_id:environment_id:name`.",
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
@@ -132,13 +132,18 @@ func (r *SharedVariableResource) Create(ctx context.Context, req resource.Create
 
 	tflog.Trace(ctx, "created a shared variable")
 
-	err = getSharedVariable(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.Name.ValueString(), data)
+	found, err := getSharedVariable(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.Name.ValueString(), data)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared variable after creating it, got error: %s", err))
 		return
 	}
 
+	if !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Shared variable %s not found after creating it", data.Name.ValueString()))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -151,13 +156,19 @@ func (r *SharedVariableResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	err := getSharedVariable(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.Name.ValueString(), data)
+	found, err := getSharedVariable(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.Name.ValueString(), data)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared variable, got error: %s", err))
 		return
 	}
 
+	// Deleted outside of terraform, let it be created again
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -186,13 +197,18 @@ func (r *SharedVariableResource) Update(ctx context.Context, req resource.Update
 
 	tflog.Trace(ctx, "updated a shared variable")
 
-	err = getSharedVariable(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.Name.ValueString(), data)
+	found, err := getSharedVariable(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.Name.ValueString(), data)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared variable after updating it, got error: %s", err))
 		return
 	}
 
+	if !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Shared variable %s not found after updating it", data.Name.ValueString()))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -227,17 +243,24 @@ func (r *SharedVariableResource) ImportState(ctx context.Context, req resource.I
 	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: project_id:environment_name:name. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: project_id:environment_id:name or project_id:environment_name:name. Got: %q", req.ID),
 		)
 
 		return
 	}
 
-	environmentId, err := findEnvironment(ctx, *r.client, parts[0], parts[1])
+	// Environment names are never UUIDs, so the id of the variable can be imported as well
+	environmentId := &parts[1]
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
-		return
+	if !uuidRegex().MatchString(parts[1]) {
+		var err error
+
+		environmentId, err = findEnvironment(ctx, *r.client, parts[0], parts[1])
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+			return
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
@@ -245,20 +268,25 @@ func (r *SharedVariableResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
 }
 
-func getSharedVariable(ctx context.Context, client graphql.Client, projectId string, environmentId string, name string, data *SharedVariableResourceModel) error {
+// getSharedVariable reads the shared variable into data, reporting whether it exists.
+func getSharedVariable(ctx context.Context, client graphql.Client, projectId string, environmentId string, name string, data *SharedVariableResourceModel) (bool, error) {
 	response, err := getSharedVariables(ctx, client, projectId, environmentId)
 
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	if value, ok := response.Variables[name]; ok {
-		data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", projectId, environmentId, name))
-		data.Name = types.StringValue(name)
-		data.Value = types.StringValue(fmt.Sprintf("%v", value))
-		data.ProjectId = types.StringValue(projectId)
-		data.EnvironmentId = types.StringValue(environmentId)
+	value, ok := response.Variables[name]
+
+	if !ok {
+		return false, nil
 	}
 
-	return nil
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", projectId, environmentId, name))
+	data.Name = types.StringValue(name)
+	data.Value = types.StringValue(fmt.Sprintf("%v", value))
+	data.ProjectId = types.StringValue(projectId)
+	data.EnvironmentId = types.StringValue(environmentId)
+
+	return true, nil
 }
@@ -27,7 +27,7 @@ func TestAccSharedVariableResourceDefault(t *testing.T) {
 			{
 				ResourceName:      "railway_shared_variable.test",
 				ImportState:       true,
-				ImportStateId:     "0bb01547-570d-4109-a5e8-138691f6a2d1:staging:API_KEY",
+				ImportStateId:     "0bb01547-570d-4109-a5e8-138691f6a2d1:d0519b29-5d12-4857-a5dd-76fa7418336c:API_KEY",
 				ImportStateVerify: true,
 			},
 			// Update with default values
@@ -3,12 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -145,14 +145,7 @@ func (r *TcpProxyResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	proxy := response.TcpProxyCreate.TCPProxy
-
-	data.Id = types.StringValue(proxy.Id)
-	data.ApplicationPort = types.Int64Value(int64(proxy.ApplicationPort))
-	data.EnvironmentId = types.StringValue(proxy.EnvironmentId)
-	data.ServiceId = types.StringValue(proxy.ServiceId)
-	data.ProxyPort = types.Int64Value(int64(proxy.ProxyPort))
-	data.Domain = types.StringValue(proxy.Domain)
+	buildTcpProxy(&response.TcpProxyCreate.TCPProxy, data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -166,24 +159,24 @@ func (r *TcpProxyResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	response, err := getTcpProxy(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+	// Keyed on the id, so a proxy whose port was changed outside of terraform shows up as drift
+	proxy, err := findTcpProxy(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), func(proxy *TCPProxy) bool {
+		return proxy.Id == data.Id.ValueString()
+	})
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tcp proxy, got error: %s", err))
 		return
 	}
 
-	for _, proxy := range response.TcpProxies {
-		if proxy.Id == data.Id.ValueString() {
-			data.Id = types.StringValue(proxy.Id)
-			data.ApplicationPort = types.Int64Value(int64(proxy.ApplicationPort))
-			data.EnvironmentId = types.StringValue(proxy.EnvironmentId)
-			data.ServiceId = types.StringValue(proxy.ServiceId)
-			data.ProxyPort = types.Int64Value(int64(proxy.ProxyPort))
-			data.Domain = types.StringValue(proxy.Domain)
-		}
+	// Deleted outside of terraform, let it be created again
+	if proxy == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
+	buildTcpProxy(proxy, data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -221,16 +214,116 @@ func (r *TcpProxyResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *TcpProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.Split(req.ID, ":")
 
-	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+	var proxy *TCPProxy
+	var err error
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		proxy, err = findTcpProxyById(ctx, *r.client, parts[0])
+	case len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "":
+		// The last part is the application port, or the proxy id like in earlier versions
+		matches := func(proxy *TCPProxy) bool { return proxy.Id == parts[2] }
+
+		if port, portErr := strconv.Atoi(parts[2]); portErr == nil {
+			matches = func(proxy *TCPProxy) bool { return proxy.ApplicationPort == port }
+		}
+
+		proxy, err = findTcpProxy(ctx, *r.client, parts[1], parts[0], matches)
+	default:
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: service_id:environment_id:tcp_proxy_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: tcp_proxy_id or service_id:environment_id:application_port. Got: %q", req.ID),
 		)
 
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tcp proxy, got error: %s", err))
+		return
+	}
+
+	if proxy == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find tcp proxy %q", req.ID))
+		return
+	}
+
+	var data TcpProxyResourceModel
+
+	buildTcpProxy(proxy, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func buildTcpProxy(proxy *TCPProxy, data *TcpProxyResourceModel) {
+	data.Id = types.StringValue(proxy.Id)
+	data.ApplicationPort = types.Int64Value(int64(proxy.ApplicationPort))
+	data.EnvironmentId = types.StringValue(proxy.EnvironmentId)
+	data.ServiceId = types.StringValue(proxy.ServiceId)
+	data.ProxyPort = types.Int64Value(int64(proxy.ProxyPort))
+	data.Domain = types.StringValue(proxy.Domain)
+}
+
+// findTcpProxy returns the first TCP proxy of the service instance that matches, or nil when none does.
+func findTcpProxy(ctx context.Context, client graphql.Client, environmentId string, serviceId string, matches func(proxy *TCPProxy) bool) (*TCPProxy, error) {
+	response, err := getTcpProxy(ctx, client, environmentId, serviceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, proxy := range response.TcpProxies {
+		if matches(&proxy.TCPProxy) {
+			return &proxy.TCPProxy, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findTcpProxyById looks for the TCP proxy in every service instance of every project, as TCP proxies can't be read
+// by id alone. This takes one request per service instance, so importing by service_id:environment_id:application_port
+// is preferred. It returns nil when none has the id.
+func findTcpProxyById(ctx context.Context, client graphql.Client, id string) (*TCPProxy, error) {
+	var after *string
+
+	for {
+		response, err := listTcpProxyProjects(ctx, client, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, project := range response.Projects.Edges {
+			environments, err := listAllEnvironments(ctx, client, project.Node.Id, nil)
+
+			if err != nil {
+				return nil, err
+			}
+
+			services, err := listAllProjectServices(ctx, client, project.Node.Id)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, environment := range environments {
+				for _, service := range services {
+					proxy, err := findTcpProxy(ctx, client, environment.Id, service.Id, func(proxy *TCPProxy) bool {
+						return proxy.Id == id
+					})
+
+					if err != nil || proxy != nil {
+						return proxy, err
+					}
+				}
+			}
+		}
+
+		if !response.Projects.PageInfo.HasNextPage {
+			return nil, nil
+		}
+
+		after = &response.Projects.PageInfo.EndCursor
+	}
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -32,6 +33,12 @@ func TestAccTcpProxyResourceDefault(t *testing.T) {
 				ImportStateIdFunc: tcpProxyImportIdFunc,
 				ImportStateVerify: true,
 			},
+			// ImportState by id testing
+			{
+				ResourceName:      "railway_tcp_proxy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Update with default values
 			{
 				Config: testAccTcpProxyResourceConfigDefault(6379),
@@ -66,5 +73,70 @@ func tcpProxyImportIdFunc(state *terraform.State) (string, error) {
 		return "", fmt.Errorf("Resource Not found")
 	}
 
-	return fmt.Sprintf("%s:%s:%s", rawState.Primary.Attributes["service_id"], rawState.Primary.Attributes["environment_id"], rawState.Primary.Attributes["id"]), nil
+	return fmt.Sprintf("%s:%s:%s", rawState.Primary.Attributes["service_id"], rawState.Primary.Attributes["environment_id"], rawState.Primary.Attributes["application_port"]), nil
+}
+
+func TestFindTcpProxyById(t *testing.T) {
+	var pages, environmentPages, servicePages, proxyLookups int
+
+	client := newTestClient(t, func(operationName string) string {
+		switch operationName {
+		case "listTcpProxyProjects":
+			pages++
+
+			if pages == 1 {
+				return `{"data": {"projects": {"edges": [{"node": {"id": "project-1"}}], "pageInfo": {"hasNextPage": true, "endCursor": "cursor-1"}}}}`
+			}
+
+			return `{"data": {"projects": {"edges": [{"node": {"id": "project-2"}}], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}`
+		case "listEnvironments":
+			environmentPages++
+
+			return fmt.Sprintf(`{"data": {"environments": {"edges": [{"node": {"id": "environment-%d", "name": "production", "isEphemeral": false}}], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}`, environmentPages)
+		case "listProjectServices":
+			servicePages++
+
+			// The services of the second project span two pages
+			switch servicePages {
+			case 1:
+				return `{"data": {"project": {"services": {"edges": [{"node": {"id": "service-1", "name": "api"}}], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}}`
+			case 2:
+				return `{"data": {"project": {"services": {"edges": [{"node": {"id": "service-2", "name": "web"}}], "pageInfo": {"hasNextPage": true, "endCursor": "cursor-2"}}}}}`
+			default:
+				return `{"data": {"project": {"services": {"edges": [{"node": {"id": "service-3", "name": "redis"}}], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}}`
+			}
+		case "getTcpProxy":
+			proxyLookups++
+
+			if proxyLookups < 3 {
+				return `{"data": {"tcpProxies": []}}`
+			}
+
+			return `{"data": {"tcpProxies": [{"id": "proxy-1", "applicationPort": 6379, "proxyPort": 12345, "domain": "roundhouse.proxy.rlwy.net", "environmentId": "environment-2", "serviceId": "service-3"}]}}`
+		default:
+			t.Errorf("unexpected operation: %s", operationName)
+			return `{}`
+		}
+	})
+
+	proxy, err := findTcpProxyById(context.Background(), *client, "proxy-1")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if proxy == nil || proxy.ServiceId != "service-3" || proxy.ProxyPort != 12345 {
+		t.Fatalf("expected proxy of service-3, got %+v", proxy)
+	}
+
+	pages, environmentPages, servicePages, proxyLookups = 0, 0, 0, 0
+	proxy, err = findTcpProxyById(context.Background(), *client, "proxy-2")
+
+	if err != nil || proxy != nil {
+		t.Fatalf("expected no proxy, got %+v and %v", proxy, err)
+	}
+
+	if pages != 2 || proxyLookups != 3 {
+		t.Errorf("expected every service instance to be searched, got %d pages and %d lookups", pages, proxyLookups)
+	}
 }
@@ -0,0 +1,456 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TemplateDeployResource{}
+
+func NewTemplateDeployResource() resource.Resource {
+	return &TemplateDeployResource{}
+}
+
+type TemplateDeployResource struct {
+	client *graphql.Client
+}
+
+type TemplateDeployResourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	Code                    types.String `tfsdk:"code"`
+	ProjectId               types.String `tfsdk:"project_id"`
+	EnvironmentId           types.String `tfsdk:"environment_id"`
+	Variables               types.Map    `tfsdk:"variables"`
+	ServiceIds              types.Map    `tfsdk:"service_ids"`
+	DeleteServicesOnDestroy types.Bool   `tfsdk:"delete_services_on_destroy"`
+}
+
+func (r *TemplateDeployResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template_deploy"
+}
+
+func (r *TemplateDeployResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway template deploy. Deploys the services of a template into an environment once, changing any of the inputs deploys the template again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the template deploy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Code of the template, as in `https://railway.com/template/<code>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project to deploy the template into.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment to deploy the template into.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"variables": schema.MapAttribute{
+				MarkdownDescription: "Values of the template variables. Keys are either the variable name, set on every service that has it, or `<service name>.<variable name>` for a single service.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_ids": schema.MapAttribute{
+				MarkdownDescription: "Identifiers of the services created by the template, keyed by service name. Services of the template sharing a name are keyed by `<service name>:<template service id>` instead.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_services_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete the services created by the template when the resource is destroyed. Otherwise they are left in place. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *TemplateDeployResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TemplateDeployResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TemplateDeployResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables := map[string]string{}
+
+	if !data.Variables.IsNull() {
+		resp.Diagnostics.Append(data.Variables.ElementsAs(ctx, &variables, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	template, err := getTemplate(ctx, *r.client, data.Code.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read template, got error: %s", err))
+		return
+	}
+
+	config := template.Template.SerializedConfig
+
+	if err := applyTemplateVariables(config, variables); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("variables"), "Invalid Template Variables", err.Error())
+		return
+	}
+
+	// Railway doesn't report which services a deploy created, so they are found by comparing the services before and after
+	existingServices, err := listAllProjectServices(ctx, *r.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read services, got error: %s", err))
+		return
+	}
+
+	response, err := deployTemplate(ctx, *r.client, TemplateDeployV2Input{
+		EnvironmentId:    data.EnvironmentId.ValueStringPointer(),
+		ProjectId:        data.ProjectId.ValueStringPointer(),
+		SerializedConfig: config,
+		TemplateId:       template.Template.Id,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy template, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deployed a template")
+
+	var workflowErr error
+
+	if workflowId := response.TemplateDeployV2.WorkflowId; workflowId != nil {
+		workflowErr = waitForWorkflow(ctx, *r.client, *workflowId, templateDeployTimeout)
+	}
+
+	services, err := listAllProjectServices(ctx, *r.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		if workflowErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for the template deploy, got error: %s", workflowErr))
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read services, got error: %s", err))
+		return
+	}
+
+	templateServices, _ := config["services"].(map[string]interface{})
+
+	serviceIdsValue, diags := types.MapValueFrom(ctx, types.StringType, deployedTemplateServiceIds(existingServices, services, templateServices))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.Code.ValueString()))
+	data.ServiceIds = serviceIdsValue
+
+	// Saved even when the deploy failed, so the services it already created are not orphaned
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if workflowErr != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for the template deploy, got error: %s", workflowErr))
+	}
+}
+
+func (r *TemplateDeployResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TemplateDeployResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceIds := map[string]string{}
+
+	resp.Diagnostics.Append(data.ServiceIds.ElementsAs(ctx, &serviceIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	services, err := listAllProjectServices(ctx, *r.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read services, got error: %s", err))
+		return
+	}
+
+	existing := map[string]bool{}
+
+	for _, service := range services {
+		existing[service.Id] = true
+	}
+
+	// Services deleted outside of terraform are no longer part of the deploy
+	for name, serviceId := range serviceIds {
+		if !existing[serviceId] {
+			delete(serviceIds, name)
+		}
+	}
+
+	serviceIdsValue, diags := types.MapValueFrom(ctx, types.StringType, serviceIds)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ServiceIds = serviceIdsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateDeployResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TemplateDeployResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Everything else requires replacement, only delete_services_on_destroy can change
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateDeployResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TemplateDeployResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DeleteServicesOnDestroy.ValueBool() {
+		tflog.Trace(ctx, "keeping the services of the template deploy")
+		return
+	}
+
+	serviceIds := map[string]string{}
+
+	resp.Diagnostics.Append(data.ServiceIds.ElementsAs(ctx, &serviceIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, serviceId := range serviceIds {
+		_, err := deleteService(ctx, *r.client, serviceId)
+
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete service %s, got error: %s", name, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted the services of a template deploy")
+}
+
+// deployedTemplateServiceIds returns the services created by a template deploy, keyed by name. Only new services
+// that come from one of the services of the template count, so services created at the same time by something else
+// are left out. Services sharing a name are keyed by <service name>:<template service id> instead, so none is dropped.
+func deployedTemplateServiceIds(before []listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService, after []listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService, templateServices map[string]interface{}) map[string]string {
+	existing := map[string]bool{}
+
+	for _, service := range before {
+		existing[service.Id] = true
+	}
+
+	var deployed []listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService
+
+	names := map[string]int{}
+
+	for _, service := range after {
+		if existing[service.Id] || service.TemplateServiceId == nil {
+			continue
+		}
+
+		if _, ok := templateServices[*service.TemplateServiceId]; !ok {
+			continue
+		}
+
+		deployed = append(deployed, service)
+		names[service.Name]++
+	}
+
+	serviceIds := map[string]string{}
+
+	for _, service := range deployed {
+		key := service.Name
+
+		if names[service.Name] > 1 {
+			key = service.Name + ":" + *service.TemplateServiceId
+		}
+
+		serviceIds[key] = service.Id
+	}
+
+	return serviceIds
+}
+
+var (
+	templateDeployTimeout = 20 * time.Minute
+	workflowInterval      = 5 * time.Second
+)
+
+// waitForWorkflow polls a Railway workflow, such as a template deploy, until it completes.
+func waitForWorkflow(ctx context.Context, client graphql.Client, workflowId string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		response, err := getWorkflowStatus(ctx, client, workflowId)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return err
+		}
+
+		switch response.WorkflowStatus.Status {
+		case WorkflowStatusComplete:
+			return nil
+		case WorkflowStatusError:
+			if response.WorkflowStatus.Error != nil {
+				return fmt.Errorf("workflow failed: %s", *response.WorkflowStatus.Error)
+			}
+
+			return fmt.Errorf("workflow failed")
+		case WorkflowStatusNotfound:
+			return fmt.Errorf("workflow %s not found", workflowId)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(workflowInterval):
+		}
+	}
+}
+
+// applyTemplateVariables sets the variable values on the services of a serialized template config. A key is either
+// a variable name, set on every service that has it, or <service name>.<variable name>. Keys that match no variable
+// are an error, so typos don't go unnoticed.
+func applyTemplateVariables(config map[string]interface{}, variables map[string]string) error {
+	used := map[string]bool{}
+	services, _ := config["services"].(map[string]interface{})
+
+	for _, rawService := range services {
+		service, ok := rawService.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		serviceName, _ := service["name"].(string)
+		serviceVariables, _ := service["variables"].(map[string]interface{})
+
+		for name, rawVariable := range serviceVariables {
+			variable, ok := rawVariable.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			for _, key := range []string{serviceName + "." + name, name} {
+				if value, ok := variables[key]; ok {
+					variable["value"] = value
+					used[key] = true
+					break
+				}
+			}
+		}
+	}
+
+	var unknown []string
+
+	for key := range variables {
+		if !used[key] {
+			unknown = append(unknown, key)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("the template has no variables named %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
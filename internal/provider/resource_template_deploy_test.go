@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApplyTemplateVariables(t *testing.T) {
+	newConfig := func() map[string]interface{} {
+		return map[string]interface{}{
+			"services": map[string]interface{}{
+				"a1b2": map[string]interface{}{
+					"name": "api",
+					"variables": map[string]interface{}{
+						"PORT":      map[string]interface{}{"defaultValue": "3000"},
+						"LOG_LEVEL": map[string]interface{}{"defaultValue": "info"},
+					},
+				},
+				"c3d4": map[string]interface{}{
+					"name": "worker",
+					"variables": map[string]interface{}{
+						"LOG_LEVEL": map[string]interface{}{"defaultValue": "info"},
+					},
+				},
+			},
+		}
+	}
+
+	value := func(config map[string]interface{}, serviceId string, name string) interface{} {
+		service := config["services"].(map[string]interface{})[serviceId].(map[string]interface{})
+		return service["variables"].(map[string]interface{})[name].(map[string]interface{})["value"]
+	}
+
+	testCases := map[string]struct {
+		variables   map[string]string
+		expected    map[string]interface{}
+		expectError string
+	}{
+		"no variables": {
+			variables: map[string]string{},
+			expected:  map[string]interface{}{"a1b2/PORT": nil, "a1b2/LOG_LEVEL": nil, "c3d4/LOG_LEVEL": nil},
+		},
+		"variable on every service": {
+			variables: map[string]string{"LOG_LEVEL": "debug"},
+			expected:  map[string]interface{}{"a1b2/PORT": nil, "a1b2/LOG_LEVEL": "debug", "c3d4/LOG_LEVEL": "debug"},
+		},
+		"service variable wins": {
+			variables: map[string]string{"LOG_LEVEL": "debug", "worker.LOG_LEVEL": "warn", "api.PORT": "8080"},
+			expected:  map[string]interface{}{"a1b2/PORT": "8080", "a1b2/LOG_LEVEL": "debug", "c3d4/LOG_LEVEL": "warn"},
+		},
+		"unknown variables": {
+			variables:   map[string]string{"PORT": "8080", "worker.PORT": "8081", "DATABASE_URL": "postgres://"},
+			expectError: "DATABASE_URL, worker.PORT",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := newConfig()
+			err := applyTemplateVariables(config, testCase.variables)
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			actual := map[string]interface{}{}
+
+			for key := range testCase.expected {
+				parts := strings.SplitN(key, "/", 2)
+				actual[key] = value(config, parts[0], parts[1])
+			}
+
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWaitForWorkflow(t *testing.T) {
+	interval := workflowInterval
+	workflowInterval = 10 * time.Millisecond
+	t.Cleanup(func() { workflowInterval = interval })
+
+	testCases := map[string]struct {
+		response    string
+		expectError string
+	}{
+		"complete": {
+			response: `{"data": {"workflowStatus": {"status": "Complete", "error": null}}}`,
+		},
+		"failed": {
+			response:    `{"data": {"workflowStatus": {"status": "Error", "error": "service failed to build"}}}`,
+			expectError: "service failed to build",
+		},
+		"not found": {
+			response:    `{"data": {"workflowStatus": {"status": "NotFound", "error": null}}}`,
+			expectError: "not found",
+		},
+		"still running": {
+			response:    `{"data": {"workflowStatus": {"status": "Running", "error": null}}}`,
+			expectError: "deadline exceeded",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := newTestClient(t, func(operationName string) string {
+				if operationName != "getWorkflowStatus" {
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+
+				return testCase.response
+			})
+
+			err := waitForWorkflow(context.Background(), *client, "workflow-1", 50*time.Millisecond)
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestDeployedTemplateServiceIds(t *testing.T) {
+	type service = listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService
+
+	templateServiceId := func(id string) *string { return &id }
+
+	before := []service{
+		{Id: "existing", Name: "api", TemplateServiceId: templateServiceId("template-api")},
+	}
+
+	after := append(before,
+		service{Id: "api", Name: "api", TemplateServiceId: templateServiceId("template-api")},
+		service{Id: "worker-1", Name: "worker", TemplateServiceId: templateServiceId("template-worker-1")},
+		service{Id: "worker-2", Name: "worker", TemplateServiceId: templateServiceId("template-worker-2")},
+		// Created by another resource in the same apply
+		service{Id: "parallel", Name: "parallel"},
+		service{Id: "other-template", Name: "other", TemplateServiceId: templateServiceId("template-other")},
+	)
+
+	templateServices := map[string]interface{}{
+		"template-api":      map[string]interface{}{"name": "api"},
+		"template-worker-1": map[string]interface{}{"name": "worker"},
+		"template-worker-2": map[string]interface{}{"name": "worker"},
+	}
+
+	expected := map[string]string{
+		"api":                      "api",
+		"worker:template-worker-1": "worker-1",
+		"worker:template-worker-2": "worker-2",
+	}
+
+	if serviceIds := deployedTemplateServiceIds(before, after, templateServices); !reflect.DeepEqual(serviceIds, expected) {
+		t.Errorf("expected service ids %v, got %v", expected, serviceIds)
+	}
+}
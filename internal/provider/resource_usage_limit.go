@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &UsageLimitResource{}
+var _ resource.ResourceWithImportState = &UsageLimitResource{}
+var _ resource.ResourceWithConfigValidators = &UsageLimitResource{}
+
+func NewUsageLimitResource() resource.Resource {
+	return &UsageLimitResource{}
+}
+
+type UsageLimitResource struct {
+	client *graphql.Client
+}
+
+type UsageLimitResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	WorkspaceId       types.String `tfsdk:"workspace_id"`
+	CustomerId        types.String `tfsdk:"customer_id"`
+	AlertThresholdUSD types.Int64  `tfsdk:"alert_threshold_usd"`
+	HardLimitUSD      types.Int64  `tfsdk:"hard_limit_usd"`
+	KeepOnDestroy     types.Bool   `tfsdk:"keep_on_destroy"`
+}
+
+func (r *UsageLimitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_limit"
+}
+
+func (r *UsageLimitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway usage limit. Alerts when the monthly usage of a workspace crosses a threshold and optionally stops its services at a hard limit.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the usage limit.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace the usage limit applies to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"customer_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the billing customer of the workspace.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alert_threshold_usd": schema.Int64Attribute{
+				MarkdownDescription: "Monthly usage in US dollars above which Railway sends an alert.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"hard_limit_usd": schema.Int64Attribute{
+				MarkdownDescription: "Monthly usage in US dollars at which Railway stops the services of the workspace. Must be at least `alert_threshold_usd`. No hard limit when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"keep_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to leave the usage limit in place when the resource is destroyed, instead of removing it. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *UsageLimitResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		usageLimitThresholdValidator{},
+	}
+}
+
+type usageLimitThresholdValidator struct{}
+
+func (v usageLimitThresholdValidator) Description(ctx context.Context) string {
+	return "`alert_threshold_usd` must not be above `hard_limit_usd`"
+}
+
+func (v usageLimitThresholdValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v usageLimitThresholdValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UsageLimitResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AlertThresholdUSD.IsNull() || data.AlertThresholdUSD.IsUnknown() || data.HardLimitUSD.IsNull() || data.HardLimitUSD.IsUnknown() {
+		return
+	}
+
+	if data.AlertThresholdUSD.ValueInt64() > data.HardLimitUSD.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("alert_threshold_usd"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf(
+				"%s, got alert threshold of $%d and hard limit of $%d.",
+				v.Description(ctx), data.AlertThresholdUSD.ValueInt64(), data.HardLimitUSD.ValueInt64(),
+			),
+		)
+	}
+}
+
+func (r *UsageLimitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UsageLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *UsageLimitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getWorkspaceUsageLimit(ctx, *r.client, data.WorkspaceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
+		return
+	}
+
+	data.CustomerId = types.StringValue(response.Workspace.Customer.Id)
+
+	r.setUsageLimit(ctx, data, "create", &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsageLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *UsageLimitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := getUsageLimit(ctx, *r.client, data.WorkspaceId.ValueString(), data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read usage limit, got error: %s", err))
+		return
+	}
+
+	// Removed outside of terraform, let it be set again
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Not set after importing
+	if data.KeepOnDestroy.IsNull() {
+		data.KeepOnDestroy = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsageLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *UsageLimitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setUsageLimit(ctx, data, "update", &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsageLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *UsageLimitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.KeepOnDestroy.ValueBool() {
+		tflog.Trace(ctx, "keeping the usage limit on destroy")
+		return
+	}
+
+	_, err := removeUsageLimit(ctx, *r.client, UsageLimitRemoveInput{
+		CustomerId: data.CustomerId.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove usage limit, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "removed a usage limit")
+}
+
+func (r *UsageLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: workspace_id, a workspace has at most one usage limit
+	if !uuidRegex().MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: workspace_id, where workspace_id is a UUID. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("keep_on_destroy"), false)...)
+}
+
+func (r *UsageLimitResource) setUsageLimit(ctx context.Context, data *UsageLimitResourceModel, action string, diags *diag.Diagnostics) {
+	input := UsageLimitSetInput{
+		CustomerId:       data.CustomerId.ValueString(),
+		SoftLimitDollars: int(data.AlertThresholdUSD.ValueInt64()),
+	}
+
+	if !data.HardLimitUSD.IsNull() {
+		hardLimit := int(data.HardLimitUSD.ValueInt64())
+		input.HardLimitDollars = &hardLimit
+	}
+
+	_, err := setUsageLimit(ctx, *r.client, input)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s usage limit, got error: %s", action, err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("%sd a usage limit", action))
+
+	found, err := getUsageLimit(ctx, *r.client, data.WorkspaceId.ValueString(), data)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read usage limit after setting it, got error: %s", err))
+		return
+	}
+
+	if !found {
+		diags.AddError("Client Error", "Usage limit not found after setting it")
+	}
+}
+
+// getUsageLimit reads the usage limit of the workspace into data, reporting whether the workspace has one.
+func getUsageLimit(ctx context.Context, client graphql.Client, workspaceId string, data *UsageLimitResourceModel) (bool, error) {
+	response, err := getWorkspaceUsageLimit(ctx, client, workspaceId)
+
+	if err != nil {
+		return false, err
+	}
+
+	customer := response.Workspace.Customer
+
+	if customer.UsageLimit == nil {
+		return false, nil
+	}
+
+	data.Id = types.StringValue(customer.UsageLimit.Id)
+	data.WorkspaceId = types.StringValue(workspaceId)
+	data.CustomerId = types.StringValue(customer.Id)
+	data.AlertThresholdUSD = types.Int64Value(int64(customer.UsageLimit.SoftLimit))
+	data.HardLimitUSD = optionalInt64(customer.UsageLimit.HardLimit)
+
+	return true, nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsageLimitResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config:      testAccUsageLimitResourceConfigDefault(200, 100),
+				ExpectError: regexp.MustCompile("must not be above"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccUsageLimitResourceConfigDefault(50, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_usage_limit.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_usage_limit.test", "workspace_id", "ecb63be7-63fb-47fe-95fc-1585d24e172d"),
+					resource.TestCheckResourceAttr("railway_usage_limit.test", "alert_threshold_usd", "50"),
+					resource.TestCheckResourceAttr("railway_usage_limit.test", "hard_limit_usd", "100"),
+					resource.TestCheckResourceAttr("railway_usage_limit.test", "keep_on_destroy", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_usage_limit.test",
+				ImportState:       true,
+				ImportStateId:     "ecb63be7-63fb-47fe-95fc-1585d24e172d",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccUsageLimitResourceConfigDefault(75, 150),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_usage_limit.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_usage_limit.test", "alert_threshold_usd", "75"),
+					resource.TestCheckResourceAttr("railway_usage_limit.test", "hard_limit_usd", "150"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUsageLimitResourceConfigDefault(alert int, hard int) string {
+	return fmt.Sprintf(`
+resource "railway_usage_limit" "test" {
+  workspace_id = "ecb63be7-63fb-47fe-95fc-1585d24e172d"
+  alert_threshold_usd = %d
+  hard_limit_usd = %d
+}
+`, alert, hard)
+}
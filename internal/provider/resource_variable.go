@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -29,12 +32,13 @@ type VariableResource struct {
 }
 
 type VariableResourceModel struct {
-	Id            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Value         types.String `tfsdk:"value"`
-	EnvironmentId types.String `tfsdk:"environment_id"`
-	ServiceId     types.String `tfsdk:"service_id"`
-	ProjectId     types.String `tfsdk:"project_id"`
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Value          types.String `tfsdk:"value"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	ServiceId      types.String `tfsdk:"service_id"`
+	ProjectId      types.String `tfsdk:"project_id"`
+	TriggerRestart types.Bool   `tfsdk:"trigger_restart"`
 }
 
 func (r *VariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,10 +47,10 @@ func (r *VariableResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *VariableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Railway variable. Any changes in collection triggers service redeployment.",
+		MarkdownDescription: "Railway variable. Any change redeploys the service unless `trigger_restart` is `false`. Creating a variable that already exists takes it over and overwrites its value.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "Identifier of the variable.",
+				MarkdownDescription: "Identifier of the variable, in the format `environment_id:service_id:name`.",
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
@@ -60,7 +64,7 @@ func (r *VariableResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"value": schema.StringAttribute{
-				MarkdownDescription: "Value of the variable.",
+				MarkdownDescription: "Value of the variable. Railway references need a doubled `$` in HCL, so terraform doesn't read them as templates.",
 				Required:            true,
 				Sensitive:           true,
 			},
@@ -88,6 +92,12 @@ func (r *VariableResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "Identifier of the project the variable belongs to.",
 				Computed:            true,
 			},
+			"trigger_restart": schema.BoolAttribute{
+				MarkdownDescription: "Whether to redeploy the service after the variable changes so it uses the new value. Variables changed before a redeploy of the service started share that redeploy. Set it to `false` when deploys happen elsewhere, such as in CI. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 		},
 	}
 }
@@ -134,6 +144,7 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 		ServiceId:     data.ServiceId.ValueStringPointer(),
 		EnvironmentId: data.EnvironmentId.ValueString(),
 		ProjectId:     service.Service.ProjectId,
+		SkipDeploys:   true,
 	}
 
 	_, err = upsertVariable(ctx, *r.client, input)
@@ -143,16 +154,23 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	changedAt := time.Now()
+
 	tflog.Trace(ctx, "created a variable")
 
-	err = getVariable(ctx, *r.client, service.Service.ProjectId, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), data.Name.ValueString(), data)
+	found, err := getVariable(ctx, *r.client, service.Service.ProjectId, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), data.Name.ValueString(), data)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variable after creating it, got error: %s", err))
 		return
 	}
 
-	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+	if !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Variable %s not found after creating it", data.Name.ValueString()))
+		return
+	}
+
+	err = restartAfterVariableChange(ctx, r.client, data.TriggerRestart, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), changedAt, &resp.Diagnostics)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable created, got error: %s", err))
@@ -171,13 +189,23 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	err := getVariable(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), data.Name.ValueString(), data)
+	found, err := getVariable(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), data.Name.ValueString(), data)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variable, got error: %s", err))
 		return
 	}
 
+	// Deleted outside of terraform, let it be created again
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if data.TriggerRestart.IsNull() {
+		data.TriggerRestart = types.BoolValue(true)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -203,6 +231,7 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 		ServiceId:     data.ServiceId.ValueStringPointer(),
 		EnvironmentId: data.EnvironmentId.ValueString(),
 		ProjectId:     state.ProjectId.ValueString(),
+		SkipDeploys:   true,
 	}
 
 	_, err := upsertVariable(ctx, *r.client, input)
@@ -212,16 +241,23 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	changedAt := time.Now()
+
 	tflog.Trace(ctx, "updated a variable")
 
-	err = getVariable(ctx, *r.client, state.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), data.Name.ValueString(), data)
+	found, err := getVariable(ctx, *r.client, state.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), data.Name.ValueString(), data)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variable after updating it, got error: %s", err))
 		return
 	}
 
-	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+	if !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Variable %s not found after updating it", data.Name.ValueString()))
+		return
+	}
+
+	err = restartAfterVariableChange(ctx, r.client, data.TriggerRestart, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), changedAt, &resp.Diagnostics)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable updated, got error: %s", err))
@@ -254,10 +290,12 @@ func (r *VariableResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+	changedAt := time.Now()
+
+	err = restartAfterVariableChange(ctx, r.client, data.TriggerRestart, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), changedAt, &resp.Diagnostics)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable updated, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable deleted, got error: %s", err))
 		return
 	}
 
@@ -270,13 +308,22 @@ func (r *VariableResource) ImportState(ctx context.Context, req resource.ImportS
 	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: service_id:environment_name:name. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: environment_id:service_id:name or service_id:environment_name:name. Got: %q", req.ID),
 		)
 
 		return
 	}
 
-	service, err := getService(ctx, *r.client, parts[0])
+	// Either the id of the variable, environment_id:service_id:name, or the older service_id:environment_name:name.
+	// Environment names can't be told apart from ids otherwise, but they are never UUIDs.
+	byEnvironmentId := uuidRegex().MatchString(parts[1])
+	serviceId, environment, name := parts[0], parts[1], parts[2]
+
+	if byEnvironmentId {
+		environment, serviceId = parts[0], parts[1]
+	}
+
+	service, err := getService(ctx, *r.client, serviceId)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
@@ -284,34 +331,55 @@ func (r *VariableResource) ImportState(ctx context.Context, req resource.ImportS
 	}
 
 	projectId := service.Service.ProjectId
-	environmentId, err := findEnvironment(ctx, *r.client, projectId, parts[1])
+	environmentId := &environment
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
-		return
+	if !byEnvironmentId {
+		environmentId, err = findEnvironment(ctx, *r.client, projectId, environment)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+			return
+		}
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceId)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
 }
 
-func getVariable(ctx context.Context, client graphql.Client, projectId string, environmentId string, serviceId string, name string, data *VariableResourceModel) error {
+// getVariable reads the variable into data, reporting whether it exists.
+func getVariable(ctx context.Context, client graphql.Client, projectId string, environmentId string, serviceId string, name string, data *VariableResourceModel) (bool, error) {
 	response, err := getVariables(ctx, client, projectId, environmentId, serviceId)
 
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	if value, ok := response.Variables[name]; ok {
-		data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", serviceId, environmentId, name))
-		data.Name = types.StringValue(name)
-		data.Value = types.StringValue(fmt.Sprintf("%v", value))
-		data.ProjectId = types.StringValue(projectId)
-		data.EnvironmentId = types.StringValue(environmentId)
-		data.ServiceId = types.StringValue(serviceId)
+	value, ok := response.Variables[name]
+
+	if !ok {
+		return false, nil
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", environmentId, serviceId, name))
+	data.Name = types.StringValue(name)
+	data.Value = types.StringValue(fmt.Sprintf("%v", value))
+	data.ProjectId = types.StringValue(projectId)
+	data.EnvironmentId = types.StringValue(environmentId)
+	data.ServiceId = types.StringValue(serviceId)
+
+	return true, nil
+}
+
+// restartAfterVariableChange redeploys the service instance so it uses the variables changed at changedAt, unless
+// triggerRestart is false or a redeploy started after the change. A null triggerRestart comes from a state written
+// before the attribute existed, which always redeployed.
+func restartAfterVariableChange(ctx context.Context, client *graphql.Client, triggerRestart types.Bool, environmentId string, serviceId string, changedAt time.Time, diags *diag.Diagnostics) error {
+	if !triggerRestart.IsNull() && !triggerRestart.ValueBool() {
+		tflog.Trace(ctx, "skipping service instance redeploy, variable restarts are disabled")
+		return nil
 	}
 
-	return nil
+	return redeployServiceInstanceOnce(ctx, client, environmentId, serviceId, changedAt, diags)
 }
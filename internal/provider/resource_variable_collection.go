@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -45,11 +49,15 @@ var variableAttrTypes = map[string]attr.Type{
 }
 
 type VariableCollectionResourceModel struct {
-	Id            types.String `tfsdk:"id"`
-	Variables     types.List   `tfsdk:"variables"`
-	EnvironmentId types.String `tfsdk:"environment_id"`
-	ServiceId     types.String `tfsdk:"service_id"`
-	ProjectId     types.String `tfsdk:"project_id"`
+	Id             types.String `tfsdk:"id"`
+	Variables      types.List   `tfsdk:"variables"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	ServiceId      types.String `tfsdk:"service_id"`
+	ProjectId      types.String `tfsdk:"project_id"`
+	ManageAll      types.Bool   `tfsdk:"manage_all"`
+	IgnoreKeys     types.List   `tfsdk:"ignore_keys"`
+	Unmanaged      types.List   `tfsdk:"unmanaged_variables"`
+	TriggerRestart types.Bool   `tfsdk:"trigger_restart"`
 }
 
 func (r *VariableCollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +66,7 @@ func (r *VariableCollectionResource) Metadata(ctx context.Context, req resource.
 
 func (r *VariableCollectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Railway variable collection. Group of variables managed as a whole. Any changes in collection triggers service redeployment.",
+		MarkdownDescription: "Railway variable collection. Group of variables managed as a whole. Any change in the collection redeploys the service unless `trigger_restart` is `false`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Identifier of the variable collection.",
@@ -108,6 +116,32 @@ func (r *VariableCollectionResource) Schema(ctx context.Context, req resource.Sc
 				MarkdownDescription: "Identifier of the project the variable collection belongs to.",
 				Computed:            true,
 			},
+			"manage_all": schema.BoolAttribute{
+				MarkdownDescription: "Whether the collection manages all the variables of the service. Variables not in `variables` show up as drift in `unmanaged_variables` and are deleted on apply, except for `RAILWAY_*` variables and `ignore_keys`. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ignore_keys": schema.ListAttribute{
+				MarkdownDescription: "Names of the variables that `manage_all` never deletes, such as variables provided by plugins. A trailing `*` matches any name starting with the rest.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtLeast(1)),
+				},
+			},
+			"unmanaged_variables": schema.ListAttribute{
+				MarkdownDescription: "Names of the variables of the service found on refresh that are neither in `variables` nor ignored. Only read when `manage_all` is `true`, the plan always empties it and the apply deletes the names it lists.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"trigger_restart": schema.BoolAttribute{
+				MarkdownDescription: "Whether to redeploy the service after the collection changes so it uses the new values. Variables changed before a redeploy of the service started share that redeploy. Set it to `false` when deploys happen elsewhere, such as in CI. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 		},
 	}
 }
@@ -162,11 +196,13 @@ func (r *VariableCollectionResource) Create(ctx context.Context, req resource.Cr
 		variablesMap[v.Name.ValueString()] = v.Value.ValueString()
 	}
 
+	// The service is redeployed once below, after the whole collection is in place
 	input := VariableCollectionUpsertInput{
 		Variables:     variablesMap,
 		ServiceId:     data.ServiceId.ValueStringPointer(),
 		EnvironmentId: data.EnvironmentId.ValueString(),
 		ProjectId:     service.Service.ProjectId,
+		SkipDeploys:   true,
 	}
 
 	_, err = upsertVariableCollection(ctx, *r.client, input)
@@ -185,6 +221,11 @@ func (r *VariableCollectionResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	changedAt := time.Now()
+
+	// Unmanaged variables aren't in the plan, they are only deleted once a refresh has shown them
+	data.Unmanaged = types.ListValueMust(types.StringType, []attr.Value{})
+
 	err = getVariableCollection(ctx, *r.client, service.Service.ProjectId, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), variableNames, data)
 
 	if err != nil {
@@ -192,7 +233,7 @@ func (r *VariableCollectionResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+	err = restartAfterVariableChange(ctx, r.client, data.TriggerRestart, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), changedAt, &resp.Diagnostics)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable collection created, got error: %s", err))
@@ -225,6 +266,34 @@ func (r *VariableCollectionResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	if data.ManageAll.IsNull() {
+		data.ManageAll = types.BoolValue(false)
+	}
+
+	if data.TriggerRestart.IsNull() {
+		data.TriggerRestart = types.BoolValue(true)
+	}
+
+	data.Unmanaged = types.ListValueMust(types.StringType, []attr.Value{})
+
+	// Report the variables added outside of terraform, so the plan deletes them
+	if data.ManageAll.ValueBool() {
+		keys, diags := ignoreKeys(ctx, data)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := readUnmanagedVariables(ctx, *r.client, variableNames, keys, data)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read unmanaged variables, got error: %s", err))
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -257,6 +326,7 @@ func (r *VariableCollectionResource) Update(ctx context.Context, req resource.Up
 			EnvironmentId: data.EnvironmentId.ValueString(),
 			ProjectId:     state.ProjectId.ValueString(),
 			Variables:     variablesMapToUpsert,
+			SkipDeploys:   true,
 		}
 
 		_, err := upsertVariableCollection(ctx, *r.client, input)
@@ -274,6 +344,18 @@ func (r *VariableCollectionResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	if data.ManageAll.ValueBool() {
+		unmanaged, diags := getUnmanagedVariableNamesToDelete(ctx, data, state)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		variableNamesToDelete = append(variableNamesToDelete, unmanaged...)
+	}
+
 	if len(variableNamesToDelete) > 0 {
 		err := deleteManyVariables(ctx, *r.client, state.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), variableNamesToDelete)
 
@@ -292,6 +374,11 @@ func (r *VariableCollectionResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	changedAt := time.Now()
+
+	// Variables added since the last refresh show up on the next one
+	data.Unmanaged = types.ListValueMust(types.StringType, []attr.Value{})
+
 	err := getVariableCollection(ctx, *r.client, state.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), allVariableNames, data)
 
 	if err != nil {
@@ -299,7 +386,7 @@ func (r *VariableCollectionResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+	err = restartAfterVariableChange(ctx, r.client, data.TriggerRestart, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), changedAt, &resp.Diagnostics)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable collection updated, got error: %s", err))
@@ -332,7 +419,9 @@ func (r *VariableCollectionResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+	changedAt := time.Now()
+
+	err = restartAfterVariableChange(ctx, r.client, data.TriggerRestart, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), changedAt, &resp.Diagnostics)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable collection deleted, got error: %s", err))
@@ -544,3 +633,116 @@ func getVariableNamesToDelete(ctx context.Context, data, state *VariableCollecti
 
 	return variableNamesToDelete, nil
 }
+
+// railwayProvidedVariables matches the variables Railway provides to every service, they are never unmanaged.
+const railwayProvidedVariables = "RAILWAY_*"
+
+func ignoreKeys(ctx context.Context, data *VariableCollectionResourceModel) ([]string, diag.Diagnostics) {
+	keys := []string{railwayProvidedVariables}
+
+	if !data.IgnoreKeys.IsNull() {
+		var configured []string
+
+		diags := data.IgnoreKeys.ElementsAs(ctx, &configured, false)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		keys = append(keys, configured...)
+	}
+
+	return keys, nil
+}
+
+func isIgnoredVariable(name string, ignoreKeys []string) bool {
+	for _, key := range ignoreKeys {
+		if prefix, ok := strings.CutSuffix(key, "*"); ok {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if name == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getUnmanagedVariableNames returns the sorted names of the variables that are neither managed nor ignored.
+func getUnmanagedVariableNames(variables map[string]interface{}, managed []string, ignoreKeys []string) []string {
+	managedMap := make(map[string]bool, len(managed))
+
+	for _, name := range managed {
+		managedMap[name] = true
+	}
+
+	unmanaged := make([]string, 0)
+
+	for name := range variables {
+		if !managedMap[name] && !isIgnoredVariable(name, ignoreKeys) {
+			unmanaged = append(unmanaged, name)
+		}
+	}
+
+	sort.Strings(unmanaged)
+
+	return unmanaged
+}
+
+// readUnmanagedVariables sets the unmanaged variables of the service in data.
+func readUnmanagedVariables(ctx context.Context, client graphql.Client, managed []string, ignoreKeys []string, data *VariableCollectionResourceModel) error {
+	response, err := getVariables(ctx, client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	names := make([]attr.Value, 0)
+
+	for _, name := range getUnmanagedVariableNames(response.Variables, managed, ignoreKeys) {
+		names = append(names, types.StringValue(name))
+	}
+
+	data.Unmanaged = types.ListValueMust(types.StringType, names)
+
+	return nil
+}
+
+// getUnmanagedVariableNamesToDelete returns the unmanaged variables of the state that the plan still doesn't manage
+// nor ignore. Only names shown in the plan are deleted, variables added since the last refresh are left alone.
+func getUnmanagedVariableNamesToDelete(ctx context.Context, data, state *VariableCollectionResourceModel) ([]string, diag.Diagnostics) {
+	if state.Unmanaged.IsNull() || state.Unmanaged.IsUnknown() {
+		return nil, nil
+	}
+
+	keys, diags := ignoreKeys(ctx, data)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	managed, diags := getVariableNames(ctx, data)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var unmanaged []string
+
+	diags = state.Unmanaged.ElementsAs(ctx, &unmanaged, false)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	toDelete := make([]string, 0)
+
+	for _, name := range unmanaged {
+		if !slices.Contains(managed, name) && !isIgnoredVariable(name, keys) {
+			toDelete = append(toDelete, name)
+		}
+	}
+
+	return toDelete, nil
+}
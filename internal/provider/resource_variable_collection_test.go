@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -25,6 +29,9 @@ func TestAccVariableCollectionResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("railway_variable_collection.test", "variables.1.value", "two"),
 					resource.TestCheckResourceAttr("railway_variable_collection.test", "variables.2.name", "VALUE_C"),
 					resource.TestCheckResourceAttr("railway_variable_collection.test", "variables.2.value", "three"),
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "manage_all", "false"),
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "unmanaged_variables.#", "0"),
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "trigger_restart", "true"),
 				),
 			},
 			// ImportState testing
@@ -83,6 +90,167 @@ func TestAccVariableCollectionResourceDefault(t *testing.T) {
 	})
 }
 
+func TestVariableCollectionChanges(t *testing.T) {
+	ctx := context.Background()
+
+	collection := func(variables map[string]string) *VariableCollectionResourceModel {
+		values := make([]attr.Value, 0, len(variables))
+
+		for name, value := range variables {
+			values = append(values, types.ObjectValueMust(variableAttrTypes, map[string]attr.Value{
+				"name":  types.StringValue(name),
+				"value": types.StringValue(value),
+			}))
+		}
+
+		return &VariableCollectionResourceModel{
+			Variables: types.ListValueMust(types.ObjectType{AttrTypes: variableAttrTypes}, values),
+		}
+	}
+
+	state := collection(map[string]string{"A": "1", "B": "2", "C": "3"})
+	data := collection(map[string]string{"A": "1", "B": "changed", "D": "4"})
+
+	toUpsert, diags := getVariablesToUpsert(ctx, data, state)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expectedUpsert := map[string]interface{}{"B": "changed", "D": "4"}
+
+	if !reflect.DeepEqual(toUpsert, expectedUpsert) {
+		t.Fatalf("expected to upsert %v, got %v", expectedUpsert, toUpsert)
+	}
+
+	toDelete, diags := getVariableNamesToDelete(ctx, data, state)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !reflect.DeepEqual(toDelete, []string{"C"}) {
+		t.Fatalf("expected to delete [C], got %v", toDelete)
+	}
+}
+
+func TestGetUnmanagedVariableNames(t *testing.T) {
+	variables := map[string]interface{}{
+		"VALUE_A":              "1",
+		"VALUE_B":              "2",
+		"MANUAL":               "3",
+		"DATABASE_URL":         "postgres://",
+		"RAILWAY_PUBLIC_PORT":  "8080",
+		"PLUGIN_REDIS_URL":     "redis://",
+		"PLUGIN_REDIS_HOST":    "redis",
+		"PLUGINS_ARE_CONFUSED": "4",
+	}
+
+	unmanaged := getUnmanagedVariableNames(variables, []string{"VALUE_A", "VALUE_B"}, []string{railwayProvidedVariables, "DATABASE_URL", "PLUGIN_REDIS_*"})
+	expected := []string{"MANUAL", "PLUGINS_ARE_CONFUSED"}
+
+	if !reflect.DeepEqual(unmanaged, expected) {
+		t.Fatalf("expected unmanaged %v, got %v", expected, unmanaged)
+	}
+}
+
+func TestGetUnmanagedVariableNamesToDelete(t *testing.T) {
+	ctx := context.Background()
+
+	stringList := func(values ...string) types.List {
+		elements := make([]attr.Value, 0, len(values))
+
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+
+		return types.ListValueMust(types.StringType, elements)
+	}
+
+	variables := func(names ...string) types.List {
+		values := make([]attr.Value, 0, len(names))
+
+		for _, name := range names {
+			values = append(values, types.ObjectValueMust(variableAttrTypes, map[string]attr.Value{
+				"name":  types.StringValue(name),
+				"value": types.StringValue("value"),
+			}))
+		}
+
+		return types.ListValueMust(types.ObjectType{AttrTypes: variableAttrTypes}, values)
+	}
+
+	state := &VariableCollectionResourceModel{
+		Variables: variables("VALUE_A"),
+		Unmanaged: stringList("MANUAL", "PLUGIN_URL", "ADOPTED"),
+	}
+
+	testCases := map[string]struct {
+		data        *VariableCollectionResourceModel
+		expected    []string
+		expectError bool
+	}{
+		"unchanged plan": {
+			data:     &VariableCollectionResourceModel{Variables: variables("VALUE_A"), IgnoreKeys: types.ListNull(types.StringType)},
+			expected: []string{"MANUAL", "PLUGIN_URL", "ADOPTED"},
+		},
+		"ignored in the plan": {
+			data:     &VariableCollectionResourceModel{Variables: variables("VALUE_A"), IgnoreKeys: stringList("PLUGIN_*")},
+			expected: []string{"MANUAL", "ADOPTED"},
+		},
+		"managed in the plan": {
+			data:     &VariableCollectionResourceModel{Variables: variables("VALUE_A", "ADOPTED"), IgnoreKeys: types.ListNull(types.StringType)},
+			expected: []string{"MANUAL", "PLUGIN_URL"},
+		},
+		"invalid ignore keys": {
+			data:        &VariableCollectionResourceModel{Variables: variables("VALUE_A"), IgnoreKeys: types.ListValueMust(types.BoolType, []attr.Value{types.BoolValue(true)})},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			toDelete, diags := getUnmanagedVariableNamesToDelete(ctx, testCase.data, state)
+
+			if diags.HasError() != testCase.expectError {
+				t.Fatalf("expected error %t, got %v", testCase.expectError, diags)
+			}
+
+			if !testCase.expectError && !reflect.DeepEqual(toDelete, testCase.expected) {
+				t.Errorf("expected to delete %v, got %v", testCase.expected, toDelete)
+			}
+		})
+	}
+}
+
+func TestReadUnmanagedVariables(t *testing.T) {
+	client := newTestClient(t, func(operationName string) string {
+		if operationName != "getVariables" {
+			t.Errorf("unexpected operation: %s", operationName)
+			return `{}`
+		}
+
+		return `{"data": {"variables": {"VALUE_A": "1", "MANUAL": "2", "RAILWAY_PUBLIC_PORT": "8080"}}}`
+	})
+
+	data := &VariableCollectionResourceModel{Variables: types.ListNull(types.ObjectType{AttrTypes: variableAttrTypes})}
+
+	if err := readUnmanagedVariables(context.Background(), *client, []string{"VALUE_A"}, []string{railwayProvidedVariables}, data); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("MANUAL")})
+
+	if !data.Unmanaged.Equal(expected) {
+		t.Errorf("expected unmanaged %v, got %v", expected, data.Unmanaged)
+	}
+
+	// The managed variables are left as they are
+	if !data.Variables.IsNull() {
+		t.Errorf("expected variables to be untouched, got %v", data.Variables)
+	}
+}
+
 func testAccVariableCollectionResourceConfigDefault(valueA, valueB, valueC string) string {
 	return fmt.Sprintf(`
 resource "railway_variable_collection" "test" {
@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -16,26 +20,27 @@ func TestAccVariableResourceDefault(t *testing.T) {
 			{
 				Config: testAccVariableResourceConfigDefault("1234567890"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("railway_variable.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:REDIS_URL"),
+					resource.TestCheckResourceAttr("railway_variable.test", "id", "d0519b29-5d12-4857-a5dd-76fa7418336c:39da7e07-fa3a-42fd-b695-d229319f2993:REDIS_URL"),
 					resource.TestCheckResourceAttr("railway_variable.test", "name", "REDIS_URL"),
 					resource.TestCheckResourceAttr("railway_variable.test", "value", "1234567890"),
 					resource.TestCheckResourceAttr("railway_variable.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
 					resource.TestCheckResourceAttr("railway_variable.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
 					resource.TestCheckResourceAttr("railway_variable.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_variable.test", "trigger_restart", "true"),
 				),
 			},
 			// ImportState testing
 			{
 				ResourceName:      "railway_variable.test",
 				ImportState:       true,
-				ImportStateId:     "39da7e07-fa3a-42fd-b695-d229319f2993:staging:REDIS_URL",
+				ImportStateId:     "d0519b29-5d12-4857-a5dd-76fa7418336c:39da7e07-fa3a-42fd-b695-d229319f2993:REDIS_URL",
 				ImportStateVerify: true,
 			},
 			// Update with default values
 			{
 				Config: testAccVariableResourceConfigDefault("1234567890"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("railway_variable.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:REDIS_URL"),
+					resource.TestCheckResourceAttr("railway_variable.test", "id", "d0519b29-5d12-4857-a5dd-76fa7418336c:39da7e07-fa3a-42fd-b695-d229319f2993:REDIS_URL"),
 					resource.TestCheckResourceAttr("railway_variable.test", "name", "REDIS_URL"),
 					resource.TestCheckResourceAttr("railway_variable.test", "value", "1234567890"),
 					resource.TestCheckResourceAttr("railway_variable.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
@@ -47,7 +52,7 @@ func TestAccVariableResourceDefault(t *testing.T) {
 			{
 				Config: testAccVariableResourceConfigDefault("$${{redis.REDIS_URL}}"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("railway_variable.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:REDIS_URL"),
+					resource.TestCheckResourceAttr("railway_variable.test", "id", "d0519b29-5d12-4857-a5dd-76fa7418336c:39da7e07-fa3a-42fd-b695-d229319f2993:REDIS_URL"),
 					resource.TestCheckResourceAttr("railway_variable.test", "name", "REDIS_URL"),
 					resource.TestCheckResourceAttr("railway_variable.test", "value", "${{redis.REDIS_URL}}"),
 					resource.TestCheckResourceAttr("railway_variable.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
@@ -77,3 +82,67 @@ resource "railway_variable" "test" {
 }
 `, value)
 }
+
+func TestRestartAfterVariableChange(t *testing.T) {
+	testCases := map[string]struct {
+		triggerRestart  []types.Bool
+		changes         []bool
+		expectRedeploys int
+	}{
+		"coalesced": {
+			triggerRestart:  []types.Bool{types.BoolValue(true), types.BoolValue(true), types.BoolValue(true)},
+			changes:         []bool{true, false, false},
+			expectRedeploys: 1,
+		},
+		"changed between restarts": {
+			triggerRestart:  []types.Bool{types.BoolValue(true), types.BoolValue(true), types.BoolValue(true)},
+			changes:         []bool{true, false, true},
+			expectRedeploys: 2,
+		},
+		"disabled": {
+			triggerRestart:  []types.Bool{types.BoolValue(false), types.BoolValue(false)},
+			changes:         []bool{true, true},
+			expectRedeploys: 0,
+		},
+		"state without attribute": {
+			triggerRestart:  []types.Bool{types.BoolNull()},
+			changes:         []bool{true},
+			expectRedeploys: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			redeploys := 0
+
+			client := newTestClient(t, func(operationName string) string {
+				if operationName != "redeployServiceInstanceWithEnv" {
+					t.Errorf("unexpected operation: %s", operationName)
+				}
+
+				redeploys++
+
+				return `{"data": {"serviceInstanceRedeploy": true}}`
+			})
+
+			var changedAt time.Time
+			var diags diag.Diagnostics
+
+			for i, triggerRestart := range testCase.triggerRestart {
+				if testCase.changes[i] {
+					changedAt = time.Now()
+				}
+
+				err := restartAfterVariableChange(context.Background(), client, triggerRestart, "d0519b29-5d12-4857-a5dd-76fa7418336c", "39da7e07-fa3a-42fd-b695-d229319f2993", changedAt, &diags)
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			if redeploys != testCase.expectRedeploys {
+				t.Errorf("expected %d redeploys, got %d", testCase.expectRedeploys, redeploys)
+			}
+		})
+	}
+}
@@ -0,0 +1,409 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &VolumeBackupResource{}
+var _ resource.ResourceWithImportState = &VolumeBackupResource{}
+
+func NewVolumeBackupResource() resource.Resource {
+	return &VolumeBackupResource{}
+}
+
+type VolumeBackupResource struct {
+	client *graphql.Client
+}
+
+type VolumeBackupResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	VolumeInstanceId types.String `tfsdk:"volume_instance_id"`
+	Name             types.String `tfsdk:"name"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	UsedMB           types.Int64  `tfsdk:"used_mb"`
+	RestoreTrigger   types.String `tfsdk:"restore_trigger"`
+}
+
+func (r *VolumeBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_backup"
+}
+
+func (r *VolumeBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway volume backup. Takes a backup of a volume instance when created and deletes it when destroyed. The volume instance can be restored from it with `restore_trigger`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the backup.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume_instance_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the volume instance to back up.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the backup.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Time the backup was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Time Railway deletes the backup, in RFC 3339 format. Null when it doesn't expire.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"used_mb": schema.Int64Attribute{
+				MarkdownDescription: "Size of the backup in megabytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"restore_trigger": schema.StringAttribute{
+				MarkdownDescription: "Changing this to a new value restores the volume instance from the backup, replacing its data. Setting it when the backup is created doesn't restore.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *VolumeBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VolumeBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *VolumeBackupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeInstanceId := data.VolumeInstanceId.ValueString()
+
+	// The create mutation doesn't return the backup, so it is found by comparing the backups before and after
+	existingBackups, err := listVolumeInstanceBackups(ctx, *r.client, volumeInstanceId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume backups, got error: %s", err))
+		return
+	}
+
+	response, err := createVolumeInstanceBackup(ctx, *r.client, volumeInstanceId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create volume backup, got error: %s", err))
+		return
+	}
+
+	if workflowId := response.VolumeInstanceBackupCreate.WorkflowId; workflowId != nil {
+		if err := waitForWorkflow(ctx, *r.client, *workflowId, volumeBackupTimeout); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for the volume backup, got error: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "created a volume backup")
+
+	backupsResponse, err := listVolumeInstanceBackups(ctx, *r.client, volumeInstanceId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume backups, got error: %s", err))
+		return
+	}
+
+	backup := newestVolumeBackup(existingBackups.VolumeInstanceBackupList, backupsResponse.VolumeInstanceBackupList)
+
+	if backup == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to find the volume backup after creating it")
+		return
+	}
+
+	setVolumeBackup(data, backup)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *VolumeBackupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := findVolumeBackup(ctx, *r.client, data.VolumeInstanceId.ValueString(), data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume backup, got error: %s", err))
+		return
+	}
+
+	// Expired or deleted outside of terraform, let it be created again
+	if backup == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setVolumeBackup(data, backup)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *VolumeBackupResourceModel
+	var state *VolumeBackupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only restore_trigger can change, everything else requires replacement
+	if !data.RestoreTrigger.IsNull() && !data.RestoreTrigger.Equal(state.RestoreTrigger) {
+		err := restoreVolumeBackup(ctx, *r.client, data.VolumeInstanceId.ValueString(), data.Id.ValueString(), volumeBackupTimeout)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Volume Restore Failed",
+				fmt.Sprintf("Unable to restore volume instance %s from backup %s, got error: %s\n\nThe volume instance may be partially restored, check it in Railway before applying again.", data.VolumeInstanceId.ValueString(), data.Id.ValueString(), err),
+			)
+
+			return
+		}
+
+		tflog.Trace(ctx, "restored a volume backup")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *VolumeBackupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := deleteVolumeInstanceBackup(ctx, *r.client, data.VolumeInstanceId.ValueString(), data.Id.ValueString())
+
+	if err != nil {
+		// Expired in the meantime
+		if isNotFoundError(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete volume backup, got error: %s", err))
+		return
+	}
+
+	if workflowId := response.VolumeInstanceBackupDelete.WorkflowId; workflowId != nil {
+		if err := waitForWorkflow(ctx, *r.client, *workflowId, volumeBackupTimeout); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for the volume backup deletion, got error: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted a volume backup")
+}
+
+func (r *VolumeBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: volume_instance_id:backup_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume_instance_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+var (
+	volumeBackupTimeout       = 30 * time.Minute
+	volumeRestoreReadyTimeout = 10 * time.Minute
+)
+
+// restoreVolumeBackup restores the volume instance from the backup and waits until the volume instance is ready
+// again, so later resources don't use it while it is still being restored.
+func restoreVolumeBackup(ctx context.Context, client graphql.Client, volumeInstanceId string, backupId string, timeout time.Duration) error {
+	// Restoring from a backup that has expired would fail after the volume instance was already touched
+	backup, err := findVolumeBackup(ctx, client, volumeInstanceId, backupId)
+
+	if err != nil {
+		return err
+	}
+
+	if backup == nil {
+		return fmt.Errorf("backup doesn't exist anymore")
+	}
+
+	response, err := restoreVolumeInstanceBackup(ctx, client, volumeInstanceId, backupId)
+
+	if err != nil {
+		return err
+	}
+
+	if workflowId := response.VolumeInstanceBackupRestore.WorkflowId; workflowId != nil {
+		if err := waitForWorkflow(ctx, client, *workflowId, timeout); err != nil {
+			return err
+		}
+	}
+
+	return waitForVolumeInstanceReady(ctx, client, volumeInstanceId, volumeRestoreReadyTimeout)
+}
+
+// waitForVolumeInstanceReady polls the volume instance until it leaves the transitional states.
+func waitForVolumeInstanceReady(ctx context.Context, client graphql.Client, volumeInstanceId string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		response, err := getVolumeInstanceState(ctx, client, volumeInstanceId)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return err
+		}
+
+		state := response.VolumeInstance.State
+
+		if state == nil || *state == VolumeStateReady {
+			return nil
+		}
+
+		switch *state {
+		case VolumeStateError, VolumeStateDeleted, VolumeStateDeleting:
+			return fmt.Errorf("volume instance is %s after the restore", *state)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("volume instance is still %s: %w", *state, ctx.Err())
+		case <-time.After(workflowInterval):
+		}
+	}
+}
+
+func findVolumeBackup(ctx context.Context, client graphql.Client, volumeInstanceId string, backupId string) (*VolumeInstanceBackup, error) {
+	response, err := listVolumeInstanceBackups(ctx, client, volumeInstanceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, backup := range response.VolumeInstanceBackupList {
+		if backup.Id == backupId {
+			return &backup.VolumeInstanceBackup, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// newestVolumeBackup returns the newest backup that is not in before, or nil when there is none.
+func newestVolumeBackup(before []listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup, after []listVolumeInstanceBackupsVolumeInstanceBackupListVolumeInstanceBackup) *VolumeInstanceBackup {
+	existing := map[string]bool{}
+
+	for _, backup := range before {
+		existing[backup.Id] = true
+	}
+
+	var newest *VolumeInstanceBackup
+
+	for i := range after {
+		backup := &after[i].VolumeInstanceBackup
+
+		if existing[backup.Id] {
+			continue
+		}
+
+		if newest == nil || backup.CreatedAt.After(newest.CreatedAt) {
+			newest = backup
+		}
+	}
+
+	return newest
+}
+
+func setVolumeBackup(data *VolumeBackupResourceModel, backup *VolumeInstanceBackup) {
+	data.Id = types.StringValue(backup.Id)
+	data.CreatedAt = types.StringValue(backup.CreatedAt.Format(time.RFC3339))
+	data.UsedMB = optionalInt64(backup.UsedMB)
+	data.Name = types.StringNull()
+	data.ExpiresAt = types.StringNull()
+
+	if backup.Name != nil {
+		data.Name = optionalString(*backup.Name)
+	}
+
+	if backup.ExpiresAt != nil {
+		data.ExpiresAt = types.StringValue(backup.ExpiresAt.Format(time.RFC3339))
+	}
+}
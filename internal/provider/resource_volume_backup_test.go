@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccVolumeBackupResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVolumeBackupResourceConfigDefault("null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_volume_backup.test", "id"),
+					resource.TestCheckResourceAttrPair("railway_volume_backup.test", "volume_instance_id", "railway_volume_instance.test", "id"),
+					resource.TestCheckResourceAttrSet("railway_volume_backup.test", "created_at"),
+					resource.TestCheckNoResourceAttr("railway_volume_backup.test", "restore_trigger"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_volume_backup.test",
+				ImportState:       true,
+				ImportStateIdFunc: volumeBackupImportIdFunc,
+				ImportStateVerify: true,
+			},
+			// Restore testing
+			{
+				Config: testAccVolumeBackupResourceConfigDefault(`"first"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_volume_backup.test", "id"),
+					resource.TestCheckResourceAttr("railway_volume_backup.test", "restore_trigger", "first"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func volumeBackupImportIdFunc(state *terraform.State) (string, error) {
+	rawState, ok := state.RootModule().Resources["railway_volume_backup.test"]
+
+	if !ok {
+		return "", fmt.Errorf("Resource Not found")
+	}
+
+	return fmt.Sprintf("%s:%s", rawState.Primary.Attributes["volume_instance_id"], rawState.Primary.Attributes["id"]), nil
+}
+
+func testAccVolumeBackupResourceConfigDefault(restoreTrigger string) string {
+	return fmt.Sprintf(`
+resource "railway_service" "test" {
+  name = "todo-app-backup"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+
+  volume = {
+    name = "todo-backup-data"
+    mount_path = "/data"
+  }
+}
+
+resource "railway_volume_instance" "test" {
+  volume_id = railway_service.test.volume.id
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  redeploy = false
+}
+
+resource "railway_volume_backup" "test" {
+  volume_instance_id = railway_volume_instance.test.id
+  restore_trigger = %s
+}
+`, restoreTrigger)
+}
+
+func TestRestoreVolumeBackup(t *testing.T) {
+	interval := workflowInterval
+	workflowInterval = 10 * time.Millisecond
+	t.Cleanup(func() { workflowInterval = interval })
+
+	testCases := map[string]struct {
+		backups     string
+		workflow    string
+		states      []string
+		expectError string
+		expectCalls []string
+	}{
+		"restored": {
+			backups:     `[{"id": "backup-1", "createdAt": "2026-01-02T03:04:05Z"}]`,
+			workflow:    "Complete",
+			states:      []string{"RESTORING", "RESTORING", "READY"},
+			expectCalls: []string{"listVolumeInstanceBackups", "restoreVolumeInstanceBackup", "getWorkflowStatus", "getVolumeInstanceState", "getVolumeInstanceState", "getVolumeInstanceState"},
+		},
+		"backup expired": {
+			backups:     `[]`,
+			expectError: "backup doesn't exist anymore",
+			expectCalls: []string{"listVolumeInstanceBackups"},
+		},
+		"workflow failed": {
+			backups:     `[{"id": "backup-1", "createdAt": "2026-01-02T03:04:05Z"}]`,
+			workflow:    "Error",
+			expectError: "workflow failed",
+			expectCalls: []string{"listVolumeInstanceBackups", "restoreVolumeInstanceBackup", "getWorkflowStatus"},
+		},
+		"volume instance errored": {
+			backups:     `[{"id": "backup-1", "createdAt": "2026-01-02T03:04:05Z"}]`,
+			workflow:    "Complete",
+			states:      []string{"RESTORING", "ERROR"},
+			expectError: "volume instance is ERROR",
+			expectCalls: []string{"listVolumeInstanceBackups", "restoreVolumeInstanceBackup", "getWorkflowStatus", "getVolumeInstanceState", "getVolumeInstanceState"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			var stateIndex int32
+
+			client := newTestClient(t, func(operationName string) string {
+				calls = append(calls, operationName)
+
+				switch operationName {
+				case "listVolumeInstanceBackups":
+					return fmt.Sprintf(`{"data": {"volumeInstanceBackupList": %s}}`, testCase.backups)
+				case "restoreVolumeInstanceBackup":
+					return `{"data": {"volumeInstanceBackupRestore": {"workflowId": "workflow-1"}}}`
+				case "getWorkflowStatus":
+					return fmt.Sprintf(`{"data": {"workflowStatus": {"status": "%s", "error": null}}}`, testCase.workflow)
+				case "getVolumeInstanceState":
+					state := testCase.states[atomic.AddInt32(&stateIndex, 1)-1]
+					return fmt.Sprintf(`{"data": {"volumeInstance": {"id": "instance-1", "state": "%s"}}}`, state)
+				default:
+					t.Errorf("unexpected operation: %s", operationName)
+					return `{}`
+				}
+			})
+
+			err := restoreVolumeBackup(context.Background(), *client, "instance-1", "backup-1", time.Minute)
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if strings.Join(calls, ",") != strings.Join(testCase.expectCalls, ",") {
+				t.Errorf("expected calls %v, got %v", testCase.expectCalls, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &VolumeInstanceResource{}
+var _ resource.ResourceWithImportState = &VolumeInstanceResource{}
+var _ resource.ResourceWithModifyPlan = &VolumeInstanceResource{}
+
+func NewVolumeInstanceResource() resource.Resource {
+	return &VolumeInstanceResource{}
+}
+
+type VolumeInstanceResource struct {
+	client *graphql.Client
+}
+
+type VolumeInstanceResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	VolumeId      types.String `tfsdk:"volume_id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	MountPath     types.String `tfsdk:"mount_path"`
+	SizeMB        types.Int64  `tfsdk:"size_mb"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	Redeploy      types.Bool   `tfsdk:"redeploy"`
+}
+
+func (r *VolumeInstanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_instance"
+}
+
+func (r *VolumeInstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway volume instance. Settings of a volume in one environment. The instance is created and deleted together with its volume, so destroying this resource only stops managing it. Don't use it for a volume managed by the `volume` block of `railway_service`, both would manage its mount path.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the volume instance.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the volume.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment of the volume instance.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"mount_path": schema.StringAttribute{
+				MarkdownDescription: "Path the volume is mounted at in the service. Changes take effect when the service is redeployed. Defaults to the current mount path.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must be an absolute path"),
+				},
+			},
+			"size_mb": schema.Int64Attribute{
+				MarkdownDescription: "Size of the volume in MB. Railway doesn't allow resizing volumes through its API.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the volume is attached to, if any.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redeploy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to redeploy the attached service after changing the mount path, so the change takes effect. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *VolumeInstanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VolumeInstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare against on create and nothing to plan on destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *VolumeInstanceResourceModel
+	var state *VolumeInstanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.MountPath.IsUnknown() || data.MountPath.Equal(state.MountPath) || state.ServiceId.IsNull() {
+		return
+	}
+
+	if data.Redeploy.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Service Will Be Redeployed",
+			fmt.Sprintf("Changing the mount path of the volume only takes effect on a new deployment, so applying this plan will redeploy service %s.", state.ServiceId.ValueString()),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Service Needs To Be Redeployed",
+		fmt.Sprintf("Changing the mount path of the volume only takes effect on a new deployment and `redeploy` is disabled, so service %s keeps using %s until it is redeployed.", state.ServiceId.ValueString(), state.MountPath.ValueString()),
+	)
+}
+
+func (r *VolumeInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *VolumeInstanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := findVolumeInstance(ctx, *r.client, data.VolumeId.ValueString(), data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume instance, got error: %s", err))
+		return
+	}
+
+	if instance == nil {
+		resp.Diagnostics.AddError(
+			"Volume Instance Not Found",
+			fmt.Sprintf("Volume %s has no instance in environment %s.", data.VolumeId.ValueString(), data.EnvironmentId.ValueString()),
+		)
+		return
+	}
+
+	mountPathChanged := !data.MountPath.IsUnknown() && data.MountPath.ValueString() != instance.MountPath
+
+	r.apply(ctx, data, instance, mountPathChanged, &resp.State, &resp.Diagnostics)
+}
+
+func (r *VolumeInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *VolumeInstanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := findVolumeInstance(ctx, *r.client, data.VolumeId.ValueString(), data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume instance, got error: %s", err))
+		return
+	}
+
+	// The volume was deleted outside of terraform
+	if instance == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setVolumeInstance(data, instance)
+
+	// Not set after importing
+	if data.Redeploy.IsNull() {
+		data.Redeploy = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VolumeInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *VolumeInstanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := findVolumeInstance(ctx, *r.client, data.VolumeId.ValueString(), data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read volume instance, got error: %s", err))
+		return
+	}
+
+	if instance == nil {
+		resp.Diagnostics.AddError(
+			"Volume Instance Not Found",
+			fmt.Sprintf("Volume %s has no instance in environment %s.", data.VolumeId.ValueString(), data.EnvironmentId.ValueString()),
+		)
+		return
+	}
+
+	r.apply(ctx, data, instance, data.MountPath.ValueString() != instance.MountPath, &resp.State, &resp.Diagnostics)
+}
+
+func (r *VolumeInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Volume instances cannot be deleted - they exist as long as the volume exists
+	tflog.Trace(ctx, "volume instance delete is a no-op - instances are managed by the parent volume")
+}
+
+func (r *VolumeInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || !uuidRegex().MatchString(parts[0]) || !uuidRegex().MatchString(parts[1]) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: volume_id:environment_id, where both are UUIDs. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[1])...)
+}
+
+// apply moves the volume instance to the planned mount path, tracks it and redeploys the attached service if enabled.
+func (r *VolumeInstanceResource) apply(ctx context.Context, data *VolumeInstanceResourceModel, instance *VolumeInstance, mountPathChanged bool, state *tfsdk.State, diags *diag.Diagnostics) {
+	var changedAt time.Time
+
+	if mountPathChanged {
+		// The service is always sent since leaving it out detaches the volume
+		_, err := updateEnvironmentVolumeInstance(ctx, *r.client, data.VolumeId.ValueString(), data.EnvironmentId.ValueString(), VolumeInstanceUpdateInput{
+			MountPath: data.MountPath.ValueString(),
+			ServiceId: instance.ServiceId,
+		})
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update volume instance, got error: %s", err))
+			return
+		}
+
+		changedAt = time.Now()
+
+		tflog.Trace(ctx, "updated a volume instance")
+
+		instance, err = findVolumeInstance(ctx, *r.client, data.VolumeId.ValueString(), data.EnvironmentId.ValueString())
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read volume instance after updating it, got error: %s", err))
+			return
+		}
+
+		if instance == nil {
+			diags.AddError("Client Error", fmt.Sprintf("Volume %s has no instance in environment %s after updating it", data.VolumeId.ValueString(), data.EnvironmentId.ValueString()))
+			return
+		}
+	}
+
+	setVolumeInstance(data, instance)
+
+	// Track the instance before redeploying so a failed redeploy doesn't lose the applied update
+	diags.Append(state.Set(ctx, &data)...)
+
+	if diags.HasError() || !mountPathChanged || instance.ServiceId == nil || !data.Redeploy.ValueBool() {
+		return
+	}
+
+	err := redeployServiceInstanceOnce(ctx, r.client, data.EnvironmentId.ValueString(), *instance.ServiceId, changedAt, diags)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after volume instance updated, got error: %s", err))
+	}
+}
+
+func setVolumeInstance(data *VolumeInstanceResourceModel, instance *VolumeInstance) {
+	data.Id = types.StringValue(instance.Id)
+	data.MountPath = types.StringValue(instance.MountPath)
+	data.SizeMB = types.Int64Value(int64(instance.SizeMB))
+	data.ServiceId = types.StringPointerValue(instance.ServiceId)
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccVolumeInstanceResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVolumeInstanceResourceConfigDefault("/data"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_volume_instance.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_volume_instance.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_volume_instance.test", "mount_path", "/data"),
+					resource.TestCheckResourceAttrSet("railway_volume_instance.test", "size_mb"),
+					resource.TestCheckResourceAttrPair("railway_volume_instance.test", "service_id", "railway_service.test", "id"),
+					resource.TestCheckResourceAttr("railway_volume_instance.test", "redeploy", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "railway_volume_instance.test",
+				ImportState:             true,
+				ImportStateIdFunc:       volumeInstanceImportIdFunc,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"redeploy"},
+			},
+			{
+				ResourceName:  "railway_volume_instance.test",
+				ImportState:   true,
+				ImportStateId: "d0519b29-5d12-4857-a5dd-76fa7418336c",
+				ExpectError:   regexp.MustCompile("Expected import identifier with format: volume_id:environment_id"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccVolumeInstanceResourceConfigDefault("/mnt/data"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_volume_instance.test", "mount_path", "/mnt/data"),
+					resource.TestCheckResourceAttrPair("railway_volume_instance.test", "service_id", "railway_service.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func volumeInstanceImportIdFunc(state *terraform.State) (string, error) {
+	rawState, ok := state.RootModule().Resources["railway_volume_instance.test"]
+
+	if !ok {
+		return "", fmt.Errorf("Resource Not found")
+	}
+
+	return fmt.Sprintf("%s:%s", rawState.Primary.Attributes["volume_id"], rawState.Primary.Attributes["environment_id"]), nil
+}
+
+func testAccVolumeInstanceResourceConfigDefault(mountPath string) string {
+	return fmt.Sprintf(`
+resource "railway_service" "test" {
+  name = "todo-app-volume"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+
+  volume = {
+    name = "todo-data"
+    mount_path = "/data"
+  }
+
+  # There is no resource for a volume on its own, so leave the mount path to the volume instance
+  lifecycle {
+    ignore_changes = [volume]
+  }
+}
+
+resource "railway_volume_instance" "test" {
+  volume_id = railway_service.test.volume.id
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  mount_path = "%s"
+  redeploy = false
+}
+`, mountPath)
+}
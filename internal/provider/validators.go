@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Railway allocates service resources in fixed increments.
+const (
+	vcpuIncrement     = 0.25
+	memoryGBIncrement = 0.25
+	memoryMBIncrement = 256
+)
+
+var _ validator.Float64 = float64IncrementValidator{}
+
+// float64IncrementValidator validates that a float64 attribute is a multiple of the given increment.
+type float64IncrementValidator struct {
+	increment float64
+	unit      string
+}
+
+func (v float64IncrementValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a multiple of %s %s", formatFloat64(v.increment), v.unit)
+}
+
+func (v float64IncrementValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v float64IncrementValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+	steps := value / v.increment
+
+	// Allow for floating point error in values like 0.1 + 0.2
+	if math.Abs(steps-math.Round(steps)) < 1e-9 {
+		return
+	}
+
+	lower := math.Floor(steps) * v.increment
+	upper := math.Ceil(steps) * v.increment
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf(
+			"Attribute %s must be a multiple of %s %s, got: %s. The nearest valid values are %s and %s.",
+			req.Path, formatFloat64(v.increment), v.unit, formatFloat64(value), formatFloat64(lower), formatFloat64(upper),
+		),
+	)
+}
+
+// vcpuIncrementValidator validates that a vCPU allocation matches Railway's vCPU increments.
+func vcpuIncrementValidator() validator.Float64 {
+	return float64IncrementValidator{
+		increment: vcpuIncrement,
+		unit:      "vCPU",
+	}
+}
+
+// memoryGBIncrementValidator validates that a memory allocation in GB matches Railway's memory increments.
+func memoryGBIncrementValidator() validator.Float64 {
+	return float64IncrementValidator{
+		increment: memoryGBIncrement,
+		unit:      "GB",
+	}
+}
+
+var _ validator.Int64 = int64IncrementValidator{}
+
+// int64IncrementValidator validates that an int64 attribute is a multiple of the given increment.
+type int64IncrementValidator struct {
+	increment int64
+	unit      string
+}
+
+func (v int64IncrementValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a multiple of %d %s", v.increment, v.unit)
+}
+
+func (v int64IncrementValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64IncrementValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+
+	if value%v.increment == 0 {
+		return
+	}
+
+	lower := value / v.increment * v.increment
+	upper := lower + v.increment
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf(
+			"Attribute %s must be a multiple of %d %s, got: %d. The nearest valid values are %d and %d.",
+			req.Path, v.increment, v.unit, value, lower, upper,
+		),
+	)
+}
+
+// memoryMBIncrementValidator validates that a memory allocation in MB matches Railway's memory increments.
+func memoryMBIncrementValidator() validator.Int64 {
+	return int64IncrementValidator{
+		increment: memoryMBIncrement,
+		unit:      "MB",
+	}
+}
+
+var _ validator.String = dnsLabelStringValidator{}
+
+var (
+	dnsLabelRegex        = regexp.MustCompile("^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$")
+	dnsLabelInvalidRegex = regexp.MustCompile("[^a-z0-9]+")
+)
+
+// dnsLabelStringValidator validates that a string attribute is a valid RFC 1123 DNS label.
+type dnsLabelStringValidator struct{}
+
+func (v dnsLabelStringValidator) Description(ctx context.Context) string {
+	return "value must be a DNS label of at most 63 lowercase alphanumeric characters or hyphens, starting and ending with an alphanumeric character"
+}
+
+func (v dnsLabelStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dnsLabelStringValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if dnsLabelRegex.MatchString(value) {
+		return
+	}
+
+	detail := fmt.Sprintf("Attribute %s %s, got: %q.", req.Path, v.Description(ctx), value)
+
+	if suggestion := dnsLabelSuggestion(value); suggestion != "" {
+		detail += fmt.Sprintf(" Consider using %q instead.", suggestion)
+	}
+
+	resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", detail)
+}
+
+// dnsLabelValidator validates that an attribute used in DNS names is a valid DNS label.
+func dnsLabelValidator() validator.String {
+	return dnsLabelStringValidator{}
+}
+
+// dnsLabelSuggestion transforms the value into the closest valid DNS label, or returns an empty string if
+// nothing of it can be used.
+func dnsLabelSuggestion(value string) string {
+	label := dnsLabelInvalidRegex.ReplaceAllString(strings.ToLower(value), "-")
+	label = strings.Trim(label, "-")
+
+	if len(label) > 63 {
+		label = strings.TrimRight(label[:63], "-")
+	}
+
+	return label
+}
+
+func formatFloat64(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+var _ validator.String = imageReferenceStringValidator{}
+
+// Grammar of docker image references, as in github.com/distribution/reference.
+var (
+	imageDomainRegex    = regexp.MustCompile(`^(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9])(?:\.(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]))*(?::[0-9]+)?$`)
+	imageComponentRegex = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|[-]+)[a-z0-9]+)*$`)
+	imageTagRegex       = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+	imageDigestRegex    = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,}$`)
+)
+
+// Docker Hub is the registry of references without one.
+const defaultImageRegistry = "docker.io"
+
+// imageReference is a parsed docker image reference. Tag and digest are empty when the reference has none.
+type imageReference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// parseImageReference parses a docker image reference such as `ghcr.io/org/app:v1` or `postgres@sha256:...`. Like
+// docker, references without a registry are on Docker Hub, where single component repositories are in `library`.
+func parseImageReference(ref string) (imageReference, error) {
+	var image imageReference
+
+	name := ref
+
+	if index := strings.Index(name, "@"); index >= 0 {
+		name, image.Digest = name[:index], name[index+1:]
+
+		if !imageDigestRegex.MatchString(image.Digest) {
+			return image, fmt.Errorf("invalid digest %q", image.Digest)
+		}
+	}
+
+	// A colon after the last slash starts the tag, an earlier one is the port of the registry
+	if index := strings.LastIndex(name, ":"); index > strings.LastIndex(name, "/") {
+		name, image.Tag = name[:index], name[index+1:]
+
+		if !imageTagRegex.MatchString(image.Tag) {
+			return image, fmt.Errorf("invalid tag %q", image.Tag)
+		}
+	}
+
+	image.Registry = defaultImageRegistry
+	image.Repository = name
+
+	if registry, repository, ok := strings.Cut(name, "/"); ok && (strings.ContainsAny(registry, ".:") || registry == "localhost") {
+		if !imageDomainRegex.MatchString(registry) {
+			return image, fmt.Errorf("invalid registry %q", registry)
+		}
+
+		image.Registry = registry
+		image.Repository = repository
+	}
+
+	if image.Repository == "" || len(image.Repository) > 255 {
+		return image, fmt.Errorf("invalid repository %q, it must be between 1 and 255 characters", image.Repository)
+	}
+
+	for _, component := range strings.Split(image.Repository, "/") {
+		if !imageComponentRegex.MatchString(component) {
+			return image, fmt.Errorf("invalid repository %q, its path components must be lowercase alphanumeric characters separated by periods, underscores or hyphens", image.Repository)
+		}
+	}
+
+	if image.Registry == defaultImageRegistry && !strings.Contains(image.Repository, "/") {
+		image.Repository = "library/" + image.Repository
+	}
+
+	return image, nil
+}
+
+// imageReferenceStringValidator validates that a string attribute is a docker image reference.
+type imageReferenceStringValidator struct{}
+
+func (v imageReferenceStringValidator) Description(ctx context.Context) string {
+	return "value must be a docker image reference, such as `ghcr.io/org/app:v1`"
+}
+
+func (v imageReferenceStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v imageReferenceStringValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseImageReference(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q (%s).", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// imageReferenceValidator validates that an attribute is an image reference Railway can pull.
+func imageReferenceValidator() validator.String {
+	return imageReferenceStringValidator{}
+}
@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFloat64IncrementValidators(t *testing.T) {
+	testCases := map[string]struct {
+		validator   validator.Float64
+		value       types.Float64
+		expectError bool
+	}{
+		"vcpus minimum": {
+			validator: vcpuIncrementValidator(),
+			value:     types.Float64Value(0.25),
+		},
+		"vcpus off increment": {
+			validator:   vcpuIncrementValidator(),
+			value:       types.Float64Value(0.26),
+			expectError: true,
+		},
+		"vcpus 0.3": {
+			validator:   vcpuIncrementValidator(),
+			value:       types.Float64Value(0.3),
+			expectError: true,
+		},
+		"vcpus whole": {
+			validator: vcpuIncrementValidator(),
+			value:     types.Float64Value(8.0),
+		},
+		"vcpus above whole": {
+			validator:   vcpuIncrementValidator(),
+			value:       types.Float64Value(8.1),
+			expectError: true,
+		},
+		"vcpus null": {
+			validator: vcpuIncrementValidator(),
+			value:     types.Float64Null(),
+		},
+		"vcpus unknown": {
+			validator: vcpuIncrementValidator(),
+			value:     types.Float64Unknown(),
+		},
+		"memory minimum": {
+			validator: memoryGBIncrementValidator(),
+			value:     types.Float64Value(0.25),
+		},
+		"memory off increment": {
+			validator:   memoryGBIncrementValidator(),
+			value:       types.Float64Value(0.26),
+			expectError: true,
+		},
+		"memory whole": {
+			validator: memoryGBIncrementValidator(),
+			value:     types.Float64Value(8.0),
+		},
+		"memory above whole": {
+			validator:   memoryGBIncrementValidator(),
+			value:       types.Float64Value(8.1),
+			expectError: true,
+		},
+		"memory fraction": {
+			validator: memoryGBIncrementValidator(),
+			value:     types.Float64Value(1.75),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.Float64Response{}
+
+			testCase.validator.ValidateFloat64(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestFloat64IncrementValidatorNearestValues(t *testing.T) {
+	req := validator.Float64Request{
+		Path:        path.Root("vcpus"),
+		ConfigValue: types.Float64Value(0.3),
+	}
+	resp := &validator.Float64Response{}
+
+	vcpuIncrementValidator().ValidateFloat64(context.Background(), req, resp)
+
+	if len(resp.Diagnostics) != 1 {
+		t.Fatalf("expected one diagnostic, got: %v", resp.Diagnostics)
+	}
+
+	expected := "Attribute vcpus must be a multiple of 0.25 vCPU, got: 0.3. The nearest valid values are 0.25 and 0.5."
+
+	if detail := resp.Diagnostics[0].Detail(); detail != expected {
+		t.Errorf("unexpected detail\ngot:      %s\nexpected: %s", detail, expected)
+	}
+}
+
+func TestInt64IncrementValidators(t *testing.T) {
+	testCases := map[string]struct {
+		value       types.Int64
+		expectError bool
+	}{
+		"minimum": {
+			value: types.Int64Value(256),
+		},
+		"off increment": {
+			value:       types.Int64Value(257),
+			expectError: true,
+		},
+		"below increment": {
+			value:       types.Int64Value(255),
+			expectError: true,
+		},
+		"fraction of a GB": {
+			value: types.Int64Value(768),
+		},
+		"decimal GB": {
+			value:       types.Int64Value(1000),
+			expectError: true,
+		},
+		"null": {
+			value: types.Int64Null(),
+		},
+		"unknown": {
+			value: types.Int64Unknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.Int64Request{
+				Path:        path.Root("memory_mb"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.Int64Response{}
+
+			memoryMBIncrementValidator().ValidateInt64(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDNSLabelValidator(t *testing.T) {
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+		suggestion  string
+	}{
+		"valid": {
+			value: types.StringValue("internal"),
+		},
+		"valid with hyphens and digits": {
+			value: types.StringValue("api-v2"),
+		},
+		"single character": {
+			value: types.StringValue("a"),
+		},
+		"maximum length": {
+			value: types.StringValue(strings.Repeat("a", 63)),
+		},
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"spaces and punctuation": {
+			value:       types.StringValue("My Internal Net!"),
+			expectError: true,
+			suggestion:  "my-internal-net",
+		},
+		"leading hyphen": {
+			value:       types.StringValue("-internal"),
+			expectError: true,
+			suggestion:  "internal",
+		},
+		"trailing hyphen": {
+			value:       types.StringValue("internal-"),
+			expectError: true,
+			suggestion:  "internal",
+		},
+		"underscore": {
+			value:       types.StringValue("api_server"),
+			expectError: true,
+			suggestion:  "api-server",
+		},
+		"too long": {
+			value:       types.StringValue(strings.Repeat("a", 64)),
+			expectError: true,
+			suggestion:  strings.Repeat("a", 63),
+		},
+		"empty": {
+			value:       types.StringValue(""),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("name"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			dnsLabelValidator().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("expected error: %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+
+			if testCase.suggestion != "" && !strings.Contains(resp.Diagnostics[0].Detail(), fmt.Sprintf("Consider using %q instead.", testCase.suggestion)) {
+				t.Errorf("expected suggestion %q, got: %s", testCase.suggestion, resp.Diagnostics[0].Detail())
+			}
+		})
+	}
+}
+
+func TestParseImageReference(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+
+	testCases := map[string]struct {
+		ref         string
+		expected    imageReference
+		expectError string
+	}{
+		"official image": {
+			ref:      "hello-world",
+			expected: imageReference{Registry: "docker.io", Repository: "library/hello-world"},
+		},
+		"docker hub with tag": {
+			ref:      "bitnami/redis:7.2",
+			expected: imageReference{Registry: "docker.io", Repository: "bitnami/redis", Tag: "7.2"},
+		},
+		"registry": {
+			ref:      "ghcr.io/org/team/app:v1.2.3",
+			expected: imageReference{Registry: "ghcr.io", Repository: "org/team/app", Tag: "v1.2.3"},
+		},
+		"port in host": {
+			ref:      "registry.example.com:5000/app:latest",
+			expected: imageReference{Registry: "registry.example.com:5000", Repository: "app", Tag: "latest"},
+		},
+		"port in host without tag": {
+			ref:      "localhost:5000/app",
+			expected: imageReference{Registry: "localhost:5000", Repository: "app"},
+		},
+		"localhost": {
+			ref:      "localhost/app",
+			expected: imageReference{Registry: "localhost", Repository: "app"},
+		},
+		"digest only": {
+			ref:      "postgres@" + digest,
+			expected: imageReference{Registry: "docker.io", Repository: "library/postgres", Digest: digest},
+		},
+		"tag and digest": {
+			ref:      "ghcr.io/org/app:v1@" + digest,
+			expected: imageReference{Registry: "ghcr.io", Repository: "org/app", Tag: "v1", Digest: digest},
+		},
+		"uppercase repository": {
+			ref:         "ghcr.io/Org/app",
+			expectError: "invalid repository",
+		},
+		"invalid tag": {
+			ref:         "app:-latest",
+			expectError: "invalid tag",
+		},
+		"short digest": {
+			ref:         "app@sha256:abc",
+			expectError: "invalid digest",
+		},
+		"invalid registry": {
+			ref:         "-registry.io/app",
+			expectError: "invalid registry",
+		},
+		"missing repository": {
+			ref:         "ghcr.io/",
+			expectError: "invalid repository",
+		},
+		"empty": {
+			ref:         "",
+			expectError: "invalid repository",
+		},
+		"spaces": {
+			ref:         "my app",
+			expectError: "invalid repository",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			image, err := parseImageReference(testCase.ref)
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.expectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if image != testCase.expected {
+				t.Errorf("expected %+v, got %+v", testCase.expected, image)
+			}
+		})
+	}
+}
+
+func TestImageReferenceValidator(t *testing.T) {
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"valid": {
+			value: types.StringValue("ghcr.io/org/app:v1"),
+		},
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"invalid": {
+			value:       types.StringValue("ghcr.io/org/app:"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("source_image"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			imageReferenceValidator().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Fatalf("expected error: %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}